@@ -0,0 +1,8 @@
+data "netbox_cable_trace" "uplink" {
+  object_type = "dcim.interface"
+  object_id   = netbox_device_interface.uplink.id
+}
+
+output "uplink_peer" {
+  value = data.netbox_cable_trace.uplink.far_end[0].name
+}
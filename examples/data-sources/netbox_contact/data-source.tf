@@ -0,0 +1,10 @@
+data "netbox_contact" "noc" {
+  email = "noc@example.com"
+}
+
+resource "netbox_contact_assignment" "site_noc" {
+  content_type = "dcim.site"
+  object_id    = 1
+  contact_id   = data.netbox_contact.noc.id
+  role_id      = 1
+}
@@ -0,0 +1,7 @@
+data "netbox_data_file" "leaf" {
+  source_id = netbox_data_source.templates.id
+  path      = "templates/leaf.j2"
+
+  # The data file only exists after the data source was synced
+  depends_on = [netbox_data_source_sync.templates]
+}
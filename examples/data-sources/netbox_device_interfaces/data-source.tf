@@ -0,0 +1,31 @@
+data "netbox_devices" "switch" {
+  filter {
+    name  = "name"
+    value = "switch01"
+  }
+}
+
+# All enabled ethernet interfaces of the switch
+data "netbox_device_interfaces" "switch_ethernet" {
+  name_regex = "^Ethernet"
+  filter {
+    name  = "device_id"
+    value = data.netbox_devices.switch.devices[0].device_id
+  }
+  filter {
+    name  = "enabled"
+    value = "true"
+  }
+}
+
+# The management interfaces of the switch
+data "netbox_device_interfaces" "switch_mgmt" {
+  filter {
+    name  = "device_id"
+    value = data.netbox_devices.switch.devices[0].device_id
+  }
+  filter {
+    name  = "mgmt_only"
+    value = "true"
+  }
+}
@@ -0,0 +1,9 @@
+data "netbox_object_changes" "router" {
+  object_type = "dcim.device"
+  object_id   = netbox_device.router.id
+  limit       = 10
+}
+
+output "last_router_change_by" {
+  value = data.netbox_object_changes.router.changes[0].username
+}
@@ -0,0 +1,8 @@
+data "netbox_prefix" "by_cidr_and_vrf" {
+  prefix = "10.0.0.0/24"
+  vrf_id = 1
+}
+
+data "netbox_prefix" "by_tag" {
+  tag = "management"
+}
@@ -0,0 +1,27 @@
+# All active IPv4 prefixes of a site within 10.0.0.0/8, including 10.0.0.0/8 itself
+data "netbox_prefixes" "site" {
+  filter {
+    name  = "site_id"
+    value = "1"
+  }
+  filter {
+    name  = "family"
+    value = "4"
+  }
+  filter {
+    name  = "status"
+    value = "active"
+  }
+  filter {
+    name  = "within_include"
+    value = "10.0.0.0/8"
+  }
+}
+
+# All prefixes that contain an IP address
+data "netbox_prefixes" "containing" {
+  filter {
+    name  = "contains"
+    value = "10.0.0.1"
+  }
+}
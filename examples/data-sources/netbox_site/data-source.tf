@@ -5,3 +5,7 @@ data "netbox_site" "get_by_name" {
 data "netbox_site" "get_by_slug" {
   slug = "example-site-1"
 }
+
+data "netbox_site" "get_by_id" {
+  site_id = 1
+}
@@ -0,0 +1,13 @@
+// Assumes vmw-cluster-01 exists as a cluster in Netbox
+data "netbox_cluster" "vmw_cluster_01" {
+  name = "vmw-cluster-01"
+}
+
+data "netbox_virtual_machine" "myvm" {
+  name       = "myvm-1"
+  cluster_id = data.netbox_cluster.vmw_cluster_01.id
+}
+
+output "myvm_ip" {
+  value = split("/", data.netbox_virtual_machine.myvm.primary_ip4)[0]
+}
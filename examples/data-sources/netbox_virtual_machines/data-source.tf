@@ -10,3 +10,14 @@ data "netbox_virtual_machines" "base_vm" {
     value = data.netbox_cluster.vmw_cluster_01.id
   }
 }
+
+data "netbox_virtual_machines" "active_hypervisor_guests" {
+  filter {
+    name  = "status"
+    value = "active"
+  }
+  filter {
+    name  = "tag"
+    value = "managed-by-terraform"
+  }
+}
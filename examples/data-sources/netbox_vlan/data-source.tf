@@ -14,3 +14,15 @@ data "netbox_vlan" "vlan3" {
   name   = "vlan-3"
   tenant = netbox_tenant.example.id
 }
+
+# Get VLAN by VID within a VLAN group
+data "netbox_vlan" "vlan4" {
+  vid      = 100
+  group_id = netbox_vlan_group.example.id
+}
+
+# Get VLAN by VID and site ID
+data "netbox_vlan" "vlan5" {
+  vid  = 200
+  site = netbox_site.example.id
+}
@@ -0,0 +1,10 @@
+data "netbox_vlans" "active_in_group" {
+  filter {
+    name  = "group_id"
+    value = "1"
+  }
+  filter {
+    name  = "status"
+    value = "active"
+  }
+}
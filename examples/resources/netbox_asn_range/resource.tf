@@ -0,0 +1,10 @@
+resource "netbox_rir" "example" {
+  name = "example"
+}
+
+resource "netbox_asn_range" "example" {
+  name   = "private"
+  rir_id = netbox_rir.example.id
+  start  = 64512
+  end    = 65534
+}
@@ -0,0 +1,21 @@
+resource "netbox_rir" "example" {
+  name = "example"
+}
+
+resource "netbox_asn_range" "example" {
+  name   = "private"
+  rir_id = netbox_rir.example.id
+  start  = 64512
+  end    = 65534
+}
+
+resource "netbox_available_asn" "example" {
+  asn_range_id = netbox_asn_range.example.id
+  description  = "example"
+}
+
+resource "netbox_site" "example" {
+  name    = "example"
+  status  = "active"
+  asn_ids = [netbox_available_asn.example.id]
+}
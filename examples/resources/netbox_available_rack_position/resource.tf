@@ -0,0 +1,19 @@
+data "netbox_device_type" "server" {
+  model = "PowerEdge R640"
+}
+
+resource "netbox_available_rack_position" "server" {
+  rack_id       = 10
+  u_height      = data.netbox_device_type.server.u_height
+  is_full_depth = data.netbox_device_type.server.is_full_depth
+}
+
+resource "netbox_device" "server" {
+  name           = "server01"
+  device_type_id = data.netbox_device_type.server.id
+  role_id        = 1
+  site_id        = 1
+  rack_id        = netbox_available_rack_position.server.rack_id
+  rack_face      = netbox_available_rack_position.server.face
+  rack_position  = netbox_available_rack_position.server.position
+}
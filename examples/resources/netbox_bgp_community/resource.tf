@@ -0,0 +1,4 @@
+resource "netbox_bgp_community" "blackhole" {
+  value       = "65535:666"
+  description = "Blackhole traffic to the tagged routes"
+}
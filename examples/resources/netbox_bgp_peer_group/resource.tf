@@ -0,0 +1,4 @@
+resource "netbox_bgp_peer_group" "transit" {
+  name              = "transit"
+  import_policy_ids = [netbox_routing_policy.transit_in.id]
+}
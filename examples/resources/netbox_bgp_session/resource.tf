@@ -0,0 +1,19 @@
+resource "netbox_asn" "local" {
+  asn    = 65000
+  rir_id = netbox_rir.private.id
+}
+
+resource "netbox_asn" "transit" {
+  asn    = 64496
+  rir_id = netbox_rir.private.id
+}
+
+resource "netbox_bgp_session" "transit" {
+  name              = "transit-a"
+  device_id         = netbox_device.edge.id
+  local_address_id  = netbox_ip_address.edge_uplink.id
+  remote_address_id = netbox_ip_address.transit_peer.id
+  local_as_id       = netbox_asn.local.id
+  remote_as_id      = netbox_asn.transit.id
+  peer_group_id     = netbox_bgp_peer_group.transit.id
+}
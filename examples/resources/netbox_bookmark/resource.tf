@@ -0,0 +1,14 @@
+resource "netbox_site" "hq" {
+  name = "Headquarters"
+}
+
+resource "netbox_user" "noc" {
+  username = "noc"
+  password = var.noc_password
+}
+
+resource "netbox_bookmark" "hq" {
+  object_type = "dcim.site"
+  object_id   = netbox_site.hq.id
+  user_id     = netbox_user.noc.id
+}
@@ -0,0 +1,15 @@
+# Manage the branch in a separate configuration, as the provider is configured before any resource is created
+resource "netbox_branch" "maintenance" {
+  name        = "maintenance-2024-06"
+  description = "Changes of the maintenance in June 2024"
+}
+
+output "maintenance_branch" {
+  value = netbox_branch.maintenance.schema_id
+}
+
+# In the configuration whose changes are staged in the branch, set the schema ID of the branch in the provider
+provider "netbox" {
+  server_url = "https://netbox.example.com"
+  branch     = "td5smq0f"
+}
@@ -0,0 +1,17 @@
+resource "netbox_cable" "uplink" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.switch_eth1.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.router_eth1.id
+  }
+
+  type        = "cat6"
+  status      = "connected"
+  label       = "uplink"
+  color_hex   = "ff0000"
+  length      = 3
+  length_unit = "m"
+}
@@ -0,0 +1,19 @@
+resource "netbox_circuit_provider" "carrier" {
+  name = "Example Carrier"
+}
+
+resource "netbox_circuit_type" "dia" {
+  name        = "Dedicated Internet Access"
+  description = "Internet uplinks with a committed rate"
+}
+
+resource "netbox_circuit" "dc1_uplink" {
+  cid              = "CID-0001"
+  status           = "active"
+  provider_id      = netbox_circuit_provider.carrier.id
+  type_id          = netbox_circuit_type.dia.id
+  install_date     = "2023-04-01"
+  termination_date = "2026-03-31"
+  commit_rate      = 10000
+  description      = "DC1 internet uplink"
+}
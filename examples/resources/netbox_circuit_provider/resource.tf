@@ -0,0 +1,13 @@
+resource "netbox_rir" "arin" {
+  name = "ARIN"
+}
+
+resource "netbox_asn" "carrier" {
+  asn    = 64500
+  rir_id = netbox_rir.arin.id
+}
+
+resource "netbox_circuit_provider" "carrier" {
+  name    = "Example Carrier"
+  asn_ids = [netbox_asn.carrier.id]
+}
@@ -0,0 +1,13 @@
+resource "netbox_region" "emea" {
+  name = "EMEA"
+}
+
+resource "netbox_config_context" "ntp_emea" {
+  name       = "ntp-emea"
+  weight     = 2000
+  region_ids = [netbox_region.emea.id]
+  tags       = ["core-switch"]
+  data = jsonencode({
+    ntp_servers = ["10.0.0.1", "10.0.0.2"]
+  })
+}
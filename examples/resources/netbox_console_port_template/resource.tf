@@ -0,0 +1,5 @@
+resource "netbox_console_port_template" "console" {
+  device_type_id = netbox_device_type.switch.id
+  name           = "console"
+  type           = "rj-45"
+}
@@ -0,0 +1,20 @@
+resource "netbox_contact" "noc" {
+  name  = "NOC on-call"
+  email = "noc@example.com"
+}
+
+resource "netbox_contact_role" "escalation" {
+  name = "Escalation"
+}
+
+resource "netbox_site" "dc1" {
+  name = "DC1"
+}
+
+resource "netbox_contact_assignment" "dc1_noc" {
+  content_type = "dcim.site"
+  object_id    = netbox_site.dc1.id
+  contact_id   = netbox_contact.noc.id
+  role_id      = netbox_contact_role.escalation.id
+  priority     = "primary"
+}
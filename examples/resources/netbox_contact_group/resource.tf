@@ -0,0 +1,14 @@
+resource "netbox_contact_group" "operations" {
+  name = "Operations"
+}
+
+resource "netbox_contact_group" "noc" {
+  name      = "Network Operations Center"
+  parent_id = netbox_contact_group.operations.id
+}
+
+resource "netbox_contact" "on_call" {
+  name     = "NOC on-call"
+  email    = "noc@example.com"
+  group_id = netbox_contact_group.noc.id
+}
@@ -0,0 +1,12 @@
+resource "netbox_data_source" "templates" {
+  name       = "templates"
+  type       = "git"
+  source_url = "https://github.com/example/netbox-templates.git"
+  parameters = jsonencode({
+    username = "netbox"
+    password = var.git_token
+    branch   = "main"
+  })
+  ignore_rules  = "*.md"
+  sync_interval = 60
+}
@@ -0,0 +1,14 @@
+resource "netbox_data_source" "templates" {
+  name       = "templates"
+  type       = "git"
+  source_url = "https://github.com/example/netbox-templates.git"
+}
+
+# Sync the data source again whenever a new commit is referenced
+resource "netbox_data_source_sync" "templates" {
+  data_source_id = netbox_data_source.templates.id
+
+  triggers = {
+    commit = var.templates_commit
+  }
+}
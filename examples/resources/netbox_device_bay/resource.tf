@@ -0,0 +1,5 @@
+resource "netbox_device_bay" "blade1" {
+  device_id           = netbox_device.chassis.id
+  name                = "Blade 1"
+  installed_device_id = netbox_device.blade.id
+}
@@ -0,0 +1,4 @@
+resource "netbox_device_bay_template" "blade1" {
+  device_type_id = netbox_device_type.blade_chassis.id
+  name           = "Blade 1"
+}
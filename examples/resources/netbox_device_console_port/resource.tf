@@ -0,0 +1,6 @@
+resource "netbox_device_console_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "console"
+  type      = "rj-45"
+  speed     = 9600
+}
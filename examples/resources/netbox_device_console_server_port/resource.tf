@@ -0,0 +1,17 @@
+resource "netbox_device_console_server_port" "test" {
+  device_id = netbox_device.console_server.id
+  name      = "port1"
+  type      = "rj-45"
+  speed     = 9600
+}
+
+resource "netbox_cable" "console" {
+  a_termination {
+    object_type = "dcim.consoleport"
+    object_id   = netbox_device_console_port.test.id
+  }
+  b_termination {
+    object_type = "dcim.consoleserverport"
+    object_id   = netbox_device_console_server_port.test.id
+  }
+}
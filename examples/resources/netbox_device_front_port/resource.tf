@@ -0,0 +1,7 @@
+resource "netbox_device_front_port" "port1" {
+  device_id          = netbox_device.patch_panel.id
+  name               = "Port 1"
+  type               = "lc"
+  rear_port_id       = netbox_device_rear_port.trunk.id
+  rear_port_position = 1
+}
@@ -0,0 +1,13 @@
+resource "netbox_device_interface_template" "mgmt" {
+  device_type_id = netbox_device_type.switch.id
+  name           = "mgmt0"
+  type           = "1000base-t"
+  mgmt_only      = true
+}
+
+# On module types, {module} is replaced with the position of the module bay
+resource "netbox_device_interface_template" "linecard" {
+  module_type_id = netbox_module_type.linecard.id
+  name           = "xe-{module}/0/0"
+  type           = "10gbase-x-sfpp"
+}
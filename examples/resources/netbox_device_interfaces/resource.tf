@@ -0,0 +1,17 @@
+resource "netbox_device_interfaces" "front_ports" {
+  device_id    = netbox_device.switch.id
+  name_pattern = "Ethernet1/{1..48}"
+  type         = "10gbase-x-sfpp"
+  mtu          = 9216
+}
+
+resource "netbox_cable" "uplink" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interfaces.front_ports.interface_ids["Ethernet1/48"]
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.router_uplink.id
+  }
+}
@@ -0,0 +1,5 @@
+resource "netbox_device_module_bay" "slot1" {
+  device_id = netbox_device.chassis.id
+  name      = "Slot 1"
+  position  = "1"
+}
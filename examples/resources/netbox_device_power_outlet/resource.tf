@@ -0,0 +1,13 @@
+resource "netbox_device_power_port" "pdu_inlet" {
+  device_id = netbox_device.pdu.id
+  name      = "Inlet"
+  type      = "iec-60309-3p-n-e-6h"
+}
+
+resource "netbox_device_power_outlet" "outlet1" {
+  device_id     = netbox_device.pdu.id
+  name          = "Outlet 1"
+  type          = "iec-60320-c13"
+  power_port_id = netbox_device_power_port.pdu_inlet.id
+  feed_leg      = "A"
+}
@@ -0,0 +1,7 @@
+resource "netbox_device_power_port" "psu1" {
+  device_id      = netbox_device.server.id
+  name           = "PSU1"
+  type           = "iec-60320-c14"
+  maximum_draw   = 750
+  allocated_draw = 400
+}
@@ -0,0 +1,25 @@
+// Assumes Netbox already has a device whose name matches 'dc-west-mydevice-20'
+data "netbox_devices" "mydevice" {
+  filter {
+    name  = "name"
+    value = "dc-west-mydevice-20"
+  }
+}
+
+resource "netbox_device_interface" "mydevice_eth0" {
+  name      = "eth0"
+  device_id = data.netbox_devices.mydevice.devices.0.device_id
+  type      = "1000base-t"
+}
+
+resource "netbox_ip_address" "mydevice_ip" {
+  ip_address   = "10.0.0.60/24"
+  status       = "active"
+  interface_id = netbox_device_interface.mydevice_eth0.id
+  object_type  = "dcim.interface"
+}
+
+resource "netbox_device_primary_ip" "mydevice_primary_ip" {
+  ip_address_id = netbox_ip_address.mydevice_ip.id
+  device_id     = data.netbox_devices.mydevice.devices.0.device_id
+}
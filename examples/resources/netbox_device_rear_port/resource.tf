@@ -0,0 +1,6 @@
+resource "netbox_device_rear_port" "trunk" {
+  device_id = netbox_device.patch_panel.id
+  name      = "Trunk 1"
+  type      = "mpo"
+  positions = 12
+}
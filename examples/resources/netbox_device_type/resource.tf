@@ -7,3 +7,13 @@ resource "netbox_device_type" "test" {
   part_number     = "123"
   manufacturer_id = netbox_manufacturer.test.id
 }
+
+resource "netbox_device_type" "chassis" {
+  model           = "chassis"
+  manufacturer_id = netbox_manufacturer.test.id
+  u_height        = 10
+  airflow         = "front-to-rear"
+  weight          = 45.5
+  weight_unit     = "kg"
+  subdevice_role  = "parent"
+}
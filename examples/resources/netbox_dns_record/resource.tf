@@ -0,0 +1,14 @@
+resource "netbox_dns_record" "www" {
+  zone_id = netbox_dns_zone.example.id
+  type    = "A"
+  name    = "www"
+  value   = "192.0.2.1"
+  ttl     = 300
+}
+
+resource "netbox_dns_record" "mail" {
+  zone_id = netbox_dns_zone.example.id
+  type    = "MX"
+  name    = "@"
+  value   = "10 mail.example.com."
+}
@@ -0,0 +1,9 @@
+provider "netbox" {
+  server_url = "https://netbox.example.com"
+  plugins    = ["netbox_dns"]
+}
+
+resource "netbox_dns_view" "internal" {
+  name        = "internal"
+  description = "Zones that are only resolvable in the internal network"
+}
@@ -0,0 +1,14 @@
+# Notify the CMDB webhook whenever an active device is created or updated
+resource "netbox_event_rule" "device_changes" {
+  name         = "device-changes"
+  object_types = ["dcim.device"]
+  event_types  = ["object_created", "object_updated"]
+  conditions = jsonencode({
+    attr  = "status.value"
+    value = "active"
+  })
+
+  action_type        = "webhook"
+  action_object_type = "extras.webhook"
+  action_object_id   = var.cmdb_webhook_id
+}
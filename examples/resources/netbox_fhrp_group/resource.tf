@@ -0,0 +1,12 @@
+resource "netbox_fhrp_group" "example" {
+  protocol = "vrrp3"
+  group_id = 10
+}
+
+resource "netbox_ip_address" "vip" {
+  ip_address   = "10.0.0.1/24"
+  status       = "active"
+  role         = "vrrp"
+  object_type  = "ipam.fhrpgroup"
+  interface_id = netbox_fhrp_group.example.id
+}
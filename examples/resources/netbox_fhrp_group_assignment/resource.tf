@@ -0,0 +1,18 @@
+resource "netbox_fhrp_group" "example" {
+  protocol = "vrrp3"
+  group_id = 10
+}
+
+resource "netbox_fhrp_group_assignment" "router1" {
+  fhrp_group_id  = netbox_fhrp_group.example.id
+  interface_type = "dcim.interface"
+  interface_id   = netbox_device_interface.router1.id
+  priority       = 200
+}
+
+resource "netbox_fhrp_group_assignment" "router2" {
+  fhrp_group_id  = netbox_fhrp_group.example.id
+  interface_type = "dcim.interface"
+  interface_id   = netbox_device_interface.router2.id
+  priority       = 100
+}
@@ -0,0 +1,7 @@
+resource "netbox_front_port_template" "port1" {
+  device_type_id        = netbox_device_type.patch_panel.id
+  name                  = "Port 1"
+  type                  = "lc"
+  rear_port_template_id = netbox_rear_port_template.trunk.id
+  rear_port_position    = 1
+}
@@ -0,0 +1,11 @@
+resource "netbox_group" "network_engineers" {
+  name        = "Network engineers"
+  description = "Engineers of the network team"
+}
+
+# The user is a member of exactly the given groups
+resource "netbox_user" "jdoe" {
+  username  = "jdoe"
+  password  = var.jdoe_password
+  group_ids = [netbox_group.network_engineers.id]
+}
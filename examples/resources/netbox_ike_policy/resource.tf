@@ -0,0 +1,14 @@
+resource "netbox_ike_proposal" "aws_phase1" {
+  name                     = "aws-phase1"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+}
+
+resource "netbox_ike_policy" "aws" {
+  name          = "aws-ikev2"
+  version       = 2
+  proposal_ids  = [netbox_ike_proposal.aws_phase1.id]
+  preshared_key = var.aws_vpn_tunnel1_psk
+}
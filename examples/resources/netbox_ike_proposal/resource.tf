@@ -0,0 +1,8 @@
+resource "netbox_ike_proposal" "aws_phase1" {
+  name                     = "aws-phase1"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+  sa_lifetime              = 28800
+}
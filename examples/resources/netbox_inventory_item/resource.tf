@@ -0,0 +1,18 @@
+resource "netbox_inventory_item" "linecard" {
+  device_id = netbox_device.chassis.id
+  name      = "Line card 1"
+  serial    = "LC0001"
+}
+
+resource "netbox_inventory_item" "sfp" {
+  device_id       = netbox_device.chassis.id
+  parent_id       = netbox_inventory_item.linecard.id
+  name            = "SFP 1"
+  role_id         = netbox_inventory_item_role.optics.id
+  manufacturer_id = netbox_manufacturer.test.id
+  part_id         = "SFP-10G-LR"
+  serial          = "SFP0001"
+
+  component_type = "dcim.interface"
+  component_id   = netbox_device_interface.xe1.id
+}
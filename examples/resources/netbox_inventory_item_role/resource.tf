@@ -0,0 +1,4 @@
+resource "netbox_inventory_item_role" "optics" {
+  name      = "Optics"
+  color_hex = "00ff00"
+}
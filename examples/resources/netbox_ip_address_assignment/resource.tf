@@ -0,0 +1,13 @@
+resource "netbox_available_ip_address" "example" {
+  prefix_id = netbox_prefix.example.id
+
+  lifecycle {
+    ignore_changes = [interface_id, object_type]
+  }
+}
+
+resource "netbox_ip_address_assignment" "example" {
+  ip_address_id = netbox_available_ip_address.example.id
+  object_type   = "dcim.interface"
+  interface_id  = netbox_device_interface.example.id
+}
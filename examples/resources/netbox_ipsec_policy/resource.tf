@@ -0,0 +1,11 @@
+resource "netbox_ipsec_proposal" "aws_phase2" {
+  name                     = "aws-phase2"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+}
+
+resource "netbox_ipsec_policy" "aws" {
+  name         = "aws-phase2"
+  proposal_ids = [netbox_ipsec_proposal.aws_phase2.id]
+  pfs_group    = 14
+}
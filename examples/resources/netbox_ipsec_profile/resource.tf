@@ -0,0 +1,12 @@
+resource "netbox_ipsec_profile" "aws" {
+  name            = "aws-site-to-site"
+  mode            = "esp"
+  ike_policy_id   = netbox_ike_policy.aws.id
+  ipsec_policy_id = netbox_ipsec_policy.aws.id
+}
+
+resource "netbox_tunnel" "aws_vpn_1" {
+  name             = "aws-vpn-1"
+  encapsulation    = "ipsec-tunnel"
+  ipsec_profile_id = netbox_ipsec_profile.aws.id
+}
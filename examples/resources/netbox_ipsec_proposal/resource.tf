@@ -0,0 +1,6 @@
+resource "netbox_ipsec_proposal" "aws_phase2" {
+  name                     = "aws-phase2"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  sa_lifetime_seconds      = 3600
+}
@@ -0,0 +1,5 @@
+resource "netbox_l2vpn" "tenant_a" {
+  name       = "tenant-a-overlay"
+  type       = "vxlan-evpn"
+  identifier = 10100
+}
@@ -0,0 +1,16 @@
+resource "netbox_vlan" "tenant_a" {
+  name = "tenant-a"
+  vid  = 100
+}
+
+resource "netbox_l2vpn" "tenant_a" {
+  name       = "tenant-a-overlay"
+  type       = "vxlan-evpn"
+  identifier = 10100
+}
+
+resource "netbox_l2vpn_termination" "tenant_a" {
+  l2vpn_id    = netbox_l2vpn.tenant_a.id
+  object_type = "ipam.vlan"
+  object_id   = netbox_vlan.tenant_a.id
+}
@@ -0,0 +1,6 @@
+resource "netbox_mac_address" "eth0" {
+  mac_address          = "00:11:22:33:44:55"
+  assigned_object_type = "dcim.interface"
+  assigned_object_id   = netbox_device_interface.eth0.id
+  is_primary           = true
+}
@@ -0,0 +1,11 @@
+resource "netbox_module" "linecard" {
+  device_id      = netbox_device.chassis.id
+  module_bay_id  = netbox_device_module_bay.slot1.id
+  module_type_id = netbox_module_type.linecard.id
+  status         = "active"
+  serial         = "ABC123"
+
+  # Take over interfaces that were created before the module was installed
+  replicate_components = true
+  adopt_components     = true
+}
@@ -0,0 +1,5 @@
+resource "netbox_module_bay_template" "slot1" {
+  device_type_id = netbox_device_type.chassis.id
+  name           = "Slot 1"
+  position       = "1"
+}
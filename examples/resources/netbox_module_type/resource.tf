@@ -0,0 +1,5 @@
+resource "netbox_module_type" "linecard" {
+  manufacturer_id = netbox_manufacturer.test.id
+  model           = "LC-48X"
+  part_number     = "LC-48X-10G"
+}
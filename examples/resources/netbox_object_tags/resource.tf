@@ -0,0 +1,10 @@
+resource "netbox_tag" "managed" {
+  name = "managed-by-terraform"
+}
+
+# Attach the tag to an existing device that is not managed by Terraform
+resource "netbox_object_tags" "legacy_device" {
+  content_type = "dcim.device"
+  object_id    = 123
+  tags         = [netbox_tag.managed.slug]
+}
@@ -2,3 +2,12 @@
 resource "netbox_platform" "PANOS" {
   name = "PANOS"
 }
+
+resource "netbox_manufacturer" "juniper" {
+  name = "Juniper"
+}
+
+resource "netbox_platform" "junos" {
+  name            = "Junos"
+  manufacturer_id = netbox_manufacturer.juniper.id
+}
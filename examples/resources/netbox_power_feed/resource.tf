@@ -0,0 +1,22 @@
+resource "netbox_power_feed" "test" {
+  power_panel_id  = netbox_power_panel.test.id
+  name            = "Feed A1"
+  status          = "active"
+  type            = "primary"
+  supply          = "ac"
+  phase           = "three-phase"
+  voltage         = 400
+  amperage        = 32
+  max_utilization = 80
+}
+
+resource "netbox_cable" "feed" {
+  a_termination {
+    object_type = "dcim.powerfeed"
+    object_id   = netbox_power_feed.test.id
+  }
+  b_termination {
+    object_type = "dcim.powerport"
+    object_id   = netbox_device_power_port.pdu_inlet.id
+  }
+}
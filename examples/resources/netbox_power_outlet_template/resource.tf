@@ -0,0 +1,13 @@
+resource "netbox_power_port_template" "inlet" {
+  device_type_id = netbox_device_type.pdu.id
+  name           = "Inlet"
+  type           = "iec-60309-3p-n-e-6h"
+}
+
+resource "netbox_power_outlet_template" "outlet1" {
+  device_type_id         = netbox_device_type.pdu.id
+  name                   = "Outlet 1"
+  type                   = "iec-60320-c13"
+  power_port_template_id = netbox_power_port_template.inlet.id
+  feed_leg               = "A"
+}
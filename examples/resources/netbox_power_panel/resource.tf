@@ -0,0 +1,5 @@
+resource "netbox_power_panel" "test" {
+  name        = "Panel A"
+  site_id     = netbox_site.test.id
+  location_id = netbox_location.test.id
+}
@@ -0,0 +1,6 @@
+resource "netbox_power_port_template" "psu1" {
+  device_type_id = netbox_device_type.switch.id
+  name           = "PSU1"
+  type           = "iec-60320-c14"
+  maximum_draw   = 350
+}
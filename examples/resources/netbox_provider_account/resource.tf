@@ -0,0 +1,9 @@
+resource "netbox_circuit_provider" "carrier" {
+  name = "Example Carrier"
+}
+
+resource "netbox_provider_account" "dc_uplinks" {
+  provider_id = netbox_circuit_provider.carrier.id
+  account     = "ACC-123456"
+  name        = "DC uplinks"
+}
@@ -0,0 +1,5 @@
+resource "netbox_rack_role" "storage" {
+  name        = "Storage"
+  color_hex   = "0000FF"
+  description = "Racks housing storage systems"
+}
@@ -0,0 +1,6 @@
+resource "netbox_rear_port_template" "trunk" {
+  device_type_id = netbox_device_type.patch_panel.id
+  name           = "Trunk 1"
+  type           = "mpo"
+  positions      = 12
+}
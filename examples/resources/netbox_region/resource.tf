@@ -0,0 +1,8 @@
+resource "netbox_region" "europe" {
+  name = "Europe"
+}
+
+resource "netbox_region" "germany" {
+  name             = "Germany"
+  parent_region_id = netbox_region.europe.id
+}
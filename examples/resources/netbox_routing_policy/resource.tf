@@ -0,0 +1,9 @@
+provider "netbox" {
+  server_url = "https://netbox.example.com"
+  plugins    = ["netbox_bgp"]
+}
+
+resource "netbox_routing_policy" "transit_in" {
+  name        = "transit-in"
+  description = "Routes accepted from transit providers"
+}
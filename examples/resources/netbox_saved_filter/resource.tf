@@ -0,0 +1,8 @@
+resource "netbox_saved_filter" "planned_devices" {
+  name         = "Planned devices"
+  description  = "Devices that are not yet deployed"
+  object_types = ["dcim.device"]
+  parameters = jsonencode({
+    status = ["planned"]
+  })
+}
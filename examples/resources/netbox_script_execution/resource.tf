@@ -0,0 +1,24 @@
+resource "netbox_site" "branch" {
+  name = "Branch 42"
+}
+
+# Provision the prefixes and VLANs of a new branch with a custom script
+resource "netbox_script_execution" "provision_branch" {
+  script_id = "12"
+  data = jsonencode({
+    site = netbox_site.branch.id
+  })
+
+  # Run the script again when the site is replaced
+  triggers = {
+    site_id = netbox_site.branch.id
+  }
+
+  timeouts {
+    create = "15m"
+  }
+}
+
+output "provision_branch_output" {
+  value = netbox_script_execution.provision_branch.output
+}
@@ -0,0 +1,12 @@
+variable "router_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "netbox_secret" "router_admin" {
+  name                 = "admin"
+  role_id              = netbox_secret_role.login.id
+  assigned_object_type = "dcim.device"
+  assigned_object_id   = netbox_device.router.id
+  plaintext            = var.router_password
+}
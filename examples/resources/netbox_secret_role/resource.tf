@@ -0,0 +1,10 @@
+provider "netbox" {
+  server_url          = "https://netbox.example.com"
+  plugins             = ["netbox_secrets"]
+  secrets_private_key = file("~/.netbox/secrets.pem")
+}
+
+resource "netbox_secret_role" "login" {
+  name        = "Login"
+  description = "Credentials to log in to devices"
+}
@@ -9,3 +9,10 @@ resource "netbox_service" "ssh" {
   protocol           = "tcp"
   virtual_machine_id = data.netbox_virtual_machine.myvm.id
 }
+
+resource "netbox_service" "app" {
+  name               = "app"
+  port_ranges        = ["8000-8100", "9000"]
+  protocol           = "tcp"
+  virtual_machine_id = data.netbox_virtual_machine.myvm.id
+}
@@ -2,3 +2,11 @@ resource "netbox_tag" "dmz" {
   name      = "DMZ"
   color_hex = "ff00ff"
 }
+
+# Requires Netbox 4.0 or later
+resource "netbox_tag" "edge" {
+  name         = "Edge"
+  color_hex    = "2196f3"
+  description  = "Devices and prefixes at the network edge"
+  object_types = ["dcim.device", "ipam.prefix"]
+}
@@ -0,0 +1,8 @@
+resource "netbox_tenant_group" "business_unit" {
+  name = "Retail"
+}
+
+resource "netbox_tenant_group" "team" {
+  name      = "Retail Platform Team"
+  parent_id = netbox_tenant_group.business_unit.id
+}
@@ -0,0 +1,18 @@
+resource "netbox_user" "automation" {
+  username = "automation"
+  password = var.automation_password
+}
+
+# Netbox generates the key, which is stored in the state
+resource "netbox_token" "automation" {
+  user_id       = netbox_user.automation.id
+  write_enabled = false
+  allowed_ips   = ["10.0.0.0/8"]
+  description   = "Read-only token of the automation"
+  expires       = "2025-12-31T23:59:59Z"
+}
+
+output "automation_token" {
+  value     = netbox_token.automation.key
+  sensitive = true
+}
@@ -0,0 +1,11 @@
+resource "netbox_tunnel_group" "aws" {
+  name = "AWS VPN"
+}
+
+resource "netbox_tunnel" "aws_vpn_1" {
+  name          = "aws-vpn-1"
+  encapsulation = "ipsec-tunnel"
+  status        = "active"
+  group_id      = netbox_tunnel_group.aws.id
+  description   = "Tunnel 1 of the AWS site-to-site VPN connection"
+}
@@ -0,0 +1,4 @@
+resource "netbox_tunnel_group" "aws" {
+  name        = "AWS VPN"
+  description = "Site-to-site VPN connections to AWS"
+}
@@ -0,0 +1,22 @@
+# Mirror one tunnel of an AWS site-to-site VPN connection: the on-premises
+# firewall is one peer, the AWS virtual private gateway the other.
+resource "netbox_tunnel" "aws_vpn_1" {
+  name          = "aws-vpn-1"
+  encapsulation = "ipsec-tunnel"
+}
+
+resource "netbox_tunnel_termination" "onprem" {
+  tunnel_id             = netbox_tunnel.aws_vpn_1.id
+  role                  = "peer"
+  termination_type      = "dcim.interface"
+  termination_id        = netbox_device_interface.fw_tunnel1.id
+  outside_ip_address_id = netbox_ip_address.fw_public.id
+}
+
+resource "netbox_tunnel_termination" "aws" {
+  tunnel_id             = netbox_tunnel.aws_vpn_1.id
+  role                  = "peer"
+  termination_type      = "virtualization.vminterface"
+  termination_id        = netbox_interface.vgw_tunnel1.id
+  outside_ip_address_id = netbox_ip_address.aws_tunnel1_outside.id
+}
@@ -0,0 +1,9 @@
+resource "netbox_user" "admin" {
+  username   = "jdoe"
+  password   = var.jdoe_password
+  first_name = "Jane"
+  last_name  = "Doe"
+  email      = "jane.doe@example.com"
+  staff      = true
+  superuser  = true
+}
@@ -0,0 +1,14 @@
+resource "netbox_group" "network_engineers" {
+  name = "Network engineers"
+}
+
+# The user does not set group_ids, so its groups are managed by assignments
+resource "netbox_user" "jdoe" {
+  username = "jdoe"
+  password = var.jdoe_password
+}
+
+resource "netbox_user_group_assignment" "jdoe_network_engineers" {
+  user_id  = netbox_user.jdoe.id
+  group_id = netbox_group.network_engineers.id
+}
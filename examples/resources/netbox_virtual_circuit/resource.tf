@@ -0,0 +1,11 @@
+resource "netbox_virtual_circuit_type" "p2p" {
+  name = "Point-to-point"
+}
+
+resource "netbox_virtual_circuit" "dc1_dc2" {
+  cid                 = "VC-0001"
+  provider_network_id = 1
+  type_id             = netbox_virtual_circuit_type.p2p.id
+  status              = "active"
+  description         = "Overlay between DC1 and DC2"
+}
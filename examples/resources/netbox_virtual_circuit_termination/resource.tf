@@ -0,0 +1,12 @@
+# The virtual circuit is terminated on a virtual interface of each device
+resource "netbox_virtual_circuit_termination" "dc1" {
+  virtual_circuit_id = netbox_virtual_circuit.dc1_dc2.id
+  interface_id       = netbox_device_interface.dc1_vc.id
+  role               = "peer"
+}
+
+resource "netbox_virtual_circuit_termination" "dc2" {
+  virtual_circuit_id = netbox_virtual_circuit.dc1_dc2.id
+  interface_id       = netbox_device_interface.dc2_vc.id
+  role               = "peer"
+}
@@ -0,0 +1,5 @@
+resource "netbox_virtual_circuit_type" "p2p" {
+  name        = "Point-to-point"
+  color_hex   = "2196f3"
+  description = "Layer 2 point-to-point overlay"
+}
@@ -0,0 +1,18 @@
+resource "netbox_site" "example" {
+  name   = "example"
+  status = "active"
+}
+
+resource "netbox_vlan_group" "example" {
+  name       = "example"
+  scope_type = "dcim.site"
+  scope_id   = netbox_site.example.id
+  min_vid    = 100
+  max_vid    = 199
+}
+
+resource "netbox_vlan" "example" {
+  name     = "example"
+  vid      = 100
+  group_id = netbox_vlan_group.example.id
+}
@@ -0,0 +1,17 @@
+resource "netbox_vlan_translation_policy" "example" {
+  name        = "customer-a"
+  description = "VLAN translation for customer A"
+}
+
+resource "netbox_vlan_translation_rule" "example" {
+  policy_id  = netbox_vlan_translation_policy.example.id
+  local_vid  = 100
+  remote_vid = 2100
+}
+
+resource "netbox_device_interface" "example" {
+  name                       = "eth0"
+  device_id                  = 1
+  type                       = "1000base-t"
+  vlan_translation_policy_id = netbox_vlan_translation_policy.example.id
+}
@@ -0,0 +1,10 @@
+resource "netbox_vlan_translation_policy" "example" {
+  name = "customer-a"
+}
+
+resource "netbox_vlan_translation_rule" "example" {
+  policy_id   = netbox_vlan_translation_policy.example.id
+  local_vid   = 100
+  remote_vid  = 2100
+  description = "Customer A uplink"
+}
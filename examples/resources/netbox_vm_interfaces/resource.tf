@@ -0,0 +1,20 @@
+resource "netbox_virtual_machine" "worker" {
+  count      = 3
+  name       = "worker-${count.index}"
+  cluster_id = netbox_cluster.k8s.id
+}
+
+resource "netbox_vm_interfaces" "worker" {
+  count              = 3
+  virtual_machine_id = netbox_virtual_machine.worker[count.index].id
+  name_pattern       = "eth{0..1}"
+  mtu                = 9000
+}
+
+resource "netbox_ip_address" "worker" {
+  count        = 3
+  ip_address   = "10.0.0.${count.index + 10}/24"
+  status       = "active"
+  object_type  = "virtualization.vminterface"
+  interface_id = netbox_vm_interfaces.worker[count.index].interface_ids["eth0"]
+}
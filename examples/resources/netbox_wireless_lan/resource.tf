@@ -0,0 +1,17 @@
+variable "guest_psk" {
+  type      = string
+  sensitive = true
+}
+
+resource "netbox_wireless_lan_group" "campus" {
+  name = "Campus"
+}
+
+resource "netbox_wireless_lan" "guest" {
+  ssid        = "Guest"
+  group_id    = netbox_wireless_lan_group.campus.id
+  status      = "active"
+  auth_type   = "wpa-personal"
+  auth_cipher = "aes"
+  auth_psk    = var.guest_psk
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+
 	"github.com/e-breuninger/terraform-provider-netbox/netbox"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -26,4 +27,7 @@ func main() {
 			return netbox.Provider()
 		},
 	})
+
+	// Tokens provisioned from username and password are not needed anymore once Terraform stops the provider
+	netbox.RevokeProvisionedTokens()
 }
@@ -0,0 +1,61 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateChoiceAttribute checks during plan that the given attribute is one of the choices that the list endpoint
+// at the given path accepts for the given field. The choices are requested from Netbox instead of being hardcoded, so
+// that choices added with the FIELD_CHOICES configuration of Netbox can be used. The attribute is only validated if
+// it changed and is known, and not at all if Netbox does not advertise the choices or the provider is not configured
+// yet.
+func validateChoiceAttribute(d *schema.ResourceDiff, m interface{}, path string, attribute string, field string) error {
+	if !d.HasChange(attribute) || !d.NewValueKnown(attribute) {
+		return nil
+	}
+	value := d.Get(attribute).(string)
+	if value == "" {
+		return nil
+	}
+
+	api, ok := m.(*providerState)
+	if !ok || api == nil {
+		return nil
+	}
+	choices, ok, err := getGenericFieldChoices(api, path, field)
+	if err != nil {
+		return fmt.Errorf("error retrieving the valid values of %s from Netbox: %w", attribute, err)
+	}
+	// Without advertised choices, Netbox validates the value itself
+	if !ok {
+		return nil
+	}
+	for _, choice := range choices {
+		if choice == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s %q is not supported by Netbox, expected one of %s", attribute, value, strings.Join(choices, ", "))
+}
+
+// customizeDiffStatusChoices returns a CustomizeDiffFunc that validates the status attribute of a resource against
+// the statuses that the list endpoint at the given path accepts, including custom statuses.
+func customizeDiffStatusChoices(path string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		return validateChoiceAttribute(d, m, path, "status", "status")
+	}
+}
+
+// statusDescription returns the description of the status attribute of a resource with the given default statuses
+// of Netbox.
+func statusDescription(defaultStatuses ...string) string {
+	quoted := make([]string, len(defaultStatuses))
+	for i, status := range defaultStatuses {
+		quoted[i] = "`" + status + "`"
+	}
+	return fmt.Sprintf("By default one of %s. Custom statuses configured with `FIELD_CHOICES` in Netbox are supported as well, the status is validated against the statuses of Netbox during plan.", joinStringWithFinalConjunction(quoted, ", ", "or"))
+}
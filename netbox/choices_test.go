@@ -0,0 +1,75 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomizeDiffStatusChoices(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "OPTIONS", r.Method)
+		assert.Equal(t, "/api/ipam/prefixes/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"actions": {"POST": {"status": {"type": "choice", "choices": [
+			{"value": "active", "display_name": "Active"},
+			{"value": "quarantine", "display_name": "Quarantine"}
+		]}}}}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	r := resourceNetboxPrefix()
+	diff := func(state *terraform.InstanceState, status string) error {
+		_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"prefix": "10.0.0.0/24",
+			"status": status,
+		}), api)
+		return err
+	}
+
+	// Custom statuses are accepted
+	assert.NoError(t, diff(nil, "quarantine"))
+	assert.ErrorContains(t, diff(nil, "reserved"), `status "reserved" is not supported by Netbox, expected one of active, quarantine`)
+	// The choices are only requested once
+	assert.Equal(t, 1, requests)
+
+	// An unchanged status is not validated again
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			"prefix": "10.0.0.0/24",
+			"status": "quarantine",
+		},
+	}
+	assert.NoError(t, diff(state, "quarantine"))
+	assert.Equal(t, 1, requests)
+
+	// Without a configured provider, the status is not validated
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"prefix": "10.0.0.0/24",
+		"status": "reserved",
+	}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestStatusDescription(t *testing.T) {
+	assert.Contains(t, statusDescription("active"), "By default one of `active`.")
+	assert.Contains(t, statusDescription("active", "reserved", "deprecated"), "By default one of `active`, `reserved` or `deprecated`.")
+}
@@ -1,26 +1,78 @@
 package netbox
 
 import (
+	"context"
+	"crypto/x509"
 	"fmt"
+	"io"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	netboxclient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/goware/urlx"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
 )
 
 // Config struct for the netbox provider
 type Config struct {
-	APIToken                    string
+	APIToken string
+	Username string
+	Password string
+	// TokenLifetime is the lifetime in minutes of API tokens provisioned from username and password. If zero,
+	// defaultProvisionedTokenLifetime is used.
+	TokenLifetime               int
 	ServerURL                   string
+	BasePath                    string
 	AllowInsecureHttps          bool
+	CACertFile                  string
+	CACertPEM                   string
+	ClientCertFile              string
+	ClientKeyFile               string
 	Headers                     map[string]interface{}
 	RequestTimeout              int
 	StripTrailingSlashesFromURL bool
+	MaxRetries                  int
+	RetryOnStatusCodes          []int
+	RequestsPerSecond           float64
+	DebugAPICalls               bool
+	MaxParallelRequests         int
+	DisableCompression          bool
+	CollectMetrics              bool
+	// Branch is the schema ID of the branch of the branching plugin that all requests are made in. If empty, requests
+	// are made in the main schema.
+	Branch string
+	// LogContext is the context used for logging via tflog, usually the context passed to the
+	// provider's configure function. If nil, nothing is logged.
+	LogContext context.Context
 }
 
+// defaultProvisionedTokenLifetime is the lifetime of API tokens provisioned from username and password if no lifetime
+// is configured.
+const defaultProvisionedTokenLifetime = 24 * time.Hour
+
+// provisionedTokenDescription is the description of API tokens provisioned from username and password. It is used to
+// find the expired tokens of the provider.
+const provisionedTokenDescription = "Provisioned by terraform-provider-netbox"
+
+// provisionedTokenRevokeTimeout is the time to revoke the provisioned tokens when the provider shuts down. Terraform
+// kills the provider shortly after asking it to shut down, so this has to be short.
+const provisionedTokenRevokeTimeout = time.Second
+
+// branchHeader is the header that selects the branch of the branching plugin a request is made in.
+const branchHeader = "X-NetBox-Branch"
+
+// defaultRetryOnStatusCodes are the status codes that are retried if no status codes are configured.
+var defaultRetryOnStatusCodes = []int{429, 502, 503, 504}
+
 // customHeaderTransport is a transport that adds the specified headers on
 // every request.
 type customHeaderTransport struct {
@@ -28,14 +80,65 @@ type customHeaderTransport struct {
 	headers  map[string]interface{}
 }
 
+// apiCallLogTransport is a transport that logs method, path, status and
+// elapsed time of every request.
+type apiCallLogTransport struct {
+	original   http.RoundTripper
+	logContext context.Context
+}
+
+// tflogLogger adapts tflog to the logger interface of the Open API runtime.
+type tflogLogger struct {
+	logContext context.Context
+}
+
+// concurrencyLimitTransport is a transport that limits the number of requests
+// that are in flight at the same time.
+type concurrencyLimitTransport struct {
+	original  http.RoundTripper
+	semaphore chan struct{}
+}
+
+// releaseOnCloseBody releases a slot of a concurrencyLimitTransport once the response body is closed.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+// rateLimitTransport is a transport that throttles requests using a token bucket.
+type rateLimitTransport struct {
+	original http.RoundTripper
+	limiter  *tokenBucket
+}
+
+// tokenBucket is a simple token bucket that refills at the given rate up to burst tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// retryTransport is a transport that retries requests answered with one of
+// the specified status codes, using exponential backoff with jitter.
+type retryTransport struct {
+	original    http.RoundTripper
+	maxRetries  int
+	statusCodes []int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	logContext  context.Context
+}
+
 // Client does the heavy lifting of establishing a base Open API client to Netbox.
 func (cfg *Config) Client() (interface{}, error) {
 
-	log.WithFields(log.Fields{
+	tflog.Debug(cfg.logContext(), "Initializing Netbox client", map[string]interface{}{
 		"server_url": cfg.ServerURL,
-	}).Debug("Initializing Netbox client")
+	})
 
-	if cfg.APIToken == "" {
+	if cfg.APIToken == "" && (cfg.Username == "" || cfg.Password == "") {
 		return nil, fmt.Errorf("missing netbox API key")
 	}
 
@@ -44,16 +147,29 @@ func (cfg *Config) Client() (interface{}, error) {
 	if urlParseError != nil {
 		return nil, fmt.Errorf("error while trying to parse URL: %s", urlParseError)
 	}
+	if parsedURL.Host == "" {
+		return nil, fmt.Errorf("error while trying to parse URL: no host found in %q", cfg.ServerURL)
+	}
 
 	desiredRuntimeClientSchemes := []string{parsedURL.Scheme}
-	log.WithFields(log.Fields{
+	tflog.Debug(cfg.logContext(), "Initializing Netbox Open API runtime client", map[string]interface{}{
 		"host":    parsedURL.Host,
 		"schemes": desiredRuntimeClientSchemes,
-	}).Debug("Initializing Netbox Open API runtime client")
+	})
 
 	// build http client
 	clientOpts := httptransport.TLSClientOptions{
 		InsecureSkipVerify: cfg.AllowInsecureHttps,
+		Certificate:        cfg.ClientCertFile,
+		Key:                cfg.ClientKeyFile,
+	}
+
+	if cfg.CACertFile != "" || cfg.CACertPEM != "" {
+		caCertPool, err := cfg.caCertPool()
+		if err != nil {
+			return nil, err
+		}
+		clientOpts.LoadedCAPool = caCertPool
 	}
 
 	trans, err := httptransport.TLSTransport(clientOpts)
@@ -61,10 +177,30 @@ func (cfg *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	// The standard transport requests gzip compressed responses and transparently
+	// decompresses them unless compression is disabled
+	if httpTransport, ok := trans.(*http.Transport); ok {
+		httpTransport.DisableCompression = cfg.DisableCompression
+	}
+
+	if cfg.DebugAPICalls {
+		trans = apiCallLogTransport{
+			original:   trans,
+			logContext: cfg.logContext(),
+		}
+	}
+
+	if cfg.CollectMetrics {
+		trans = metricsTransport{
+			original: trans,
+			metrics:  collectedAPIMetrics,
+		}
+	}
+
 	if cfg.Headers != nil && len(cfg.Headers) > 0 {
-		log.WithFields(log.Fields{
+		tflog.Debug(cfg.logContext(), "Setting custom headers on every request to Netbox", map[string]interface{}{
 			"custom_headers": cfg.Headers,
-		}).Debug("Setting custom headers on every request to Netbox")
+		})
 
 		trans = customHeaderTransport{
 			original: trans,
@@ -72,26 +208,473 @@ func (cfg *Config) Client() (interface{}, error) {
 		}
 	}
 
+	if cfg.Branch != "" {
+		tflog.Debug(cfg.logContext(), "Making all requests to Netbox in a branch", map[string]interface{}{
+			"branch": cfg.Branch,
+		})
+
+		trans = customHeaderTransport{
+			original: trans,
+			headers:  map[string]interface{}{branchHeader: cfg.Branch},
+		}
+	}
+
+	if cfg.MaxParallelRequests > 0 {
+		tflog.Debug(cfg.logContext(), "Limiting the number of parallel requests to Netbox", map[string]interface{}{
+			"max_parallel_requests": cfg.MaxParallelRequests,
+		})
+
+		trans = concurrencyLimitTransport{
+			original:  trans,
+			semaphore: make(chan struct{}, cfg.MaxParallelRequests),
+		}
+	}
+
+	if cfg.RequestsPerSecond > 0 {
+		tflog.Debug(cfg.logContext(), "Limiting the rate of requests to Netbox", map[string]interface{}{
+			"requests_per_second": cfg.RequestsPerSecond,
+		})
+
+		trans = rateLimitTransport{
+			original: trans,
+			limiter:  newTokenBucket(cfg.RequestsPerSecond),
+		}
+	}
+
+	if cfg.MaxRetries > 0 {
+		statusCodes := cfg.RetryOnStatusCodes
+		if len(statusCodes) == 0 {
+			statusCodes = defaultRetryOnStatusCodes
+		}
+
+		tflog.Debug(cfg.logContext(), "Retrying failed requests to Netbox", map[string]interface{}{
+			"max_retries":           cfg.MaxRetries,
+			"retry_on_status_codes": statusCodes,
+		})
+
+		trans = retryTransport{
+			original:    trans,
+			maxRetries:  cfg.MaxRetries,
+			statusCodes: statusCodes,
+			minBackoff:  time.Second,
+			maxBackoff:  30 * time.Second,
+			logContext:  cfg.logContext(),
+		}
+	}
+
 	httpClient := &http.Client{
 		Transport: trans,
 		Timeout:   time.Second * time.Duration(cfg.RequestTimeout),
 	}
 
-	transport := httptransport.NewWithClient(parsedURL.Host, parsedURL.Path+netboxclient.DefaultBasePath, desiredRuntimeClientSchemes, httpClient)
-	transport.DefaultAuthentication = httptransport.APIKeyAuth("Authorization", "header", fmt.Sprintf("Token %v", cfg.APIToken))
-	transport.SetLogger(log.StandardLogger())
+	basePath := cfg.BasePath
+	if basePath == "" {
+		basePath = netboxclient.DefaultBasePath
+	}
+
+	transport := httptransport.NewWithClient(parsedURL.Host, parsedURL.Path+basePath, desiredRuntimeClientSchemes, httpClient)
+	transport.SetLogger(tflogLogger{logContext: cfg.logContext()})
 	netboxClient := netboxclient.New(transport, nil)
 
+	apiToken := cfg.APIToken
+	if apiToken == "" {
+		apiToken, err = cfg.getProvisionedToken(netboxClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+	transport.DefaultAuthentication = httptransport.APIKeyAuth("Authorization", "header", fmt.Sprintf("Token %v", apiToken))
+
 	return netboxClient, nil
 }
 
-// RoundTrip adds the headers specified in the transport on every request.
+// provisionedToken is an API token provisioned from username and password.
+type provisionedToken struct {
+	id      int64
+	key     string
+	expires time.Time
+	api     *providerState
+}
+
+// provisionedTokens holds the API tokens provisioned by this process. A token is reused by later configurations of
+// the provider with the same credentials as long as at least half of its lifetime is left, and all tokens are revoked
+// by RevokeProvisionedTokens when the provider shuts down.
+var provisionedTokens = struct {
+	sync.Mutex
+	byCredentials map[string]*provisionedToken
+	all           []*provisionedToken
+}{byCredentials: map[string]*provisionedToken{}}
+
+// getProvisionedToken returns the key of an API token for the configured username and password, either a token that
+// was provisioned before by this process or a new one.
+func (cfg *Config) getProvisionedToken(netboxClient *netboxclient.NetBoxAPI) (string, error) {
+	lifetime := defaultProvisionedTokenLifetime
+	if cfg.TokenLifetime > 0 {
+		lifetime = time.Duration(cfg.TokenLifetime) * time.Minute
+	}
+	credentials := strings.Join([]string{cfg.ServerURL, cfg.BasePath, cfg.Username, hashSensitiveValue(cfg.Password)}, "\x00")
+
+	provisionedTokens.Lock()
+	defer provisionedTokens.Unlock()
+
+	if token, ok := provisionedTokens.byCredentials[credentials]; ok && time.Until(token.expires) > lifetime/2 {
+		tflog.Debug(cfg.logContext(), "Reusing provisioned Netbox API token", map[string]interface{}{
+			"username": cfg.Username,
+			"expires":  token.expires.Format(time.RFC3339),
+		})
+		return token.key, nil
+	}
+
+	token, userID, err := cfg.provisionToken(netboxClient, lifetime)
+	if err != nil {
+		return "", err
+	}
+	provisionedTokens.byCredentials[credentials] = token
+	provisionedTokens.all = append(provisionedTokens.all, token)
+
+	// Tokens of earlier runs that could not be revoked are deleted once they are expired, so they do not pile up
+	if err := deleteExpiredProvisionedTokens(token, userID); err != nil {
+		tflog.Warn(cfg.logContext(), "Could not delete expired provisioned Netbox API tokens", map[string]interface{}{
+			"username": cfg.Username,
+			"error":    err.Error(),
+		})
+	}
+
+	return token.key, nil
+}
+
+// provisionToken exchanges the configured username and password for a new API token that expires after the given
+// lifetime. The ID of the user of the token is returned as well.
+func (cfg *Config) provisionToken(netboxClient *netboxclient.NetBoxAPI, lifetime time.Duration) (*provisionedToken, int64, error) {
+	tflog.Debug(cfg.logContext(), "Provisioning Netbox API token", map[string]interface{}{
+		"username": cfg.Username,
+		"lifetime": lifetime.String(),
+	})
+
+	expires := time.Now().Add(lifetime)
+	data := map[string]interface{}{
+		"username":    cfg.Username,
+		"password":    cfg.Password,
+		"description": provisionedTokenDescription,
+		"expires":     expires.Format(time.RFC3339),
+	}
+
+	res, err := genericAPIRequest(&providerState{NetBoxAPI: netboxClient}, "POST", "/users/tokens/provision/", data)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error while trying to provision an API token for user %s: %s", cfg.Username, err)
+	}
+
+	key, ok := res["key"].(string)
+	if !ok || key == "" {
+		return nil, 0, fmt.Errorf("error while trying to provision an API token for user %s: no key returned", cfg.Username)
+	}
+	id, _ := getGenericObjectID(res)
+	userID, _ := getGenericNestedObjectID(res, "user")
+
+	return &provisionedToken{
+		id:      id,
+		key:     key,
+		expires: expires,
+		api:     &providerState{NetBoxAPI: netboxClient},
+	}, userID, nil
+}
+
+// request sends a request to Netbox that is authenticated with the token.
+func (token *provisionedToken) request(ctx context.Context, method string, path string, query url.Values) (map[string]interface{}, error) {
+	return genericAPIRequestWithQuery(token.api, method, path, query, nil, func(op *runtime.ClientOperation) {
+		op.AuthInfo = httptransport.APIKeyAuth("Authorization", "header", fmt.Sprintf("Token %v", token.key))
+		op.Context = ctx
+	})
+}
+
+// deleteExpiredProvisionedTokens deletes the expired API tokens of the user that were provisioned by the provider.
+func deleteExpiredProvisionedTokens(token *provisionedToken, userID int64) error {
+	if userID == 0 {
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("user_id", strconv.FormatInt(userID, 10))
+	query.Set("description", provisionedTokenDescription)
+	query.Set("expires__lt", time.Now().Format(time.RFC3339))
+	res, err := token.request(context.Background(), "GET", "/users/tokens/", query)
+	if err != nil {
+		return err
+	}
+
+	results, _ := res["results"].([]interface{})
+	for _, result := range results {
+		expiredToken, _ := result.(map[string]interface{})
+		id, err := getGenericObjectID(expiredToken)
+		if err != nil || expiredToken["description"] != provisionedTokenDescription {
+			continue
+		}
+		_, err = token.request(context.Background(), "DELETE", fmt.Sprintf("/users/tokens/%d/", id), nil)
+		if err != nil && !isGenericAPINotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeProvisionedTokens deletes the API tokens provisioned from username and password by this process. It is called
+// when the provider shuts down. Tokens that cannot be revoked in time are deleted by a later run once they are expired.
+func RevokeProvisionedTokens() {
+	provisionedTokens.Lock()
+	defer provisionedTokens.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), provisionedTokenRevokeTimeout)
+	defer cancel()
+
+	for _, token := range provisionedTokens.all {
+		if token.id != 0 {
+			token.request(ctx, "DELETE", fmt.Sprintf("/users/tokens/%d/", token.id), nil)
+		}
+	}
+	provisionedTokens.all = nil
+	provisionedTokens.byCredentials = map[string]*provisionedToken{}
+}
+
+// logContext returns the context used for logging.
+func (cfg *Config) logContext() context.Context {
+	if cfg.LogContext == nil {
+		return context.Background()
+	}
+	return cfg.LogContext
+}
+
+// caCertPool returns the system certificate pool extended by the configured CA certificates.
+func (cfg *Config) caCertPool() (*x509.CertPool, error) {
+	caCertPool, err := x509.SystemCertPool()
+	if err != nil {
+		tflog.Debug(cfg.logContext(), "Unable to load system certificate pool, using an empty pool instead", map[string]interface{}{
+			"error": err,
+		})
+		caCertPool = x509.NewCertPool()
+	}
+
+	caCertPEM := []byte(cfg.CACertPEM)
+	if cfg.CACertFile != "" {
+		caCertPEM, err = os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to read CA certificate file: %s", err)
+		}
+	}
+
+	if !caCertPool.AppendCertsFromPEM(caCertPEM) {
+		return nil, fmt.Errorf("no valid PEM encoded CA certificates found")
+	}
+
+	return caCertPool, nil
+}
+
+// RoundTrip sets the headers specified in the transport on every request. The
+// headers are set on a clone, as a RoundTripper must not modify the request of
+// the caller.
 func (t customHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 
+	req := r.Clone(r.Context())
 	for key, value := range t.headers {
-		r.Header.Add(key, fmt.Sprintf("%v", value))
+		req.Header.Set(key, fmt.Sprintf("%v", value))
+	}
+
+	resp, err := t.original.RoundTrip(req)
+	return resp, err
+}
+
+// RoundTrip waits for a free slot and then sends the request. The slot is
+// held until the response body is closed.
+func (t concurrencyLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() { <-t.semaphore })
+	}
+
+	resp, err := t.original.RoundTrip(r)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+	return resp, err
+}
+
+func (b releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// RoundTrip waits until the rate limit allows another request and then sends it.
+func (t rateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(r.Context()); err != nil {
+		return nil, err
 	}
+	return t.original.RoundTrip(r)
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, blocking until one is available or the context is done.
+// A waiting caller reserves its token upfront, so concurrent callers are served in order.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	b.tokens--
+	deficit := -b.tokens
+	b.mu.Unlock()
+
+	if deficit <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(time.Duration(deficit / b.rate * float64(time.Second)))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// Give the reserved token back
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RoundTrip retries the request as long as the response status code is one of
+// the status codes specified in the transport and retries are left. Every
+// attempt is sent as a fresh clone of the request of the caller, so changes of
+// inner transports, e.g. added headers, never carry over to the next attempt.
+func (t retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+
+	req := r.Clone(r.Context())
+	for attempt := 0; ; attempt++ {
+		resp, err := t.original.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !t.shouldRetry(r.Method, resp) {
+			return resp, err
+		}
+
+		// Requests with a body can only be retried if the body can be restored
+		next := r.Clone(r.Context())
+		if r.Body != nil && r.Body != http.NoBody {
+			if r.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := r.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			next.Body = body
+		}
+
+		wait := t.backoff(attempt, resp)
+		tflog.Debug(t.logContext, "Retrying request to Netbox", map[string]interface{}{
+			"method":  r.Method,
+			"url":     r.URL.String(),
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+		resp.Body.Close()
+
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-time.After(wait):
+		}
+		req = next
+	}
+}
 
+// shouldRetry returns true if the response status code is one of the status
+// codes specified in the transport. Requests that are not idempotent, e.g. the
+// allocation of available IP addresses, may already have been processed when a
+// gateway error is returned, so they are only retried if Netbox rejected them
+// explicitly with 429 or a Retry-After header.
+func (t retryTransport) shouldRetry(method string, resp *http.Response) bool {
+	if !slices.Contains(t.statusCodes, resp.StatusCode) {
+		return false
+	}
+	if isIdempotentMethod(method) {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.Header.Get("Retry-After") != ""
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the time to wait before the next attempt. The Retry-After
+// header is honored if set, otherwise the exponential backoff is jittered
+// to avoid all parallel requests retrying at the same time.
+func (t retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && retryAfter >= 0 {
+		wait := time.Duration(retryAfter) * time.Second
+		if wait > t.maxBackoff {
+			return t.maxBackoff
+		}
+		return wait
+	}
+
+	wait := t.minBackoff << attempt
+	if wait <= 0 || wait > t.maxBackoff {
+		wait = t.maxBackoff
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// RoundTrip logs the request and its result.
+func (t apiCallLogTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	start := time.Now()
 	resp, err := t.original.RoundTrip(r)
+
+	fields := map[string]interface{}{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"elapsed_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = resp.StatusCode
+	}
+	tflog.Info(t.logContext, "Netbox API call", fields)
+
 	return resp, err
 }
+
+func (l tflogLogger) Printf(format string, args ...interface{}) {
+	tflog.Info(l.logContext, fmt.Sprintf(format, args...))
+}
+
+func (l tflogLogger) Debugf(format string, args ...interface{}) {
+	tflog.Debug(l.logContext, fmt.Sprintf(format, args...))
+}
@@ -1,12 +1,31 @@
 package netbox
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/status"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -94,6 +113,636 @@ func TestAdditionalHeadersSet(t *testing.T) {
 	client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
 }
 
-/* TODO
-func TestInvalidHttpsCertificate(t *testing.T) {}
-*/
+func TestInvalidHttpsCertificate(t *testing.T) {
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.Error(t, err)
+}
+
+func TestInvalidHttpsCertificateAllowInsecure(t *testing.T) {
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:           "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:          ts.URL,
+		AllowInsecureHttps: true,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestCustomCACertificate(t *testing.T) {
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	defer ts.Close()
+
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	err := os.WriteFile(caCertFile, []byte(caCertPEM), 0600)
+	assert.NoError(t, err)
+
+	for _, config := range []Config{
+		{
+			APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+			ServerURL: ts.URL,
+			CACertPEM: caCertPEM,
+		},
+		{
+			APIToken:   "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+			ServerURL:  ts.URL,
+			CACertFile: caCertFile,
+		},
+	} {
+		client, err := config.Client()
+		assert.NoError(t, err)
+
+		req := status.NewStatusListParams()
+		_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+		assert.NoError(t, err)
+	}
+}
+
+func TestInvalidCACertificate(t *testing.T) {
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: "https://localhost:8080",
+		CACertPEM: "not a certificate",
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+
+	config = Config{
+		APIToken:   "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:  "https://localhost:8080",
+		CACertFile: filepath.Join(t.TempDir(), "missing.pem"),
+	}
+
+	_, err = config.Client()
+	assert.Error(t, err)
+}
+
+func TestClientCertificate(t *testing.T) {
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	ts.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	clientCertFile := filepath.Join(t.TempDir(), "client.pem")
+	err = os.WriteFile(clientCertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0600)
+	assert.NoError(t, err)
+	clientKeyFile := filepath.Join(t.TempDir(), "client.key")
+	err = os.WriteFile(clientKeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	assert.NoError(t, err)
+
+	config := Config{
+		APIToken:           "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:          ts.URL,
+		AllowInsecureHttps: true,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.Error(t, err)
+
+	config.ClientCertFile = clientCertFile
+	config.ClientKeyFile = clientKeyFile
+
+	client, err = config.Client()
+	assert.NoError(t, err)
+
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestRequestTimeout(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(2 * time.Second)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 1,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.Error(t, err)
+
+	config.RequestTimeout = 5
+
+	client, err = config.Client()
+	assert.NoError(t, err)
+
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestRetryTransport(t *testing.T) {
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "payload", string(body))
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	trans := retryTransport{
+		original:    http.DefaultTransport,
+		maxRetries:  2,
+		statusCodes: defaultRetryOnStatusCodes,
+		minBackoff:  time.Millisecond,
+		maxBackoff:  10 * time.Millisecond,
+		logContext:  context.Background(),
+	}
+
+	req, _ := http.NewRequest("PUT", ts.URL, strings.NewReader("payload"))
+	originalBody := req.Body
+	resp, err := trans.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, requests)
+	// The request of the caller is not modified
+	assert.Equal(t, originalBody, req.Body)
+
+	// Give up after the maximum number of retries
+	requests = 0
+	trans.maxRetries = 1
+	req, _ = http.NewRequest("PUT", ts.URL, strings.NewReader("payload"))
+	resp, err = trans.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+
+	// Do not retry status codes that are not configured
+	requests = 0
+	trans.maxRetries = 2
+	trans.statusCodes = []int{429}
+	req, _ = http.NewRequest("PUT", ts.URL, strings.NewReader("payload"))
+	resp, err = trans.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, requests)
+}
+
+func TestRetryTransportCustomHeaders(t *testing.T) {
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// The headers of inner transports are not duplicated on retries
+		assert.Equal(t, []string{"1a2b3c4d"}, r.Header.Values(branchHeader))
+		assert.Equal(t, []string{"bar"}, r.Header.Values("X-Foo"))
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	trans := retryTransport{
+		original: customHeaderTransport{
+			original: customHeaderTransport{
+				original: http.DefaultTransport,
+				headers:  map[string]interface{}{"X-Foo": "bar"},
+			},
+			headers: map[string]interface{}{branchHeader: "1a2b3c4d"},
+		},
+		maxRetries:  2,
+		statusCodes: defaultRetryOnStatusCodes,
+		minBackoff:  time.Millisecond,
+		maxBackoff:  10 * time.Millisecond,
+		logContext:  context.Background(),
+	}
+
+	req, _ := http.NewRequest("GET", ts.URL, nil)
+	resp, err := trans.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, requests)
+	// The request of the caller is not modified
+	assert.Empty(t, req.Header)
+}
+
+func TestRetryTransportNonIdempotent(t *testing.T) {
+
+	requests := 0
+	status := http.StatusServiceUnavailable
+	retryAfter := ""
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "payload", string(body))
+		if requests < 2 {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	trans := retryTransport{
+		original:    http.DefaultTransport,
+		maxRetries:  2,
+		statusCodes: defaultRetryOnStatusCodes,
+		minBackoff:  time.Millisecond,
+		maxBackoff:  10 * time.Millisecond,
+		logContext:  context.Background(),
+	}
+
+	// A gateway error does not tell whether a create was processed, so it is not retried
+	req, _ := http.NewRequest("POST", ts.URL, strings.NewReader("payload"))
+	resp, err := trans.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, requests)
+
+	// Unless Netbox asks to retry the request
+	requests = 0
+	retryAfter = "0"
+	req, _ = http.NewRequest("POST", ts.URL, strings.NewReader("payload"))
+	resp, err = trans.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+
+	// Rate limited requests were not processed and are retried
+	requests = 0
+	retryAfter = ""
+	status = http.StatusTooManyRequests
+	req, _ = http.NewRequest("PATCH", ts.URL, strings.NewReader("payload"))
+	resp, err = trans.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+
+	trans := retryTransport{
+		minBackoff: time.Second,
+		maxBackoff: 30 * time.Second,
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := trans.backoff(attempt, resp)
+		assert.LessOrEqual(t, wait, trans.maxBackoff)
+		assert.Greater(t, wait, time.Duration(0))
+	}
+
+	resp.Header.Set("Retry-After", "5")
+	assert.Equal(t, 5*time.Second, trans.backoff(0, resp))
+
+	resp.Header.Set("Retry-After", "120")
+	assert.Equal(t, trans.maxBackoff, trans.backoff(0, resp))
+}
+
+func TestRateLimitTransport(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	trans := rateLimitTransport{
+		original: http.DefaultTransport,
+		limiter:  newTokenBucket(20),
+	}
+
+	// The first 20 requests use up the burst, the next 10 requests take about half a second
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		req, _ := http.NewRequest("GET", ts.URL, nil)
+		resp, err := trans.RoundTrip(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 400*time.Millisecond)
+	assert.Less(t, elapsed, 2*time.Second)
+}
+
+func TestAPICallLogTransport(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer ts.Close()
+
+	var output bytes.Buffer
+	trans := apiCallLogTransport{
+		original:   http.DefaultTransport,
+		logContext: tflogtest.RootLogger(context.Background(), &output),
+	}
+
+	req, _ := http.NewRequest("GET", ts.URL+"/api/status/", nil)
+	resp, err := trans.RoundTrip(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "Netbox API call", entries[0]["@message"])
+	assert.Equal(t, "GET", entries[0]["method"])
+	assert.Equal(t, "/api/status/", entries[0]["path"])
+	assert.Equal(t, float64(http.StatusTeapot), entries[0]["status"])
+	assert.Contains(t, entries[0], "elapsed_ms")
+}
+
+func TestProvisionTokenFromUsernameAndPassword(t *testing.T) {
+
+	var mu sync.Mutex
+	provisioned := 0
+	deleted := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/users/tokens/provision/" {
+			var data map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&data)
+			if data["username"] != "admin" || data["password"] != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"detail": "Invalid credentials"}`))
+				return
+			}
+			assert.Equal(t, provisionedTokenDescription, data["description"])
+			expires, err := time.Parse(time.RFC3339, data["expires"].(string))
+			assert.NoError(t, err)
+			assert.WithinDuration(t, time.Now().Add(30*time.Minute), expires, time.Minute)
+			mu.Lock()
+			provisioned++
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 1, "user": {"id": 2}, "key": "0123456789abcdef0123456789abcdef01234567"}`))
+			return
+		}
+		assert.Equal(t, "Token 0123456789abcdef0123456789abcdef01234567", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/users/tokens/":
+			assert.Equal(t, "2", r.URL.Query().Get("user_id"))
+			assert.Equal(t, provisionedTokenDescription, r.URL.Query().Get("description"))
+			assert.NotEmpty(t, r.URL.Query().Get("expires__lt"))
+			w.Write([]byte(`{"count": 2, "results": [{"id": 5, "description": "` + provisionedTokenDescription + `"}, {"id": 6, "description": "manual"}]}`))
+		case r.Method == "DELETE":
+			mu.Lock()
+			deleted = append(deleted, r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		ServerURL:     ts.URL,
+		Username:      "admin",
+		Password:      "secret",
+		TokenLifetime: 30,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+	// Only the expired tokens of the provider are deleted
+	assert.Equal(t, []string{"/api/users/tokens/5/"}, deleted)
+
+	// The token is reused by later configurations with the same credentials
+	_, err = config.Client()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, provisioned)
+
+	config.Password = "wrong"
+	_, err = config.Client()
+	assert.Error(t, err)
+
+	// The token is revoked when the provider shuts down
+	deleted = []string{}
+	RevokeProvisionedTokens()
+	assert.Equal(t, []string{"/api/users/tokens/1/"}, deleted)
+}
+
+func TestConcurrencyLimitTransport(t *testing.T) {
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	trans := concurrencyLimitTransport{
+		original:  http.DefaultTransport,
+		semaphore: make(chan struct{}, 2),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", ts.URL, nil)
+			resp, err := trans.RoundTrip(req)
+			assert.NoError(t, err)
+			io.ReadAll(resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, maxInFlight)
+	assert.Len(t, trans.semaphore, 0)
+}
+
+func TestCustomBasePath(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/netbox/custom-api/status/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL + "/netbox",
+		BasePath:  "/custom-api",
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestCompression(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+		gz.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	res, err := client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "3.4.3", res.GetPayload().(map[string]interface{})["netbox-version"])
+}
+
+func TestDisableCompression(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Accept-Encoding"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "3.4.3"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:           "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:          ts.URL,
+		DisableCompression: true,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestURLMissingHostShouldFail(t *testing.T) {
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: "https://",
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
+
+func TestBranchHeaderSet(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "td5smq0f", r.Header.Get("X-NetBox-Branch"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.2.0"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+		Branch:    "td5smq0f",
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.(*netboxClient.NetBoxAPI).Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
@@ -1,6 +1,11 @@
 package netbox
 
 import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,12 +19,207 @@ var customFieldsSchema = &schema.Schema{
 		Type:    schema.TypeString,
 		Default: nil,
 	},
+	Description: "Map of custom field names to values. Values are given as strings and converted to the type of the custom field in Netbox: integer, decimal, boolean and object fields take their literal value, e.g. `\"42\"` or `\"true\"`, while JSON, multiple selection and multiple object fields take JSON, e.g. `jsonencode([\"a\", \"b\"])`. An empty string clears the custom field. Custom fields that are not given here are left untouched, unless `manage_all_custom_fields` is set in the provider configuration.",
+}
+
+// customFieldsComputedSchema is the schema of the custom fields of data sources.
+var customFieldsComputedSchema = &schema.Schema{
+	Type:     schema.TypeMap,
+	Computed: true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+	Description: "Map of custom field names to values. Numbers and booleans are given as literals, e.g. `\"42\"`, references to objects as ID and all other values as JSON, e.g. `[\"a\",\"b\"]`.",
 }
 
+// getCustomFields returns the custom fields of an object read from Netbox as a map of strings, as Terraform knows
+// custom fields only as a map of strings. Numbers and booleans are formatted as literals, references to objects as the
+// ID of the object and all other values as JSON. It returns nil if the object has no custom fields.
 func getCustomFields(cf interface{}) map[string]interface{} {
 	cfm, ok := cf.(map[string]interface{})
 	if !ok || len(cfm) == 0 {
 		return nil
 	}
-	return cfm
+	result := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		result[name] = flattenCustomFieldValue(value)
+	}
+	return result
+}
+
+// getManagedCustomFields returns the custom fields of an object read from Netbox like getCustomFields. Unless the
+// provider manages all custom fields, only the custom fields that are given in the resource data are returned, so that
+// custom fields set outside of Terraform do not show up as drift.
+func getManagedCustomFields(api *providerState, d *schema.ResourceData, cf interface{}) map[string]interface{} {
+	customFields := getCustomFields(cf)
+	if customFields == nil || api.manageAllCustomFields {
+		return customFields
+	}
+	managed := d.Get(customFieldsKey).(map[string]interface{})
+	result := make(map[string]interface{}, len(managed))
+	for name, value := range customFields {
+		if _, ok := managed[name]; ok {
+			result[name] = value
+		}
+	}
+	return result
+}
+
+// flattenCustomFieldValue returns the string representation of a single custom field value read from Netbox.
+func flattenCustomFieldValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	case map[string]interface{}:
+		// Object fields are returned as nested object, but written as ID
+		if id, ok := getCustomFieldObjectID(v); ok {
+			return id
+		}
+	case []interface{}:
+		// Multiple object fields are returned as list of nested objects, but written as list of IDs
+		ids := make([]json.Number, 0, len(v))
+		for _, item := range v {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				break
+			}
+			id, ok := getCustomFieldObjectID(object)
+			if !ok {
+				break
+			}
+			ids = append(ids, json.Number(id))
+		}
+		if len(v) > 0 && len(ids) == len(v) {
+			value = ids
+		}
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return string(encoded)
+}
+
+// getCustomFieldObjectID returns the ID of a nested object in the value of an object custom field. Nested objects are
+// told apart from the value of a JSON custom field by their URL.
+func getCustomFieldObjectID(object map[string]interface{}) (string, bool) {
+	if _, ok := object["url"].(string); !ok {
+		return "", false
+	}
+	switch id := object["id"].(type) {
+	case float64:
+		return strconv.FormatFloat(id, 'f', -1, 64), true
+	case json.Number:
+		return id.String(), true
+	}
+	return "", false
+}
+
+// getCustomFieldsFromResourceData returns the custom fields of the resource data to send to Netbox. Netbox merges the
+// sent custom fields into the existing ones, so custom fields that are not configured are left untouched. If the
+// provider manages all custom fields, custom fields that were removed from the configuration are cleared instead.
+// It returns nil if there are no custom fields to send.
+func getCustomFieldsFromResourceData(api *providerState, d *schema.ResourceData) interface{} {
+	oldValue, newValue := d.GetChange(customFieldsKey)
+	cf := map[string]interface{}{}
+	for name, value := range newValue.(map[string]interface{}) {
+		cf[name] = value
+	}
+	if api.manageAllCustomFields {
+		for name := range oldValue.(map[string]interface{}) {
+			if _, ok := cf[name]; !ok {
+				cf[name] = nil
+			}
+		}
+	}
+	if len(cf) == 0 {
+		return nil
+	}
+	return encodeCustomFields(api, cf)
+}
+
+// encodeCustomFields returns the configured custom fields converted to the types of the custom fields in Netbox.
+// Custom field names are unique in Netbox, so the types are looked up by name. If the types cannot be looked up or a
+// value does not match the type of its custom field, the value is sent as configured and Netbox reports the error.
+func encodeCustomFields(api *providerState, cf interface{}) interface{} {
+	cfm, ok := cf.(map[string]interface{})
+	if !ok || len(cfm) == 0 {
+		return cf
+	}
+
+	types := getCustomFieldTypes(api, cfm)
+
+	result := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		result[name] = encodeCustomFieldValue(types[name], value)
+	}
+	return result
+}
+
+// getCustomFieldTypes returns the types of the custom fields with the names of the keys of the given map.
+func getCustomFieldTypes(api *providerState, cf map[string]interface{}) map[string]string {
+	names := make([]string, 0, len(cf))
+	for name := range cf {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	types := map[string]string{}
+	if api == nil || api.NetBoxAPI == nil {
+		return types
+	}
+	customFields, err := genericAPIList(api, "/extras/custom-fields/", url.Values{"name": names})
+	if err != nil {
+		return types
+	}
+	for _, customField := range customFields {
+		name, _ := customField["name"].(string)
+		if cfType, ok := customField["type"].(map[string]interface{}); ok {
+			types[name], _ = cfType["value"].(string)
+		}
+	}
+	return types
+}
+
+// encodeCustomFieldValue converts a configured custom field value to the given custom field type.
+func encodeCustomFieldValue(cfType string, value interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	if s == "" {
+		if cfType == "" {
+			return s
+		}
+		return nil
+	}
+	switch cfType {
+	case "integer", "object":
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+	case "decimal":
+		// Numbers are sent as literal to retain their precision
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			return json.Number(s)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b
+		}
+	case "json", "multiselect", "multiobject":
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(s), &decoded); err == nil {
+			return decoded
+		}
+	}
+	return s
 }
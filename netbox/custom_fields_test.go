@@ -0,0 +1,156 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCustomFields(t *testing.T) {
+	assert.Nil(t, getCustomFields(nil))
+	assert.Nil(t, getCustomFields(map[string]interface{}{}))
+
+	cf := getCustomFields(map[string]interface{}{
+		"text":        "value",
+		"unset":       nil,
+		"integer":     float64(42),
+		"generic":     json.Number("7"),
+		"decimal":     float64(1.5),
+		"boolean":     true,
+		"json":        map[string]interface{}{"b": float64(1), "a": []interface{}{"x"}},
+		"multiselect": []interface{}{"a", "b"},
+		"object":      map[string]interface{}{"id": float64(3), "url": "http://netbox/api/dcim/sites/3/", "display": "site"},
+		"multiobject": []interface{}{
+			map[string]interface{}{"id": json.Number("4"), "url": "http://netbox/api/dcim/sites/4/"},
+			map[string]interface{}{"id": json.Number("5"), "url": "http://netbox/api/dcim/sites/5/"},
+		},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"text":        "value",
+		"unset":       nil,
+		"integer":     "42",
+		"generic":     "7",
+		"decimal":     "1.5",
+		"boolean":     "true",
+		"json":        `{"a":["x"],"b":1}`,
+		"multiselect": `["a","b"]`,
+		"object":      "3",
+		"multiobject": "[4,5]",
+	}, cf)
+}
+
+func TestEncodeCustomFieldValue(t *testing.T) {
+	for _, tc := range []struct {
+		cfType   string
+		value    string
+		expected interface{}
+	}{
+		{"text", "42", "42"},
+		{"integer", "42", int64(42)},
+		{"integer", "not a number", "not a number"},
+		{"decimal", "1.50", json.Number("1.50")},
+		{"boolean", "true", true},
+		{"boolean", "false", false},
+		{"object", "3", int64(3)},
+		{"json", `{"a":1}`, map[string]interface{}{"a": float64(1)}},
+		{"multiselect", `["a","b"]`, []interface{}{"a", "b"}},
+		{"multiobject", "[4,5]", []interface{}{float64(4), float64(5)}},
+		{"integer", "", nil},
+		{"", "", ""},
+		{"", "42", "42"},
+	} {
+		assert.Equal(t, tc.expected, encodeCustomFieldValue(tc.cfType, tc.value), "%s %q", tc.cfType, tc.value)
+	}
+}
+
+func TestEncodeCustomFields(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/extras/custom-fields/", r.URL.Path)
+		assert.Equal(t, []string{"count", "enabled", "owner"}, r.URL.Query()["name"])
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 2, "results": [
+			{"id": 1, "name": "count", "type": {"value": "integer", "label": "Integer"}},
+			{"id": 2, "name": "enabled", "type": {"value": "boolean", "label": "Boolean (true/false)"}}
+		]}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*netboxClient.NetBoxAPI)}
+
+	cf := encodeCustomFields(api, map[string]interface{}{
+		"count":   "5",
+		"enabled": "true",
+		// Unknown custom fields are sent as configured, so that Netbox reports them
+		"owner": "team-a",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"count":   int64(5),
+		"enabled": true,
+		"owner":   "team-a",
+	}, cf)
+}
+
+// customFieldsResourceData returns resource data of a tenant whose custom fields change from the custom fields in the
+// state to the configured ones.
+func customFieldsResourceData(t *testing.T, state map[string]string, config map[string]interface{}) *schema.ResourceData {
+	r := resourceNetboxTenant()
+	attributes := map[string]string{"name": "test", "slug": "test"}
+	for k, v := range state {
+		attributes["custom_fields."+k] = v
+	}
+	attributes["custom_fields.%"] = strconv.Itoa(len(state))
+	instanceState := &terraform.InstanceState{ID: "1", Attributes: attributes}
+
+	diff, err := r.Diff(context.Background(), instanceState, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "test",
+		"slug":          "test",
+		"custom_fields": config,
+	}), nil)
+	assert.NoError(t, err)
+	d, err := schema.InternalMap(r.Schema).Data(instanceState, diff)
+	assert.NoError(t, err)
+	return d
+}
+
+func TestGetManagedCustomFields(t *testing.T) {
+	d := customFieldsResourceData(t, map[string]string{"managed": "a"}, map[string]interface{}{"managed": "b"})
+	cf := map[string]interface{}{"managed": "c", "unmanaged": "d"}
+
+	// Custom fields set outside of Terraform are ignored
+	assert.Equal(t, map[string]interface{}{"managed": "c"}, getManagedCustomFields(&providerState{}, d, cf))
+	assert.Nil(t, getManagedCustomFields(&providerState{}, d, map[string]interface{}{}))
+
+	// unless the provider manages all custom fields
+	api := &providerState{manageAllCustomFields: true}
+	assert.Equal(t, map[string]interface{}{"managed": "c", "unmanaged": "d"}, getManagedCustomFields(api, d, cf))
+}
+
+func TestGetCustomFieldsFromResourceData(t *testing.T) {
+	// Only configured custom fields are sent, removed custom fields are no longer managed
+	d := customFieldsResourceData(t, map[string]string{"kept": "a", "removed": "b"}, map[string]interface{}{"kept": "c"})
+	assert.Equal(t, map[string]interface{}{"kept": "c"}, getCustomFieldsFromResourceData(&providerState{}, d))
+
+	// If the provider manages all custom fields, removed custom fields are cleared
+	api := &providerState{manageAllCustomFields: true}
+	assert.Equal(t, map[string]interface{}{"kept": "c", "removed": nil}, getCustomFieldsFromResourceData(api, d))
+
+	// Nothing is sent if there are no custom fields
+	d = customFieldsResourceData(t, map[string]string{}, map[string]interface{}{})
+	assert.Nil(t, getCustomFieldsFromResourceData(&providerState{}, d))
+	assert.Nil(t, getCustomFieldsFromResourceData(api, d))
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,13 +39,14 @@ for more information on available lookup expressions.`,
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchemaRead,
+			"tags":          tagsSchemaRead,
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamAsnsListParams()
 
@@ -79,5 +79,6 @@ func dataSourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", result.Description)
 	d.Set("tags", getTagListFromNestedTagList(result.Tags))
 	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -55,7 +54,8 @@ func dataSourceNetboxAsns() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
-						"tags": tagsSchemaRead,
+						"tags":          tagsSchemaRead,
+						"custom_fields": customFieldsComputedSchema,
 					},
 				},
 			},
@@ -64,7 +64,7 @@ func dataSourceNetboxAsns() *schema.Resource {
 }
 
 func dataSourceNetboxAsnsRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamAsnsListParams()
 
@@ -112,6 +112,7 @@ func dataSourceNetboxAsnsRead(d *schema.ResourceData, m interface{}) error {
 		mapping["asn"] = v.Asn
 		mapping["rir_id"] = v.Rir
 		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+		mapping["custom_fields"] = getCustomFields(v.CustomFields)
 
 		s = append(s, mapping)
 	}
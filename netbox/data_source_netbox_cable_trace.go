@@ -0,0 +1,172 @@
+package netbox
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cableTraceObjectTypes are the content types whose cable path can be traced.
+var cableTraceObjectTypes = []string{"dcim.interface", "dcim.consoleport", "dcim.consoleserverport", "dcim.powerport", "dcim.poweroutlet", "dcim.powerfeed"}
+
+var cableTraceTerminationSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"object_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"object_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"device_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The ID of the device of the termination. Not set for terminations that do not belong to a device, e.g. circuit terminations.",
+		},
+	},
+}
+
+func dataSourceNetboxCableTrace() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxCableTraceRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This data source traces the cable path starting at the given interface or port, e.g. through patch panels and circuits. Each segment of the path consists of the terminations on the near end, the cable and the terminations on the far end.`,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cableTraceObjectTypes, false),
+				Description:  "The content type of the origin of the trace. One of `dcim.interface`, `dcim.consoleport`, `dcim.consoleserverport`, `dcim.powerport`, `dcim.poweroutlet` or `dcim.powerfeed`.",
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"segments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"near_end": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     cableTraceTerminationSchema,
+						},
+						"cable_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the cable of the segment. Not set if the path ends without a cable.",
+						},
+						"far_end": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     cableTraceTerminationSchema,
+						},
+					},
+				},
+			},
+			"far_end": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        cableTraceTerminationSchema,
+				Description: "The terminations at the far end of the complete path, e.g. the interface of the connected device.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxCableTraceRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	objectType := d.Get("object_type").(string)
+	objectID := int64(d.Get("object_id").(int))
+
+	path, err := getObjectAPIPath(objectType, objectID)
+	if err != nil {
+		return err
+	}
+
+	res, err := genericAPIRawRequest(api, "GET", path+"trace/", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	segments, err := flattenCableTrace(res)
+	if err != nil {
+		return err
+	}
+
+	farEnd := []map[string]interface{}{}
+	if len(segments) > 0 {
+		farEnd = segments[len(segments)-1]["far_end"].([]map[string]interface{})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", objectType, objectID))
+	d.Set("segments", segments)
+	d.Set("far_end", farEnd)
+	return nil
+}
+
+// flattenCableTrace converts the response of a trace endpoint to the segments attribute. Netbox returns each
+// segment as a list of the near end terminations, the cable and the far end terminations.
+func flattenCableTrace(trace interface{}) ([]map[string]interface{}, error) {
+	traceSegments, ok := trace.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected cable trace, expected a list of segments")
+	}
+
+	segments := []map[string]interface{}{}
+	for _, traceSegment := range traceSegments {
+		parts, ok := traceSegment.([]interface{})
+		if !ok || len(parts) != 3 {
+			return nil, fmt.Errorf("unexpected cable trace segment, expected near end, cable and far end")
+		}
+
+		segment := map[string]interface{}{
+			"near_end": flattenCableTraceTerminations(parts[0]),
+			"far_end":  flattenCableTraceTerminations(parts[2]),
+		}
+		if cable, ok := parts[1].(map[string]interface{}); ok {
+			if cableID, err := getGenericObjectID(cable); err == nil {
+				segment["cable_id"] = cableID
+			}
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+func flattenCableTraceTerminations(terminations interface{}) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	terminationList, _ := terminations.([]interface{})
+	for _, t := range terminationList {
+		termination, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		mapping := map[string]interface{}{}
+		if objectURL, ok := termination["url"].(string); ok {
+			mapping["object_type"] = getContentTypeFromObjectURL(objectURL)
+		}
+		if id, err := getGenericObjectID(termination); err == nil {
+			mapping["object_id"] = id
+		}
+		if name, ok := termination["name"].(string); ok {
+			mapping["name"] = name
+		} else if display, ok := termination["display"].(string); ok {
+			mapping["name"] = strings.TrimSpace(display)
+		}
+		if deviceID, ok := getGenericNestedObjectID(termination, "device"); ok {
+			mapping["device_id"] = deviceID
+		}
+		result = append(result, mapping)
+	}
+	return result
+}
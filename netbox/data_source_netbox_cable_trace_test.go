@@ -0,0 +1,88 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxCableTraceDataSource_basic(t *testing.T) {
+	testSlug := "cable_trace_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxCableFullDependencies(testName) + `
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.test1.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.test2.id
+  }
+  status = "connected"
+}
+
+data "netbox_cable_trace" "test" {
+  object_type = "dcim.interface"
+  object_id   = netbox_device_interface.test1.id
+
+  depends_on = [netbox_cable.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "segments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "segments.0.cable_id", "netbox_cable.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "segments.0.near_end.0.object_id", "netbox_device_interface.test1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "far_end.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "far_end.0.object_type", "dcim.interface"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "far_end.0.object_id", "netbox_device_interface.test2", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "far_end.0.name", fmt.Sprintf("%s_2", testName)),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "far_end.0.device_id", "netbox_device.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenCableTrace(t *testing.T) {
+	var trace interface{}
+	decoder := json.NewDecoder(strings.NewReader(`[
+  [
+    [{"id": 1, "url": "https://netbox.example.com/api/dcim/interfaces/1/", "name": "eth0", "device": {"id": 10}}],
+    {"id": 100, "url": "https://netbox.example.com/api/dcim/cables/100/"},
+    [{"id": 5, "url": "https://netbox.example.com/api/dcim/front-ports/5/", "name": "1", "device": {"id": 20}}]
+  ],
+  [
+    [{"id": 6, "url": "https://netbox.example.com/api/dcim/rear-ports/6/", "name": "1", "device": {"id": 20}}],
+    null,
+    []
+  ]
+]`))
+	decoder.UseNumber()
+	assert.NoError(t, decoder.Decode(&trace))
+
+	segments, err := flattenCableTrace(trace)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"near_end": []map[string]interface{}{{"object_type": "dcim.interface", "object_id": int64(1), "name": "eth0", "device_id": int64(10)}},
+			"cable_id": int64(100),
+			"far_end":  []map[string]interface{}{{"object_type": "dcim.frontport", "object_id": int64(5), "name": "1", "device_id": int64(20)}},
+		},
+		{
+			"near_end": []map[string]interface{}{{"object_type": "dcim.rearport", "object_id": int64(6), "name": "1", "device_id": int64(20)}},
+			"far_end":  []map[string]interface{}{},
+		},
+	}, segments)
+
+	_, err = flattenCableTrace(map[string]interface{}{"detail": "Not found."})
+	assert.Error(t, err)
+}
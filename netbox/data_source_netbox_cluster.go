@@ -4,7 +4,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -34,13 +33,14 @@ func dataSourceNetboxCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			tagsKey: tagsSchemaRead,
+			tagsKey:         tagsSchemaRead,
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := virtualization.NewVirtualizationClustersListParams()
@@ -78,5 +78,6 @@ func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,12 +21,13 @@ func dataSourceNetboxClusterType() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxClusterTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := virtualization.NewVirtualizationClusterTypesListParams()
@@ -50,5 +50,6 @@ func dataSourceNetboxClusterTypeRead(d *schema.ResourceData, m interface{}) erro
 	d.Set("cluster_type_id", result.ID)
 	d.SetId(strconv.FormatInt(result.ID, 10))
 	d.Set("name", result.Name)
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
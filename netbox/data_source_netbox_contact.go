@@ -0,0 +1,97 @@
+package netbox
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxContact() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxContactRead,
+		Description: `:meta:subcategory:Tenancy:`,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "email"},
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "email"},
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the `netbox_contact_group` of the contact. If set, only contacts of this group are considered.",
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":          tagsSchemaRead,
+			customFieldsKey: customFieldsComputedSchema,
+		},
+	}
+}
+
+func dataSourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	params := tenancy.NewTenancyContactsListParams()
+
+	if name, ok := d.Get("name").(string); ok && name != "" {
+		params.Name = &name
+	}
+
+	if email, ok := d.Get("email").(string); ok && email != "" {
+		params.Email = &email
+	}
+
+	if groupID, ok := d.Get("group_id").(int); ok && groupID != 0 {
+		params.GroupID = strToPtr(strconv.Itoa(groupID))
+	}
+
+	limit := int64(2) // Limit of 2 is enough
+	params.Limit = &limit
+
+	res, err := api.Tenancy.TenancyContactsList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	if *res.GetPayload().Count > int64(1) {
+		return errors.New("more than one result, specify a more narrow filter")
+	}
+	if *res.GetPayload().Count == int64(0) {
+		return errors.New("no result")
+	}
+	result := res.GetPayload().Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("name", result.Name)
+	d.Set("email", result.Email)
+	if result.Group != nil {
+		d.Set("group_id", result.Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+	d.Set("title", result.Title)
+	d.Set("phone", result.Phone)
+	d.Set("address", result.Address)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
+	return nil
+}
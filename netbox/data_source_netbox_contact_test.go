@@ -0,0 +1,50 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxContactDataSource_basic(t *testing.T) {
+	testSlug := "contact_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_contact_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_contact" "test" {
+  name     = "%[1]s"
+  email    = "%[1]s@example.com"
+  phone    = "123456789"
+  group_id = netbox_contact_group.test.id
+}
+
+data "netbox_contact" "by_name" {
+  depends_on = [netbox_contact.test]
+  name       = "%[1]s"
+}
+
+data "netbox_contact" "by_email" {
+  depends_on = [netbox_contact.test]
+  email      = "%[1]s@example.com"
+  group_id   = netbox_contact_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_contact.by_name", "id", "netbox_contact.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_contact.by_name", "email", testName+"@example.com"),
+					resource.TestCheckResourceAttr("data.netbox_contact.by_name", "phone", "123456789"),
+					resource.TestCheckResourceAttrPair("data.netbox_contact.by_name", "group_id", "netbox_contact_group.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_contact.by_email", "id", "netbox_contact.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_contact.by_email", "name", testName),
+				),
+			},
+		},
+	})
+}
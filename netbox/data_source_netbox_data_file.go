@@ -0,0 +1,85 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDataFile() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDataFileRead,
+		Description: `:meta:subcategory:Extras:This data source returns a data file of a data source, e.g. to use it as the data file of a config template. Data files only exist after the data source was synced, e.g. with the ` + "`netbox_data_source_sync`" + ` resource. This data source requires Netbox 3.5 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the data source of the data file.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the data file relative to the root of the data source, e.g. `templates/leaf.j2`.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the data file in bytes.",
+			},
+			"hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 hash of the content of the data file.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetboxDataFileRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(dataSourcesNetboxVersion) {
+		return fmt.Errorf("netbox_data_file requires Netbox %s or later, but the Netbox version is %s", dataSourcesNetboxVersion, api.netboxVersion)
+	}
+
+	query := url.Values{}
+	query.Set("source_id", strconv.Itoa(d.Get("source_id").(int)))
+	query.Set("path", d.Get("path").(string))
+	query.Set("limit", "2") // Limit of 2 is enough
+
+	res, err := genericAPIRequestWithQuery(api, "GET", "/core/data-files/", query, nil)
+	if err != nil {
+		return err
+	}
+
+	count, _ := getGenericInt(res, "count")
+	if count > int64(1) {
+		return errors.New("more than one result, specify a more narrow filter")
+	}
+	results, _ := res["results"].([]interface{})
+	if count == int64(0) || len(results) == 0 {
+		return errors.New("no result")
+	}
+	dataFile, _ := results[0].(map[string]interface{})
+
+	id, err := getGenericObjectID(dataFile)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+	d.Set("path", dataFile["path"])
+	if size, ok := getGenericInt(dataFile, "size"); ok {
+		d.Set("size", size)
+	}
+	d.Set("hash", dataFile["hash"])
+	d.Set("last_updated", dataFile["last_updated"])
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// deviceInterfacesFilters maps the supported filters of the netbox_device_interfaces data source to the query
+// parameters of Netbox.
+var deviceInterfacesFilters = map[string]string{
+	"device_id":   "device_id",
+	"name":        "name",
+	"type":        "type",
+	"enabled":     "enabled",
+	"mgmt_only":   "mgmt_only",
+	"tag":         "tag",
+	"mac_address": "mac_address",
+	"kind":        "kind",
+}
+
+// interfaceVlanSchema is the schema of the VLANs of an interface returned by interface data sources.
+var interfaceVlanSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"vid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	},
+}
+
+func dataSourceNetboxDeviceInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDeviceInterfacesRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This data source returns the interfaces of devices that match all given filters, e.g. all enabled interfaces of a device. Use the ` + "`netbox_interfaces`" + ` data source for the interfaces of virtual machines. This data source is not covered by the generated API client and therefore uses the generic API of the provider.`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter. One of `device_id`, `name`, `type`, `enabled`, `mgmt_only`, `tag`, `mac_address` or `kind`. `enabled` and `mgmt_only` take `true` or `false`, `kind` one of `physical`, `virtual` or `wireless`.",
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only return the interfaces whose name matches this regular expression.",
+			},
+			"interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mgmt_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mtu": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The 802.1Q mode of the interface, e.g. `access` or `tagged`. Empty if the interface has no mode.",
+						},
+						"untagged_vlan": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     interfaceVlanSchema,
+						},
+						"tagged_vlans": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     interfaceVlanSchema,
+						},
+						tagsKey:         tagsSchemaRead,
+						"custom_fields": customFieldsComputedSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxDeviceInterfacesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query, err := getDeviceInterfacesQuery(d)
+	if err != nil {
+		return err
+	}
+
+	interfaces, err := genericAPIList(api, "/dcim/interfaces/", query)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if value, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(value.(string))
+	}
+
+	s := []map[string]interface{}{}
+	for _, iface := range interfaces {
+		name, _ := iface["name"].(string)
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		s = append(s, flattenDeviceInterface(iface))
+	}
+
+	if len(s) == 0 {
+		return errors.New("no result")
+	}
+
+	d.SetId(resource.UniqueId())
+	return d.Set("interfaces", s)
+}
+
+// getDeviceInterfacesQuery returns the query parameters for the configured filters.
+func getDeviceInterfacesQuery(d *schema.ResourceData) (url.Values, error) {
+	query := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		for _, f := range filter.(*schema.Set).List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			parameter, ok := deviceInterfacesFilters[k]
+			if !ok {
+				return nil, fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+			query.Add(parameter, v)
+		}
+	}
+	return query, nil
+}
+
+func flattenDeviceInterface(iface map[string]interface{}) map[string]interface{} {
+	mapping := map[string]interface{}{
+		"name":          iface["name"],
+		"label":         iface["label"],
+		"description":   iface["description"],
+		"enabled":       iface["enabled"],
+		"mgmt_only":     iface["mgmt_only"],
+		"mac_address":   iface["mac_address"],
+		tagsKey:         getTagListFromNestedTagList(getNestedTagListFromGenericObject(iface)),
+		"custom_fields": getCustomFields(iface[customFieldsKey]),
+	}
+	if id, ok := getGenericInt(iface, "id"); ok {
+		mapping["id"] = id
+	}
+	if deviceID, ok := getGenericNestedObjectID(iface, "device"); ok {
+		mapping["device_id"] = deviceID
+	}
+	if interfaceType, ok := iface["type"].(map[string]interface{}); ok {
+		mapping["type"] = interfaceType["value"]
+	}
+	if mtu, ok := getGenericInt(iface, "mtu"); ok {
+		mapping["mtu"] = mtu
+	}
+	if mode, ok := iface["mode"].(map[string]interface{}); ok {
+		mapping["mode"] = mode["value"]
+	}
+	if untaggedVlan, ok := iface["untagged_vlan"].(map[string]interface{}); ok {
+		mapping["untagged_vlan"] = []map[string]interface{}{flattenGenericVlan(untaggedVlan)}
+	}
+	taggedVlans := []map[string]interface{}{}
+	vlanList, _ := iface["tagged_vlans"].([]interface{})
+	for _, vlan := range vlanList {
+		if vlanMap, ok := vlan.(map[string]interface{}); ok {
+			taggedVlans = append(taggedVlans, flattenGenericVlan(vlanMap))
+		}
+	}
+	mapping["tagged_vlans"] = taggedVlans
+	return mapping
+}
+
+func flattenGenericVlan(vlan map[string]interface{}) map[string]interface{} {
+	mapping := map[string]interface{}{
+		"name": vlan["name"],
+	}
+	if id, ok := getGenericInt(vlan, "id"); ok {
+		mapping["id"] = id
+	}
+	if vid, ok := getGenericInt(vlan, "vid"); ok {
+		mapping["vid"] = vid
+	}
+	return mapping
+}
@@ -0,0 +1,177 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDeviceInterfacesQuery(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxDeviceInterfaces().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "device_id", "value": "1"},
+			map[string]interface{}{"name": "mgmt_only", "value": "true"},
+		},
+	})
+	query, err := getDeviceInterfacesQuery(d)
+	assert.NoError(t, err)
+	assert.Equal(t, "device_id=1&mgmt_only=true", query.Encode())
+
+	d = schema.TestResourceDataRaw(t, dataSourceNetboxDeviceInterfaces().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "vm_id", "value": "1"},
+		},
+	})
+	_, err = getDeviceInterfacesQuery(d)
+	assert.Error(t, err)
+}
+
+func TestFlattenDeviceInterface(t *testing.T) {
+	var iface map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(`{
+		"id": 7,
+		"device": {"id": 3},
+		"name": "eth0",
+		"label": "",
+		"type": {"value": "1000base-t"},
+		"enabled": true,
+		"mgmt_only": false,
+		"mtu": 9000,
+		"mac_address": "AA:BB:CC:DD:EE:FF",
+		"description": "uplink",
+		"mode": {"value": "tagged"},
+		"untagged_vlan": {"id": 1, "vid": 10, "name": "data"},
+		"tagged_vlans": [{"id": 2, "vid": 20, "name": "voice"}],
+		"tags": [{"name": "uplink", "slug": "uplink"}],
+		"custom_fields": {}
+	}`))
+	decoder.UseNumber()
+	assert.NoError(t, decoder.Decode(&iface))
+
+	mapping := flattenDeviceInterface(iface)
+	assert.Equal(t, int64(7), mapping["id"])
+	assert.Equal(t, int64(3), mapping["device_id"])
+	assert.Equal(t, "1000base-t", mapping["type"])
+	assert.Equal(t, int64(9000), mapping["mtu"])
+	assert.Equal(t, "tagged", mapping["mode"])
+	assert.Equal(t, []map[string]interface{}{{"id": int64(1), "vid": int64(10), "name": "data"}}, mapping["untagged_vlan"])
+	assert.Equal(t, []map[string]interface{}{{"id": int64(2), "vid": int64(20), "name": "voice"}}, mapping["tagged_vlans"])
+	assert.Equal(t, []string{"uplink"}, mapping[tagsKey])
+}
+
+func TestAccNetboxDeviceInterfacesDataSource_basic(t *testing.T) {
+	testSlug := "device_interfaces_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid  = 1234
+}
+
+resource "netbox_device_interface" "eth0" {
+  name          = "eth0"
+  device_id     = netbox_device.test.id
+  type          = "1000base-t"
+  mtu           = 9000
+  mode          = "access"
+  untagged_vlan = netbox_vlan.test.id
+}
+
+resource "netbox_device_interface" "eth1" {
+  name      = "eth1"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+  enabled   = false
+}
+
+resource "netbox_device_interface" "mgmt0" {
+  name      = "mgmt0"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+  mgmtonly  = true
+}
+
+data "netbox_device_interfaces" "all" {
+  depends_on = [netbox_device_interface.eth0, netbox_device_interface.eth1, netbox_device_interface.mgmt0]
+  filter {
+    name  = "device_id"
+    value = netbox_device.test.id
+  }
+}
+
+data "netbox_device_interfaces" "ethernet_enabled" {
+  depends_on = [netbox_device_interface.eth0, netbox_device_interface.eth1, netbox_device_interface.mgmt0]
+  name_regex = "^eth"
+  filter {
+    name  = "device_id"
+    value = netbox_device.test.id
+  }
+  filter {
+    name  = "enabled"
+    value = "true"
+  }
+}
+
+data "netbox_device_interfaces" "mgmt_only" {
+  depends_on = [netbox_device_interface.eth0, netbox_device_interface.eth1, netbox_device_interface.mgmt0]
+  filter {
+    name  = "device_id"
+    value = netbox_device.test.id
+  }
+  filter {
+    name  = "mgmt_only"
+    value = "true"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.all", "interfaces.#", "3"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.ethernet_enabled", "interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_interfaces.ethernet_enabled", "interfaces.0.id", "netbox_device_interface.eth0", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_interfaces.ethernet_enabled", "interfaces.0.device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.ethernet_enabled", "interfaces.0.type", "1000base-t"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.ethernet_enabled", "interfaces.0.mtu", "9000"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.ethernet_enabled", "interfaces.0.mode", "access"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_interfaces.ethernet_enabled", "interfaces.0.untagged_vlan.0.id", "netbox_vlan.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.ethernet_enabled", "interfaces.0.untagged_vlan.0.vid", "1234"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.mgmt_only", "interfaces.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.mgmt_only", "interfaces.0.name", "mgmt0"),
+				),
+			},
+		},
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,13 +25,14 @@ func dataSourceNetboxDeviceRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			tagsKey: tagsSchemaRead,
+			tagsKey:         tagsSchemaRead,
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := dcim.NewDcimDeviceRolesListParams()
@@ -57,5 +57,6 @@ func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error
 	d.Set("slug", result.Slug)
 	d.Set("color_hex", result.Color)
 	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
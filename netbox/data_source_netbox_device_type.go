@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -42,12 +41,13 @@ func dataSourceNetboxDeviceType() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Computed: true,
 			},
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := dcim.NewDcimDeviceTypesListParams()
 
 	params.Limit = int64ToPtr(2)
@@ -80,5 +80,6 @@ func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error
 	d.Set("part_number", result.PartNumber)
 	d.Set("slug", result.Slug)
 	d.Set("u_height", result.UHeight)
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
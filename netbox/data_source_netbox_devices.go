@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"regexp"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -110,6 +109,12 @@ func dataSourceNetboxDevices() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"config_context": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The rendered config context of the device as JSON string.",
+						},
+						"custom_fields": customFieldsComputedSchema,
 					},
 				},
 			},
@@ -118,7 +123,7 @@ func dataSourceNetboxDevices() *schema.Resource {
 }
 
 func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := dcim.NewDcimDevicesListParams()
 
@@ -160,7 +165,7 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 		params.Limit = &limitInt
 	}
 
-	res, err := api.Dcim.DcimDevicesList(params, nil)
+	res, err := api.Dcim.DcimDevicesList(params, nil, withIncludeConfigContext)
 	if err != nil {
 		return err
 	}
@@ -223,6 +228,12 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 		if device.Status != nil {
 			mapping["status"] = *device.Status.Value
 		}
+		configContext, err := getConfigContextJSON(device.ConfigContext)
+		if err != nil {
+			return err
+		}
+		mapping["config_context"] = configContext
+		mapping["custom_fields"] = getCustomFields(device.CustomFields)
 		s = append(s, mapping)
 	}
 
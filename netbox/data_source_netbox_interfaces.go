@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"regexp"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -128,6 +127,7 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"custom_fields": customFieldsComputedSchema,
 					},
 				},
 			},
@@ -136,7 +136,7 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 }
 
 func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := virtualization.NewVirtualizationInterfacesListParams()
 
@@ -149,6 +149,8 @@ func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error
 			switch k {
 			case "cluster_id":
 				params.ClusterID = &vString
+			case "enabled":
+				params.Enabled = &vString
 			case "mac_address":
 				params.MacAddress = &vString
 			case "name":
@@ -223,6 +225,7 @@ func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error
 		}
 
 		mapping["vm_id"] = v.VirtualMachine.ID
+		mapping["custom_fields"] = getCustomFields(v.CustomFields)
 
 		s = append(s, mapping)
 	}
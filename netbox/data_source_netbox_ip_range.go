@@ -4,7 +4,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,12 +23,13 @@ func dataSourceNetboxIpRange() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.IsCIDR,
 			},
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxIpRangeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	contains := d.Get("contains").(string)
 
@@ -53,5 +53,6 @@ func dataSourceNetboxIpRangeRead(d *schema.ResourceData, m interface{}) error {
 	result := res.GetPayload().Results[0]
 	d.Set("id", result.ID)
 	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
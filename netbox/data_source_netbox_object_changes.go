@@ -0,0 +1,228 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// objectChangesCoreNetboxVersion is the first Netbox version with the changelog in the core app instead of extras.
+const objectChangesCoreNetboxVersion = "4.1.0"
+
+func dataSourceNetboxObjectChanges() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxObjectChangesRead,
+		Description: `:meta:subcategory:Extras:This data source returns the records of the changelog of Netbox that match the given filters, the most recent change first. All changes of a single request, e.g. of a single resource during an apply, share the same request ID. This data source is not covered by the generated API client and therefore uses the generic API of the provider.`,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return changes of objects of this type, e.g. `dcim.device`.",
+			},
+			"object_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only return changes of the object with this ID. Usually combined with `object_type`.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"create", "update", "delete"}, false),
+				Description:  "Only return changes of this action. One of `create`, `update` or `delete`.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return changes made by the user with this username.",
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only return changes made by the user with this ID.",
+			},
+			"request_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Only return changes made by the request with this ID.",
+			},
+			"time_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only return changes made at or after this time, e.g. `2024-01-31T12:00:00Z`.",
+			},
+			"time_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only return changes made at or before this time, e.g. `2024-01-31T13:00:00Z`.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 1000)),
+				Default:          100,
+				Description:      "The maximum number of changes to return.",
+			},
+			"changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the user that made the change. Not set if the user was deleted.",
+						},
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"object_repr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The string representation of the object at the time of the change.",
+						},
+						"prechange_data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data of the object before the change as JSON. Empty for created objects.",
+						},
+						"postchange_data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data of the object after the change as JSON. Empty for deleted objects.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxObjectChangesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := getObjectChangesQuery(d)
+	res, err := genericAPIRequestWithQuery(api, "GET", getObjectChangesAPIPath(api), query, nil)
+	if err != nil {
+		return err
+	}
+
+	changes := []map[string]interface{}{}
+	results, _ := res["results"].([]interface{})
+	for _, result := range results {
+		change, ok := result.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mapping, err := flattenObjectChange(change)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, mapping)
+	}
+
+	d.SetId(resource.UniqueId())
+	return d.Set("changes", changes)
+}
+
+// getObjectChangesAPIPath returns the path of the changelog in the Netbox version.
+func getObjectChangesAPIPath(api *providerState) string {
+	if api.hasNetboxVersion(objectChangesCoreNetboxVersion) {
+		return "/core/object-changes/"
+	}
+	return "/extras/object-changes/"
+}
+
+// getObjectChangesQuery returns the query parameters for the configured filters.
+func getObjectChangesQuery(d *schema.ResourceData) url.Values {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(d.Get("limit").(int)))
+	query.Set("ordering", "-time")
+	for attribute, parameter := range map[string]string{
+		"object_type": "changed_object_type",
+		"action":      "action",
+		"username":    "user",
+		"request_id":  "request_id",
+		"time_after":  "time_after",
+		"time_before": "time_before",
+	} {
+		if value, ok := d.GetOk(attribute); ok {
+			query.Set(parameter, value.(string))
+		}
+	}
+	for attribute, parameter := range map[string]string{
+		"object_id": "changed_object_id",
+		"user_id":   "user_id",
+	} {
+		if value, ok := d.GetOk(attribute); ok {
+			query.Set(parameter, strconv.Itoa(value.(int)))
+		}
+	}
+	return query
+}
+
+func flattenObjectChange(change map[string]interface{}) (map[string]interface{}, error) {
+	mapping := map[string]interface{}{
+		"time":        change["time"],
+		"username":    change["user_name"],
+		"request_id":  change["request_id"],
+		"object_type": change["changed_object_type"],
+		"object_repr": change["object_repr"],
+	}
+	if id, ok := getGenericInt(change, "id"); ok {
+		mapping["id"] = id
+	}
+	if userID, ok := getGenericNestedObjectID(change, "user"); ok {
+		mapping["user_id"] = userID
+	}
+	if action, ok := change["action"].(map[string]interface{}); ok {
+		mapping["action"] = action["value"]
+	}
+	if objectID, ok := getGenericInt(change, "changed_object_id"); ok {
+		mapping["object_id"] = objectID
+	}
+
+	prechangeData, err := formatOptionalJSON(change["prechange_data"])
+	if err != nil {
+		return nil, err
+	}
+	mapping["prechange_data"] = prechangeData
+
+	postchangeData, err := formatOptionalJSON(change["postchange_data"])
+	if err != nil {
+		return nil, err
+	}
+	mapping["postchange_data"] = postchangeData
+
+	return mapping, nil
+}
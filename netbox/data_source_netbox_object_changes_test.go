@@ -0,0 +1,99 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxObjectChangesDataSource_basic(t *testing.T) {
+	testSlug := "obj_changes_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%s"
+}
+
+data "netbox_object_changes" "test" {
+  object_type = "extras.tag"
+  object_id   = netbox_tag.test.id
+  action      = "create"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.0.action", "create"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.0.object_type", "extras.tag"),
+					resource.TestCheckResourceAttrPair("data.netbox_object_changes.test", "changes.0.object_id", "netbox_tag.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.0.object_repr", testName),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.0.prechange_data", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestGetObjectChangesQuery(t *testing.T) {
+	d := dataSourceNetboxObjectChanges().TestResourceData()
+	d.Set("object_type", "dcim.device")
+	d.Set("object_id", 5)
+	d.Set("username", "terraform")
+	d.Set("time_after", "2024-01-31T12:00:00Z")
+	d.Set("limit", 10)
+
+	query := getObjectChangesQuery(d)
+	assert.Equal(t, "dcim.device", query.Get("changed_object_type"))
+	assert.Equal(t, "5", query.Get("changed_object_id"))
+	assert.Equal(t, "terraform", query.Get("user"))
+	assert.Equal(t, "2024-01-31T12:00:00Z", query.Get("time_after"))
+	assert.Equal(t, "10", query.Get("limit"))
+	assert.Equal(t, "-time", query.Get("ordering"))
+	assert.NotContains(t, query, "user_id")
+	assert.NotContains(t, query, "request_id")
+}
+
+func TestGetObjectChangesAPIPath(t *testing.T) {
+	assert.Equal(t, "/core/object-changes/", getObjectChangesAPIPath(&providerState{netboxVersion: "4.1.0"}))
+	assert.Equal(t, "/extras/object-changes/", getObjectChangesAPIPath(&providerState{netboxVersion: "4.0.11"}))
+}
+
+func TestFlattenObjectChange(t *testing.T) {
+	mapping, err := flattenObjectChange(map[string]interface{}{
+		"id":                  json.Number("12"),
+		"time":                "2024-01-31T12:34:56.789012Z",
+		"user":                map[string]interface{}{"id": json.Number("3"), "username": "terraform"},
+		"user_name":           "terraform",
+		"request_id":          "0f6b6e3e-41a2-4a3b-9d6e-8f2f5b3a1c2d",
+		"action":              map[string]interface{}{"value": "update", "label": "Updated"},
+		"changed_object_type": "dcim.device",
+		"changed_object_id":   json.Number("5"),
+		"object_repr":         "router1",
+		"prechange_data":      map[string]interface{}{"status": "planned"},
+		"postchange_data":     map[string]interface{}{"status": "active"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), mapping["id"])
+	assert.Equal(t, int64(3), mapping["user_id"])
+	assert.Equal(t, "update", mapping["action"])
+	assert.Equal(t, int64(5), mapping["object_id"])
+	assert.Equal(t, `{"status":"planned"}`, mapping["prechange_data"])
+	assert.Equal(t, `{"status":"active"}`, mapping["postchange_data"])
+
+	// The user is null if it was deleted, the data is null for created and deleted objects
+	mapping, err = flattenObjectChange(map[string]interface{}{
+		"user":            nil,
+		"action":          map[string]interface{}{"value": "create"},
+		"prechange_data":  nil,
+		"postchange_data": map[string]interface{}{"status": "active"},
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, mapping, "user_id")
+	assert.Equal(t, "", mapping["prechange_data"])
+}
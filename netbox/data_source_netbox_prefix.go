@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,7 +12,7 @@ import (
 func dataSourceNetboxPrefix() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxPrefixRead,
-		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This data source looks up a single prefix, e.g. by its CIDR and VRF or by its description or tag. It fails unless exactly one prefix matches all given filters.`,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeInt,
@@ -79,13 +78,37 @@ for more information on available lookup expressions.`,
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchemaRead,
+			"role_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the prefix in percent. Container prefixes are utilized by their child prefixes, all other prefixes by their child IP addresses and IP ranges.",
+			},
+			"children": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of child prefixes.",
+			},
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of the prefix in the prefix hierarchy, `0` for a top-level prefix.",
+			},
+			"tags":          tagsSchemaRead,
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamPrefixesListParams()
 
@@ -147,6 +170,14 @@ func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("status", result.Status.Value)
 	d.Set("description", result.Description)
 	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	d.Set("children", result.Children)
+	d.Set("depth", result.Depth)
+
+	utilization, err := getPrefixUtilization(api, result)
+	if err != nil {
+		return err
+	}
+	d.Set("utilization", utilization)
 
 	if result.Vrf != nil {
 		d.Set("vrf_id", result.Vrf.ID)
@@ -158,6 +189,13 @@ func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 	if result.Site != nil {
 		d.Set("site_id", result.Site.ID)
 	}
+	if result.Role != nil {
+		d.Set("role_id", result.Role.ID)
+	}
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	}
 	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
@@ -87,6 +87,9 @@ data "netbox_prefix" "by_site_id" {
 					resource.TestCheckResourceAttrPair("data.netbox_prefix.by_vlan_id", "id", "netbox_prefix.test", "id"),
 					resource.TestCheckResourceAttrPair("data.netbox_prefix.by_vlan_vid", "id", "netbox_prefix.test", "id"),
 					resource.TestCheckResourceAttrPair("data.netbox_prefix.by_site_id", "id", "netbox_prefix.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.by_prefix", "utilization", "0"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.by_prefix", "children", "0"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.by_prefix", "depth", "0"),
 				),
 			},
 		},
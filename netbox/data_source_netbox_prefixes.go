@@ -3,8 +3,8 @@ package netbox
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +14,7 @@ import (
 func dataSourceNetboxPrefixes() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxPrefixesRead,
-		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This data source returns the prefixes that match all given filters.`,
 		Schema: map[string]*schema.Schema{
 			"filter": {
 				Type:     schema.TypeSet,
@@ -22,8 +22,9 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter. One of `prefix`, `family`, `status`, `vrf_id`, `vlan_id`, `vlan_vid`, `site_id`, `role_id`, `tenant_id`, `tag`, `mask_length`, `contains`, `within` or `within_include`. `contains` returns the prefixes that contain the given prefix or IP address, `within` the prefixes within the given prefix and `within_include` the same including the given prefix itself.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -67,6 +68,24 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"site_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"role_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						tagsKey:         tagsSchemaRead,
+						"custom_fields": customFieldsComputedSchema,
 					},
 				},
 			},
@@ -75,33 +94,11 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 }
 
 func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
-	params := ipam.NewIpamPrefixesListParams()
-
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "prefix":
-				params.Prefix = &vString
-			case "vlan_vid":
-				params.VlanVid = v.(*float64)
-			case "vrf_id":
-				params.VrfID = &vString
-			case "vlan_id":
-				params.VlanID = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
+	params, err := getPrefixesListParams(d)
+	if err != nil {
+		return err
 	}
 
 	res, err := api.Ipam.IpamPrefixesList(params, nil)
@@ -129,6 +126,18 @@ func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
 			mapping["vrf_id"] = v.Vrf.ID
 		}
 		mapping["status"] = v.Status.Value
+		mapping["description"] = v.Description
+		if v.Site != nil {
+			mapping["site_id"] = v.Site.ID
+		}
+		if v.Role != nil {
+			mapping["role_id"] = v.Role.ID
+		}
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		mapping[tagsKey] = getTagListFromNestedTagList(v.Tags)
+		mapping["custom_fields"] = getCustomFields(v.CustomFields)
 
 		s = append(s, mapping)
 	}
@@ -136,3 +145,63 @@ func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
 	d.SetId(resource.UniqueId())
 	return d.Set("prefixes", s)
 }
+
+// getPrefixesListParams returns the parameters of the prefix list for the configured limit and filters.
+func getPrefixesListParams(d *schema.ResourceData) (*ipam.IpamPrefixesListParams, error) {
+	params := ipam.NewIpamPrefixesListParams()
+
+	if limitValue, ok := d.GetOk("limit"); ok {
+		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"]
+			v := f.(map[string]interface{})["value"]
+			vString := v.(string)
+			switch k {
+			case "prefix":
+				params.Prefix = &vString
+			case "family":
+				if vString != "4" && vString != "6" {
+					return nil, fmt.Errorf("the value of the family filter must be 4 or 6, got %q", vString)
+				}
+				family, _ := strconv.ParseFloat(vString, 64)
+				params.Family = &family
+			case "status":
+				params.Status = &vString
+			case "vlan_vid":
+				vlanVid, err := strconv.ParseFloat(vString, 64)
+				if err != nil {
+					return nil, fmt.Errorf("the value of the vlan_vid filter must be a number, got %q", vString)
+				}
+				params.VlanVid = &vlanVid
+			case "vrf_id":
+				params.VrfID = &vString
+			case "vlan_id":
+				params.VlanID = &vString
+			case "site_id":
+				params.SiteID = &vString
+			case "role_id":
+				params.RoleID = &vString
+			case "tenant_id":
+				params.TenantID = &vString
+			case "tag":
+				params.Tag = &vString
+			case "mask_length":
+				params.MaskLength = &vString
+			case "contains":
+				params.Contains = &vString
+			case "within":
+				params.Within = &vString
+			case "within_include":
+				params.WithinInclude = &vString
+			default:
+				return nil, fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+		}
+	}
+
+	return params, nil
+}
@@ -5,8 +5,43 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestGetPrefixesListParams(t *testing.T) {
+	filters := func(filters map[string]string) *schema.ResourceData {
+		filterList := []interface{}{}
+		for name, value := range filters {
+			filterList = append(filterList, map[string]interface{}{"name": name, "value": value})
+		}
+		return schema.TestResourceDataRaw(t, dataSourceNetboxPrefixes().Schema, map[string]interface{}{"filter": filterList})
+	}
+
+	params, err := getPrefixesListParams(filters(map[string]string{
+		"family":         "6",
+		"status":         "active",
+		"site_id":        "1",
+		"role_id":        "2",
+		"vlan_vid":       "100",
+		"within_include": "10.0.0.0/8",
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, float64(6), *params.Family)
+	assert.Equal(t, "active", *params.Status)
+	assert.Equal(t, "1", *params.SiteID)
+	assert.Equal(t, "2", *params.RoleID)
+	assert.Equal(t, float64(100), *params.VlanVid)
+	assert.Equal(t, "10.0.0.0/8", *params.WithinInclude)
+
+	_, err = getPrefixesListParams(filters(map[string]string{"family": "5"}))
+	assert.Error(t, err)
+	_, err = getPrefixesListParams(filters(map[string]string{"vlan_vid": "one"}))
+	assert.Error(t, err)
+	_, err = getPrefixesListParams(filters(map[string]string{"unknown": "1"}))
+	assert.Error(t, err)
+}
+
 func TestAccNetboxPrefixesDataSource_basic(t *testing.T) {
 
 	testPrefixes := []string{"10.0.4.0/24", "10.0.5.0/24", "10.0.6.0/24"}
@@ -59,6 +94,22 @@ data "netbox_prefixes" "by_vrf" {
   }
 }
 
+data "netbox_prefixes" "within_include" {
+  depends_on = [netbox_prefix.test_prefix1, netbox_prefix.test_prefix2, netbox_prefix.without_vrf_and_vlan]
+  filter {
+    name  = "within_include"
+    value = "10.0.4.0/23"
+  }
+  filter {
+    name  = "family"
+    value = "4"
+  }
+  filter {
+    name  = "status"
+    value = "active"
+  }
+}
+
 data "netbox_prefixes" "find_prefix_without_vrf_and_vlan" {
   depends_on = [netbox_prefix.without_vrf_and_vlan]
   filter {
@@ -70,6 +121,7 @@ data "netbox_prefixes" "find_prefix_without_vrf_and_vlan" {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.netbox_prefixes.by_vrf", "prefixes.#", "2"),
 					resource.TestCheckResourceAttrPair("data.netbox_prefixes.by_vrf", "prefixes.1.vlan_vid", "netbox_vlan.test_vlan2", "vid"),
+					resource.TestCheckResourceAttr("data.netbox_prefixes.within_include", "prefixes.#", "2"),
 				),
 			},
 		},
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -56,12 +55,13 @@ func dataSourceNetboxRegion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := dcim.NewDcimRegionsListParams()
 
@@ -108,5 +108,6 @@ func dataSourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
 	if result.Parent != nil {
 		d.Set("parent_region_id", result.Parent.ID)
 	}
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -12,22 +11,36 @@ import (
 func dataSourceNetboxSite() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxSiteRead,
-		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This data source looks up a site by exactly one of ` + "`site_id`, `name` or `slug`" + `.`,
 		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"site_id", "name", "slug"},
+			},
 			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"site_id", "name", "slug"},
 			},
 			"slug": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"site_id", "name", "slug"},
 			},
 			"asn_ids": {
-				Type:     schema.TypeSet,
+				Type: schema.TypeSet,
+				// Optional is only kept for existing configurations that set it
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				Deprecated:  "Setting `asn_ids` has no effect, as it is not used to look up the site. It will only be read from the site in a future release.",
+				Description: "The IDs of the ASNs of the site.",
 			},
 			"comments": {
 				Type:     schema.TypeString,
@@ -49,10 +62,6 @@ func dataSourceNetboxSite() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"site_id": {
-				Type:     schema.TypeInt,
-				Computed: true,
-			},
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -61,15 +70,40 @@ func dataSourceNetboxSite() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"facility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latitude": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"longitude": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"physical_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shipping_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey:         tagsSchemaRead,
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := dcim.NewDcimSitesListParams()
 
 	params.Limit = int64ToPtr(2)
+	if id, ok := d.GetOk("site_id"); ok {
+		params.SetID(strToPtr(strconv.Itoa(id.(int))))
+	}
 	if name, ok := d.Get("name").(string); ok && name != "" {
 		params.SetName(&name)
 	}
@@ -95,6 +129,12 @@ func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("site_id", site.ID)
 	d.Set("slug", site.Slug)
 	d.Set("time_zone", site.TimeZone)
+	d.Set("facility", site.Facility)
+	d.Set("latitude", site.Latitude)
+	d.Set("longitude", site.Longitude)
+	d.Set("physical_address", site.PhysicalAddress)
+	d.Set("shipping_address", site.ShippingAddress)
+	d.Set(tagsKey, getTagListFromNestedTagList(site.Tags))
 
 	if site.Group != nil {
 		d.Set("group_id", site.Group.ID)
@@ -109,5 +149,6 @@ func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant_id", site.Tenant.ID)
 	}
 
+	d.Set(customFieldsKey, getCustomFields(site.CustomFields))
 	return nil
 }
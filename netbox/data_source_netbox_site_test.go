@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxSiteSetUp(testName string) string {
@@ -56,6 +58,32 @@ data "netbox_site" "test" {
 }`, testName)
 }
 
+func testAccNetboxSiteByID() string {
+	return `
+data "netbox_site" "test" {
+  site_id = netbox_site.test.id
+}`
+}
+
+func TestNetboxSiteDataSourceLookupValidation(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		config map[string]interface{}
+		valid  bool
+	}{
+		{name: "ByID", config: map[string]interface{}{"site_id": 1}, valid: true},
+		{name: "ByName", config: map[string]interface{}{"name": "hq"}, valid: true},
+		{name: "BySlug", config: map[string]interface{}{"slug": "hq"}, valid: true},
+		{name: "None", config: map[string]interface{}{}, valid: false},
+		{name: "NameAndSlug", config: map[string]interface{}{"name": "hq", "slug": "hq"}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := dataSourceNetboxSite().Validate(terraform.NewResourceConfigRaw(tt.config))
+			assert.Equal(t, tt.valid, !diags.HasError(), diags)
+		})
+	}
+}
+
 func TestAccNetboxSiteDataSource_basic(t *testing.T) {
 	testName := testAccGetTestName("site_ds_basic")
 	setUp := testAccNetboxSiteSetUp(testName)
@@ -91,6 +119,15 @@ func TestAccNetboxSiteDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.netbox_site.test", "tenant_id", "netbox_tenant.test", "id"),
 				),
 			},
+			{
+				Config: setUp + testAccNetboxSiteByID(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_site.test", "id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_site.test", "name", testName),
+					resource.TestCheckResourceAttr("data.netbox_site.test", "slug", testName),
+					resource.TestCheckResourceAttr("data.netbox_site.test", "asn_ids.#", "1"),
+				),
+			},
 		},
 	})
 }
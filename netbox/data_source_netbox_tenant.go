@@ -4,7 +4,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -34,12 +33,13 @@ func dataSourceNetboxTenant() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxTenantRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := tenancy.NewTenancyTenantsListParams()
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -72,5 +72,6 @@ func dataSourceNetboxTenantRead(d *schema.ResourceData, m interface{}) error {
 	if result.Group != nil {
 		d.Set("group_id", result.Group.ID)
 	}
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
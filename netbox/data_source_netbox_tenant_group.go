@@ -4,7 +4,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -30,12 +29,13 @@ func dataSourceNetboxTenantGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxTenantGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := tenancy.NewTenancyTenantGroupsListParams()
@@ -62,5 +62,6 @@ func dataSourceNetboxTenantGroupRead(d *schema.ResourceData, m interface{}) erro
 	if result.Parent != nil {
 		d.Set("parent_id", result.Parent.ID)
 	}
+	d.Set(customFieldsKey, getCustomFields(result.CustomFields))
 	return nil
 }
@@ -0,0 +1,142 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxVirtualMachineRead,
+		Description: `:meta:subcategory:Virtualization:This data source returns a single virtual machine, looked up by its ID or name. Use the ` + "`netbox_virtual_machines`" + ` data source to look up multiple virtual machines.`,
+		Schema: map[string]*schema.Schema{
+			"vm_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"vm_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"vm_id", "name"},
+			},
+			"cluster_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set, only virtual machines of this cluster are considered.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set, only virtual machines of this site are considered.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_context": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			customFieldsKey: customFieldsComputedSchema,
+			"disk_size_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"local_context_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"memory_mb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"platform_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"primary_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_ip4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_ip6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vcpus": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	params := virtualization.NewVirtualizationVirtualMachinesListParams()
+
+	if vmID, ok := d.Get("vm_id").(int); ok && vmID != 0 {
+		params.ID = strToPtr(strconv.Itoa(vmID))
+	}
+
+	if name, ok := d.Get("name").(string); ok && name != "" {
+		params.Name = &name
+	}
+
+	if clusterID, ok := d.Get("cluster_id").(int); ok && clusterID != 0 {
+		params.ClusterID = strToPtr(strconv.Itoa(clusterID))
+	}
+
+	if siteID, ok := d.Get("site_id").(int); ok && siteID != 0 {
+		params.SiteID = strToPtr(strconv.Itoa(siteID))
+	}
+
+	limit := int64(2) // Limit of 2 is enough
+	params.Limit = &limit
+
+	res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil, withIncludeConfigContext)
+	if err != nil {
+		return err
+	}
+
+	if count := *res.GetPayload().Count; count != int64(1) {
+		return fmt.Errorf("expected one virtual machine, but got %d", count)
+	}
+
+	result := res.GetPayload().Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	for k, v := range flattenVirtualMachine(result) {
+		d.Set(k, v)
+	}
+	return nil
+}
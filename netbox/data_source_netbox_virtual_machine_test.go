@@ -0,0 +1,97 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxVirtualMachineDataSourceRead(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/virtualization/virtual-machines/", r.URL.Path)
+		assert.Equal(t, "test", r.URL.Query().Get("name"))
+		w.Header().Set("Content-Type", "application/json")
+		// Netbox omits the config context from list responses unless it is included
+		if r.URL.Query().Get("include") != "config_context" {
+			w.Write([]byte(`{"count": 1, "results": [{"id": 4, "name": "test", "custom_fields": {"rack_units": 2}}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 1, "results": [{"id": 4, "name": "test", "config_context": {"ntp_servers": ["10.0.0.1"]}, "custom_fields": {"rack_units": 2}}]}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxVirtualMachine().Schema, map[string]interface{}{
+		"name": "test",
+	})
+	assert.NoError(t, dataSourceNetboxVirtualMachineRead(d, api))
+	assert.Equal(t, "4", d.Id())
+	assert.Equal(t, `{"ntp_servers":["10.0.0.1"]}`, d.Get("config_context"))
+	assert.Equal(t, map[string]interface{}{"rack_units": "2"}, d.Get(customFieldsKey))
+}
+
+func TestAccNetboxVirtualMachineDataSource_basic(t *testing.T) {
+	testSlug := "vm_ds_single"
+	testName := testAccGetTestName(testSlug)
+	dependencies := testAccNetboxPrimaryIPFullDependencies(testName) + `
+resource "netbox_virtual_machine_primary_ip" "test_v4" {
+  virtual_machine_id = netbox_virtual_machine.test.id
+  ip_address_id = netbox_ip_address.test_v4.id
+}
+`
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies,
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+data "netbox_virtual_machine" "by_name" {
+  name       = "%[1]s"
+  cluster_id = netbox_cluster.test.id
+}
+
+data "netbox_virtual_machine" "by_id" {
+  vm_id = netbox_virtual_machine.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine.by_name", "id", "netbox_virtual_machine.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine.by_name", "vm_id", "netbox_virtual_machine.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine.by_name", "site_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine.by_name", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine.by_name", "status", "planned"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine.by_name", "vcpus", "4"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine.by_name", "memory_mb", "1024"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine.by_name", "primary_ip4", "1.1.1.1/32"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine.by_name", "primary_ip", "1.1.1.1/32"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine.by_name", "tag_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine.by_id", "name", "netbox_virtual_machine.test", "name"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine.by_id", "cluster_id", "netbox_cluster.test", "id"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+data "netbox_virtual_machine" "missing" {
+  name = "%[1]s_missing"
+}`, testName),
+				ExpectError: regexp.MustCompile("expected one virtual machine, but got 0"),
+			},
+		},
+	})
+}
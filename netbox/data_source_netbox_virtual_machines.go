@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"regexp"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -14,9 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func dataSourceNetboxVirtualMachine() *schema.Resource {
+func dataSourceNetboxVirtualMachines() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxVirtualMachineRead,
+		Read:        dataSourceNetboxVirtualMachinesRead,
 		Description: `:meta:subcategory:Virtualization:`,
 		Schema: map[string]*schema.Schema{
 			"filter": {
@@ -61,10 +60,7 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						"custom_fields": {
-							Type:     schema.TypeMap,
-							Computed: true,
-						},
+						"custom_fields": customFieldsComputedSchema,
 						"disk_size_gb": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -135,9 +131,46 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+func dataSourceNetboxVirtualMachinesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params, err := getVirtualMachinesListParams(d)
+	if err != nil {
+		return err
+	}
+
+	res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil, withIncludeConfigContext)
+	if err != nil {
+		return err
+	}
+
+	if *res.GetPayload().Count == int64(0) {
+		return errors.New("no result")
+	}
+
+	var filteredVms []*models.VirtualMachineWithConfigContext
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		r := regexp.MustCompile(nameRegex.(string))
+		for _, vm := range res.GetPayload().Results {
+			if r.MatchString(*vm.Name) {
+				filteredVms = append(filteredVms, vm)
+			}
+		}
+	} else {
+		filteredVms = res.GetPayload().Results
+	}
+
+	var s []map[string]interface{}
+	for _, v := range filteredVms {
+		s = append(s, flattenVirtualMachine(v))
+	}
+
+	d.SetId(resource.UniqueId())
+	return d.Set("vms", s)
+}
 
+// getVirtualMachinesListParams returns the list parameters of the virtual machines for the given filters and limit.
+func getVirtualMachinesListParams(d *schema.ResourceData) (*virtualization.VirtualizationVirtualMachinesListParams, error) {
 	params := virtualization.NewVirtualizationVirtualMachinesListParams()
 
 	if filter, ok := d.GetOk("filter"); ok {
@@ -164,8 +197,26 @@ func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) e
 			case "site":
 				var siteString = v.(string)
 				params.Site = &siteString
+			case "site_id":
+				var siteIDString = v.(string)
+				params.SiteID = &siteIDString
+			case "platform_id":
+				var platformIDString = v.(string)
+				params.PlatformID = &platformIDString
+			case "role_id":
+				var roleIDString = v.(string)
+				params.RoleID = &roleIDString
+			case "status":
+				var statusString = v.(string)
+				params.Status = &statusString
+			case "tenant_id":
+				var tenantIDString = v.(string)
+				params.TenantID = &tenantIDString
+			case "tag":
+				var tagString = v.(string)
+				params.Tag = &tagString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				return nil, fmt.Errorf("'%s' is not a supported filter parameter", k)
 			}
 		}
 	}
@@ -175,98 +226,76 @@ func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) e
 		params.Limit = &limitInt
 	}
 
-	res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil)
-	if err != nil {
-		return err
-	}
+	return params, nil
+}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+// flattenVirtualMachine returns the attributes of a virtual machine as used by the virtual machine data sources.
+func flattenVirtualMachine(v *models.VirtualMachineWithConfigContext) map[string]interface{} {
+	var mapping = make(map[string]interface{})
+	if v.Cluster != nil {
+		mapping["cluster_id"] = v.Cluster.ID
 	}
-
-	var filteredVms []*models.VirtualMachineWithConfigContext
-	if nameRegex, ok := d.GetOk("name_regex"); ok {
-		r := regexp.MustCompile(nameRegex.(string))
-		for _, vm := range res.GetPayload().Results {
-			if r.MatchString(*vm.Name) {
-				filteredVms = append(filteredVms, vm)
-			}
-		}
-	} else {
-		filteredVms = res.GetPayload().Results
+	if v.Comments != "" {
+		mapping["comments"] = v.Comments
 	}
-
-	var s []map[string]interface{}
-	for _, v := range filteredVms {
-		var mapping = make(map[string]interface{})
-		if v.Cluster != nil {
-			mapping["cluster_id"] = v.Cluster.ID
-		}
-		if v.Comments != "" {
-			mapping["comments"] = v.Comments
-		}
-		if v.ConfigContext != nil {
-			if configContext, err := json.Marshal(v.ConfigContext); err == nil {
-				mapping["config_context"] = string(configContext)
-			}
-		}
-		if v.CustomFields != nil {
-			mapping["custom_fields"] = v.CustomFields
-		}
-		if v.Disk != nil {
-			mapping["disk_size_gb"] = *v.Disk
-		}
-		if v.LocalContextData != nil {
-			if localContextData, err := json.Marshal(v.LocalContextData); err == nil {
-				mapping["local_context_data"] = string(localContextData)
-			}
-		}
-		if v.Memory != nil {
-			mapping["memory_mb"] = *v.Memory
-		}
-		if v.Name != nil {
-			mapping["name"] = *v.Name
-		}
-		if v.Platform != nil {
-			mapping["platform_id"] = v.Platform.ID
-		}
-		if v.PrimaryIP != nil {
-			mapping["primary_ip"] = v.PrimaryIP.Address
-		}
-		if v.PrimaryIp4 != nil {
-			mapping["primary_ip4"] = v.PrimaryIp4.Address
-		}
-		if v.PrimaryIp6 != nil {
-			mapping["primary_ip6"] = v.PrimaryIp6.Address
-		}
-		if v.Role != nil {
-			mapping["role_id"] = v.Role.ID
-		}
-		if v.Site != nil {
-			mapping["site_id"] = v.Site.ID
+	if v.ConfigContext != nil {
+		if configContext, err := json.Marshal(v.ConfigContext); err == nil {
+			mapping["config_context"] = string(configContext)
 		}
-		if v.Status != nil {
-			mapping["status"] = v.Status.Value
-		}
-		if v.Tags != nil {
-			var tags []int64
-			for _, t := range v.Tags {
-				tags = append(tags, t.ID)
-			}
-			mapping["tag_ids"] = tags
-		}
-		if v.Tenant != nil {
-			mapping["tenant_id"] = v.Tenant.ID
+	}
+	if v.CustomFields != nil {
+		mapping["custom_fields"] = getCustomFields(v.CustomFields)
+	}
+	if v.Disk != nil {
+		mapping["disk_size_gb"] = *v.Disk
+	}
+	if v.LocalContextData != nil {
+		if localContextData, err := json.Marshal(v.LocalContextData); err == nil {
+			mapping["local_context_data"] = string(localContextData)
 		}
-		if v.Vcpus != nil {
-			mapping["vcpus"] = *v.Vcpus
+	}
+	if v.Memory != nil {
+		mapping["memory_mb"] = *v.Memory
+	}
+	if v.Name != nil {
+		mapping["name"] = *v.Name
+	}
+	if v.Platform != nil {
+		mapping["platform_id"] = v.Platform.ID
+	}
+	if v.PrimaryIP != nil {
+		mapping["primary_ip"] = v.PrimaryIP.Address
+	}
+	if v.PrimaryIp4 != nil {
+		mapping["primary_ip4"] = v.PrimaryIp4.Address
+	}
+	if v.PrimaryIp6 != nil {
+		mapping["primary_ip6"] = v.PrimaryIp6.Address
+	}
+	if v.Role != nil {
+		mapping["role_id"] = v.Role.ID
+	}
+	if v.Site != nil {
+		mapping["site_id"] = v.Site.ID
+	}
+	if v.Status != nil {
+		mapping["status"] = v.Status.Value
+	}
+	if v.Tags != nil {
+		var tags []int64
+		for _, t := range v.Tags {
+			tags = append(tags, t.ID)
 		}
-
-		mapping["vm_id"] = v.ID
-
-		s = append(s, mapping)
+		mapping["tag_ids"] = tags
+	}
+	if v.Tenant != nil {
+		mapping["tenant_id"] = v.Tenant.ID
+	}
+	if v.Vcpus != nil {
+		mapping["vcpus"] = *v.Vcpus
 	}
 
-	d.SetId(resource.UniqueId())
-	return d.Set("vms", s)
+	mapping["vm_id"] = v.ID
+
+	return mapping
 }
@@ -5,8 +5,35 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestGetVirtualMachinesListParams(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxVirtualMachines().Schema, map[string]interface{}{
+		"limit": 10,
+		"filter": []interface{}{
+			map[string]interface{}{"name": "status", "value": "active"},
+			map[string]interface{}{"name": "tenant_id", "value": "2"},
+			map[string]interface{}{"name": "tag", "value": "hypervisor"},
+		},
+	})
+	params, err := getVirtualMachinesListParams(d)
+	assert.NoError(t, err)
+	assert.Equal(t, "active", *params.Status)
+	assert.Equal(t, "2", *params.TenantID)
+	assert.Equal(t, "hypervisor", *params.Tag)
+	assert.Equal(t, int64(10), *params.Limit)
+
+	d = schema.TestResourceDataRaw(t, dataSourceNetboxVirtualMachines().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "device_id", "value": "1"},
+		},
+	})
+	_, err = getVirtualMachinesListParams(d)
+	assert.EqualError(t, err, "'device_id' is not a supported filter parameter")
+}
+
 func TestAccNetboxVirtualMachinesDataSource_basic(t *testing.T) {
 
 	testSlug := "vm_ds_basic"
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,16 +12,18 @@ import (
 func dataSourceNetboxVlan() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxVlanRead,
-		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This data source looks up a single VLAN by its ` + "`vid`" + ` or ` + "`name`" + `. VIDs are usually only unique within a VLAN group or site, so narrow the lookup with ` + "`group_id`, `site`, `role` or `tenant`" + `. It fails unless exactly one VLAN matches all given filters.`,
 		Schema: map[string]*schema.Schema{
 			"vid": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(1, 4094),
+				AtLeastOneOf: []string{"vid", "name"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"vid", "name"},
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -41,6 +42,7 @@ func dataSourceNetboxVlan() *schema.Resource {
 			"site": {
 				Type:     schema.TypeInt,
 				Computed: true,
+				Optional: true,
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -51,12 +53,13 @@ func dataSourceNetboxVlan() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			customFieldsKey: customFieldsComputedSchema,
 		},
 	}
 }
 
 func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := ipam.NewIpamVlansListParams()
 
 	params.Limit = int64ToPtr(2)
@@ -69,6 +72,9 @@ func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 	if groupID, ok := d.Get("group_id").(int); ok && groupID != 0 {
 		params.GroupID = strToPtr(strconv.Itoa(groupID))
 	}
+	if siteID, ok := d.Get("site").(int); ok && siteID != 0 {
+		params.SiteID = strToPtr(strconv.Itoa(siteID))
+	}
 	if roleID, ok := d.Get("role").(int); ok && roleID != 0 {
 		params.RoleID = strToPtr(strconv.Itoa(roleID))
 	}
@@ -81,7 +87,7 @@ func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 	if count := *res.GetPayload().Count; count != int64(1) {
-		return fmt.Errorf("expected one device type, but got %d", count)
+		return fmt.Errorf("expected one vlan, but got %d", count)
 	}
 
 	vlan := res.GetPayload().Results[0]
@@ -105,5 +111,6 @@ func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant", vlan.Tenant.ID)
 	}
 
+	d.Set(customFieldsKey, getCustomFields(vlan.CustomFields))
 	return nil
 }
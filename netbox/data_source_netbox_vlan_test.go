@@ -21,7 +21,7 @@ func TestAccNetboxVlanDataSource_basic(t *testing.T) {
 			},
 			{
 				Config:      setUp + testAccNetboxVlanDataNoResult,
-				ExpectError: regexp.MustCompile("expected one device type, but got 0"),
+				ExpectError: regexp.MustCompile("expected one vlan, but got 0"),
 			},
 			{
 				Config: setUp + testAccNetboxVlanDataByName(testName),
@@ -54,13 +54,19 @@ func TestAccNetboxVlanDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.netbox_vlan.test", "tenant", "netbox_tenant.test", "id"),
 				),
 			},
+			{
+				Config: setUp + extendedSetUp + testAccNetboxVlanDataByVidAndSite(testVid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_vlan.test", "id", "netbox_vlan.test", "id"),
+				),
+			},
 			{
 				Config:      setUp + extendedSetUp + testAccNetboxVlanDataByName(testName),
-				ExpectError: regexp.MustCompile("expected one device type, but got 2"),
+				ExpectError: regexp.MustCompile("expected one vlan, but got 2"),
 			},
 			{
 				Config:      setUp + extendedSetUp + testAccNetboxVlanDataByVid(testVid),
-				ExpectError: regexp.MustCompile("expected one device type, but got 2"),
+				ExpectError: regexp.MustCompile("expected one vlan, but got 2"),
 			},
 		},
 	})
@@ -141,3 +147,11 @@ data "netbox_vlan" "test" {
 	tenant = netbox_tenant.test.id
 }`, testVid)
 }
+
+func testAccNetboxVlanDataByVidAndSite(testVid int) string {
+	return fmt.Sprintf(`
+data "netbox_vlan" "test" {
+	vid = "%[1]d"
+	site = netbox_site.test.id
+}`, testVid)
+}
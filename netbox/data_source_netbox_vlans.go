@@ -0,0 +1,181 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxVlans() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxVlansRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This data source returns the VLANs that match all given filters.`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter. One of `vid`, `name`, `group_id`, `site_id`, `tenant_id`, `role_id`, `status` or `tag`.",
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"vlans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"site_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"role_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags":          tagsSchemaRead,
+						"custom_fields": customFieldsComputedSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxVlansRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params, err := getVlansListParams(d)
+	if err != nil {
+		return err
+	}
+
+	res, err := api.Ipam.IpamVlansList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	if *res.GetPayload().Count == int64(0) {
+		return errors.New("no result")
+	}
+
+	filteredVlans := res.GetPayload().Results
+
+	var s []map[string]interface{}
+	for _, v := range filteredVlans {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["vid"] = v.Vid
+		mapping["name"] = v.Name
+		mapping["description"] = v.Description
+		if v.Status != nil {
+			mapping["status"] = v.Status.Value
+		}
+		if v.Group != nil {
+			mapping["group_id"] = v.Group.ID
+		}
+		if v.Site != nil {
+			mapping["site_id"] = v.Site.ID
+		}
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		if v.Role != nil {
+			mapping["role_id"] = v.Role.ID
+		}
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+		mapping["custom_fields"] = getCustomFields(v.CustomFields)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(resource.UniqueId())
+	return d.Set("vlans", s)
+}
+
+// getVlansListParams returns the parameters of the VLAN list for the configured limit and filters.
+func getVlansListParams(d *schema.ResourceData) (*ipam.IpamVlansListParams, error) {
+	params := ipam.NewIpamVlansListParams()
+
+	if limitValue, ok := d.GetOk("limit"); ok {
+		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"]
+			v := f.(map[string]interface{})["value"]
+			vString := v.(string)
+			switch k {
+			case "vid":
+				params.Vid = &vString
+			case "name":
+				params.Name = &vString
+			case "group_id":
+				params.GroupID = &vString
+			case "site_id":
+				params.SiteID = &vString
+			case "tenant_id":
+				params.TenantID = &vString
+			case "role_id":
+				params.RoleID = &vString
+			case "status":
+				params.Status = &vString
+			case "tag":
+				params.Tag = &vString
+			default:
+				return nil, fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,106 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetVlansListParams(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxVlans().Schema, map[string]interface{}{
+		"limit": 10,
+		"filter": []interface{}{
+			map[string]interface{}{"name": "group_id", "value": "1"},
+			map[string]interface{}{"name": "status", "value": "active"},
+		},
+	})
+	params, err := getVlansListParams(d)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), *params.Limit)
+	assert.Equal(t, "1", *params.GroupID)
+	assert.Equal(t, "active", *params.Status)
+
+	d = schema.TestResourceDataRaw(t, dataSourceNetboxVlans().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "unknown", "value": "1"},
+		},
+	})
+	_, err = getVlansListParams(d)
+	assert.Error(t, err)
+}
+
+func TestAccNetboxVlansDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("vlans_ds_basic")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vlan_group" "test" {
+  name    = "%[1]s"
+  slug    = "%[1]s"
+  min_vid = 1
+  max_vid = 4094
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan" "test_1" {
+  vid       = 10
+  name      = "%[1]s_1"
+  group_id  = netbox_vlan_group.test.id
+  tenant_id = netbox_tenant.test.id
+}
+
+resource "netbox_vlan" "test_2" {
+  vid      = 20
+  name     = "%[1]s_2"
+  group_id = netbox_vlan_group.test.id
+  status   = "reserved"
+}
+
+data "netbox_vlans" "by_group" {
+  depends_on = [netbox_vlan.test_1, netbox_vlan.test_2]
+  filter {
+    name  = "group_id"
+    value = netbox_vlan_group.test.id
+  }
+}
+
+data "netbox_vlans" "by_group_and_status" {
+  depends_on = [netbox_vlan.test_1, netbox_vlan.test_2]
+  filter {
+    name  = "group_id"
+    value = netbox_vlan_group.test.id
+  }
+  filter {
+    name  = "status"
+    value = "reserved"
+  }
+}
+
+data "netbox_vlans" "by_tenant" {
+  depends_on = [netbox_vlan.test_1, netbox_vlan.test_2]
+  filter {
+    name  = "tenant_id"
+    value = netbox_tenant.test.id
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_vlans.by_group", "vlans.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_vlans.by_group_and_status", "vlans.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_vlans.by_group_and_status", "vlans.0.id", "netbox_vlan.test_2", "id"),
+					resource.TestCheckResourceAttr("data.netbox_vlans.by_tenant", "vlans.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_vlans.by_tenant", "vlans.0.vid", "netbox_vlan.test_1", "vid"),
+					resource.TestCheckResourceAttrPair("data.netbox_vlans.by_tenant", "vlans.0.group_id", "netbox_vlan_group.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const preventDeletionIfInUseKey = "prevent_deletion_if_in_use"
+
+var preventDeletionIfInUseSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "If true, the provider counts the objects that still reference this object before deleting it and fails with a list of them instead of issuing the DELETE request. This attribute is local to the provider and not stored in Netbox.",
+}
+
+// objectDependent describes a kind of object that can reference the object about to be deleted.
+type objectDependent struct {
+	// name is the plural name used in the error message, e.g. "devices"
+	name string
+	// path is the list endpoint of the dependent objects, e.g. "/dcim/devices/"
+	path string
+	// filter is the query parameter that filters the dependent objects by the referenced object, e.g. "site_id"
+	filter string
+}
+
+var tenantDependents = []objectDependent{
+	{name: "sites", path: "/dcim/sites/", filter: "tenant_id"},
+	{name: "locations", path: "/dcim/locations/", filter: "tenant_id"},
+	{name: "racks", path: "/dcim/racks/", filter: "tenant_id"},
+	{name: "devices", path: "/dcim/devices/", filter: "tenant_id"},
+	{name: "VRFs", path: "/ipam/vrfs/", filter: "tenant_id"},
+	{name: "prefixes", path: "/ipam/prefixes/", filter: "tenant_id"},
+	{name: "IP ranges", path: "/ipam/ip-ranges/", filter: "tenant_id"},
+	{name: "IP addresses", path: "/ipam/ip-addresses/", filter: "tenant_id"},
+	{name: "VLANs", path: "/ipam/vlans/", filter: "tenant_id"},
+	{name: "circuits", path: "/circuits/circuits/", filter: "tenant_id"},
+	{name: "clusters", path: "/virtualization/clusters/", filter: "tenant_id"},
+	{name: "virtual machines", path: "/virtualization/virtual-machines/", filter: "tenant_id"},
+}
+
+var siteDependents = []objectDependent{
+	{name: "locations", path: "/dcim/locations/", filter: "site_id"},
+	{name: "racks", path: "/dcim/racks/", filter: "site_id"},
+	{name: "devices", path: "/dcim/devices/", filter: "site_id"},
+	{name: "power panels", path: "/dcim/power-panels/", filter: "site_id"},
+	{name: "prefixes", path: "/ipam/prefixes/", filter: "site_id"},
+	{name: "VLANs", path: "/ipam/vlans/", filter: "site_id"},
+	{name: "circuit terminations", path: "/circuits/circuit-terminations/", filter: "site_id"},
+	{name: "clusters", path: "/virtualization/clusters/", filter: "site_id"},
+	{name: "virtual machines", path: "/virtualization/virtual-machines/", filter: "site_id"},
+}
+
+var vrfDependents = []objectDependent{
+	{name: "prefixes", path: "/ipam/prefixes/", filter: "vrf_id"},
+	{name: "IP ranges", path: "/ipam/ip-ranges/", filter: "vrf_id"},
+	{name: "IP addresses", path: "/ipam/ip-addresses/", filter: "vrf_id"},
+}
+
+// checkObjectNotInUse returns an error listing the objects that still reference the object with the given ID if
+// prevent_deletion_if_in_use is set. Netbox refuses to delete most of these objects anyway, but its error does not tell
+// which objects are in the way, and some references are silently nulled instead.
+func checkObjectNotInUse(api *providerState, d *schema.ResourceData, objectName string, id int64, dependents []objectDependent) error {
+	if !d.Get(preventDeletionIfInUseKey).(bool) {
+		return nil
+	}
+
+	var inUse []string
+	for _, dependent := range dependents {
+		query := url.Values{
+			dependent.filter: []string{strconv.FormatInt(id, 10)},
+			"limit":          []string{"1"},
+		}
+		res, err := genericAPIRequestWithQuery(api, "GET", dependent.path, query, nil)
+		if err != nil {
+			return fmt.Errorf("failed to count the %s referencing %s %d: %w", dependent.name, objectName, id, err)
+		}
+		if count, ok := getGenericInt(res, "count"); ok && count > 0 {
+			inUse = append(inUse, fmt.Sprintf("%d %s", count, dependent.name))
+		}
+	}
+
+	if len(inUse) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s %d is still in use by %s; remove these objects first or set %s to false", objectName, id, joinStringWithFinalConjunction(inUse, ", ", "and"), preventDeletionIfInUseKey)
+}
@@ -0,0 +1,53 @@
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckObjectNotInUse(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "42", r.URL.Query().Get("vrf_id"))
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/ipam/prefixes/":
+			w.Write([]byte(`{"count": 3, "results": [{"id": 1}]}`))
+		case "/api/ipam/ip-addresses/":
+			w.Write([]byte(`{"count": 12, "results": [{"id": 1}]}`))
+		default:
+			w.Write([]byte(`{"count": 0, "results": []}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	// Without the flag, nothing is checked
+	d := schema.TestResourceDataRaw(t, resourceNetboxVrf().Schema, map[string]interface{}{"name": "test"})
+	assert.NoError(t, checkObjectNotInUse(api, d, "VRF", 42, vrfDependents))
+	assert.Equal(t, 0, requests)
+
+	d = schema.TestResourceDataRaw(t, resourceNetboxVrf().Schema, map[string]interface{}{
+		"name":                    "test",
+		preventDeletionIfInUseKey: true,
+	})
+	err = checkObjectNotInUse(api, d, "VRF", 42, vrfDependents)
+	assert.EqualError(t, err, "VRF 42 is still in use by 3 prefixes and 12 IP addresses; remove these objects first or set prevent_deletion_if_in_use to false")
+	assert.Equal(t, len(vrfDependents), requests)
+}
@@ -0,0 +1,63 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const adoptExistingKey = "adopt_existing"
+
+// adoptExistingSchema is shared by all device component resources whose components Netbox creates from the
+// templates of the device type.
+var adoptExistingSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "If true, an existing component of the device with the same name, e.g. one that Netbox created from a template of the device type, is adopted instead of creating a new one. Attributes that are not configured are cleared on the adopted component.",
+}
+
+// adoptDeviceComponent sets the ID of the resource to the existing component with the same name of the same device
+// if adopt_existing is set. It returns true if a component was adopted. The path is the list endpoint of the component.
+func adoptDeviceComponent(api *providerState, d *schema.ResourceData, path string) (bool, error) {
+	if !d.Get(adoptExistingKey).(bool) {
+		return false, nil
+	}
+
+	id, found, err := findDeviceComponentID(api, path, int64(d.Get("device_id").(int)), d.Get("name").(string))
+	if err != nil || !found {
+		return false, err
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	return true, nil
+}
+
+// findDeviceComponentID returns the ID of the component with the given name of the given device. The path is the
+// list endpoint of the component.
+func findDeviceComponentID(api *providerState, path string, deviceID int64, name string) (int64, bool, error) {
+	query := url.Values{
+		"device_id": []string{strconv.FormatInt(deviceID, 10)},
+		"name":      []string{name},
+	}
+	res, err := genericAPIRequestWithQuery(api, "GET", path, query, nil)
+	if err != nil {
+		return 0, false, err
+	}
+
+	results, _ := res["results"].([]interface{})
+	if len(results) == 0 {
+		return 0, false, nil
+	}
+	component, ok := results[0].(map[string]interface{})
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected response from %s", path)
+	}
+	id, err := getGenericObjectID(component)
+	if err != nil {
+		return 0, false, err
+	}
+	return id, true, nil
+}
@@ -0,0 +1,42 @@
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDeviceComponentID(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/interfaces/", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("device_id"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("name") == "eth0" {
+			w.Write([]byte(`{"count": 1, "results": [{"id": 42, "name": "eth0"}]}`))
+		} else {
+			w.Write([]byte(`{"count": 0, "results": []}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	id, found, err := findDeviceComponentID(api, "/dcim/interfaces/", 1, "eth0")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(42), id)
+
+	_, found, err = findDeviceComponentID(api, "/dcim/interfaces/", 1, "eth1")
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,411 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// contentTypeAPIPaths maps Netbox content types (as used in e.g. contact assignments)
+// to the API endpoint that serves objects of that type.
+var contentTypeAPIPaths = map[string]string{
+	"circuits.circuit":              "/circuits/circuits/",
+	"circuits.circuittermination":   "/circuits/circuit-terminations/",
+	"circuits.circuittype":          "/circuits/circuit-types/",
+	"circuits.provider":             "/circuits/providers/",
+	"circuits.providernetwork":      "/circuits/provider-networks/",
+	"dcim.cable":                    "/dcim/cables/",
+	"dcim.consoleport":              "/dcim/console-ports/",
+	"dcim.consoleserverport":        "/dcim/console-server-ports/",
+	"dcim.device":                   "/dcim/devices/",
+	"dcim.devicebay":                "/dcim/device-bays/",
+	"dcim.devicerole":               "/dcim/device-roles/",
+	"dcim.devicetype":               "/dcim/device-types/",
+	"dcim.frontport":                "/dcim/front-ports/",
+	"dcim.interface":                "/dcim/interfaces/",
+	"dcim.inventoryitem":            "/dcim/inventory-items/",
+	"dcim.location":                 "/dcim/locations/",
+	"dcim.macaddress":               "/dcim/mac-addresses/",
+	"dcim.manufacturer":             "/dcim/manufacturers/",
+	"dcim.module":                   "/dcim/modules/",
+	"dcim.modulebay":                "/dcim/module-bays/",
+	"dcim.moduletype":               "/dcim/module-types/",
+	"dcim.platform":                 "/dcim/platforms/",
+	"dcim.powerfeed":                "/dcim/power-feeds/",
+	"dcim.poweroutlet":              "/dcim/power-outlets/",
+	"dcim.powerpanel":               "/dcim/power-panels/",
+	"dcim.powerport":                "/dcim/power-ports/",
+	"dcim.rack":                     "/dcim/racks/",
+	"dcim.rackreservation":          "/dcim/rack-reservations/",
+	"dcim.rackrole":                 "/dcim/rack-roles/",
+	"dcim.rearport":                 "/dcim/rear-ports/",
+	"dcim.region":                   "/dcim/regions/",
+	"dcim.site":                     "/dcim/sites/",
+	"dcim.sitegroup":                "/dcim/site-groups/",
+	"dcim.virtualchassis":           "/dcim/virtual-chassis/",
+	"ipam.aggregate":                "/ipam/aggregates/",
+	"ipam.asn":                      "/ipam/asns/",
+	"ipam.fhrpgroup":                "/ipam/fhrp-groups/",
+	"ipam.ipaddress":                "/ipam/ip-addresses/",
+	"ipam.iprange":                  "/ipam/ip-ranges/",
+	"ipam.l2vpn":                    "/ipam/l2vpns/",
+	"ipam.prefix":                   "/ipam/prefixes/",
+	"ipam.rir":                      "/ipam/rirs/",
+	"ipam.role":                     "/ipam/roles/",
+	"ipam.routetarget":              "/ipam/route-targets/",
+	"ipam.service":                  "/ipam/services/",
+	"ipam.vlan":                     "/ipam/vlans/",
+	"ipam.vlangroup":                "/ipam/vlan-groups/",
+	"ipam.vrf":                      "/ipam/vrfs/",
+	"tenancy.contact":               "/tenancy/contacts/",
+	"tenancy.contactgroup":          "/tenancy/contact-groups/",
+	"tenancy.contactrole":           "/tenancy/contact-roles/",
+	"tenancy.tenant":                "/tenancy/tenants/",
+	"tenancy.tenantgroup":           "/tenancy/tenant-groups/",
+	"virtualization.cluster":        "/virtualization/clusters/",
+	"virtualization.clustergroup":   "/virtualization/cluster-groups/",
+	"virtualization.clustertype":    "/virtualization/cluster-types/",
+	"virtualization.virtualmachine": "/virtualization/virtual-machines/",
+	"virtualization.vminterface":    "/virtualization/interfaces/",
+	"wireless.wirelesslan":          "/wireless/wireless-lans/",
+	"wireless.wirelesslangroup":     "/wireless/wireless-lan-groups/",
+	"wireless.wirelesslink":         "/wireless/wireless-links/",
+}
+
+// supportedContentTypes returns the sorted list of content types in contentTypeAPIPaths.
+func supportedContentTypes() []string {
+	contentTypes := make([]string, 0, len(contentTypeAPIPaths))
+	for contentType := range contentTypeAPIPaths {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+	return contentTypes
+}
+
+// getObjectAPIPath returns the API path of the object with the given content type and ID.
+func getObjectAPIPath(contentType string, id int64) (string, error) {
+	basePath, ok := contentTypeAPIPaths[contentType]
+	if !ok {
+		return "", fmt.Errorf("unsupported content type %q, must be one of %s", contentType, strings.Join(supportedContentTypes(), ", "))
+	}
+	return fmt.Sprintf("%s%d/", basePath, id), nil
+}
+
+// getContentTypeFromObjectURL returns the content type of the object with the given API URL, e.g. `dcim.interface`
+// for `https://netbox.example.com/api/dcim/interfaces/1/`. The URL is matched by the path after the base path of the
+// API, so it works with any configured base path. It returns an empty string for unsupported content types.
+func getContentTypeFromObjectURL(objectURL string) string {
+	u, err := url.Parse(objectURL)
+	if err != nil {
+		return ""
+	}
+	contentType, matchLength := "", 0
+	for candidate, basePath := range contentTypeAPIPaths {
+		index := strings.LastIndex(u.Path, basePath)
+		if index < 0 || len(basePath) <= matchLength {
+			continue
+		}
+		// Only the path of the object itself matches, not the paths of its sub-resources like its trace
+		if _, err := strconv.ParseInt(strings.TrimSuffix(u.Path[index+len(basePath):], "/"), 10, 64); err != nil {
+			continue
+		}
+		contentType, matchLength = candidate, len(basePath)
+	}
+	return contentType
+}
+
+// genericAPIRequest performs a request against an arbitrary API path using the transport of the given client.
+// This is used for endpoints that are not (or not suitably) covered by the generated client, e.g. to
+// partially update fields that every object shares. The decoded JSON response is returned. Non-2xx responses
+// are returned as *runtime.APIError.
+func genericAPIRequest(api *providerState, method string, path string, body interface{}) (map[string]interface{}, error) {
+	return genericAPIRequestWithQuery(api, method, path, nil, body)
+}
+
+// genericAPIRequestWithQuery is like genericAPIRequest, but additionally sends the given query parameters and applies
+// the given client options to the request, e.g. to set additional headers.
+func genericAPIRequestWithQuery(api *providerState, method string, path string, query url.Values, body interface{}, opts ...func(*runtime.ClientOperation)) (map[string]interface{}, error) {
+	result, err := genericAPIRawRequest(api, method, path, query, body, opts...)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	payload, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response from %s %s, expected a JSON object", method, path)
+	}
+	return payload, nil
+}
+
+// genericAPIRawRequest is like genericAPIRequestWithQuery, but returns the decoded JSON response as is. This is
+// needed for the few endpoints that do not respond with a JSON object, e.g. cable traces.
+func genericAPIRawRequest(api *providerState, method string, path string, query url.Values, body interface{}, opts ...func(*runtime.ClientOperation)) (interface{}, error) {
+	op := &runtime.ClientOperation{
+		ID:                 fmt.Sprintf("%s %s", method, path),
+		Method:             method,
+		PathPattern:        path,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			for key, values := range query {
+				if err := r.SetQueryParam(key, values...); err != nil {
+					return err
+				}
+			}
+			if body != nil {
+				return r.SetBodyParam(body)
+			}
+			return nil
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			var payload interface{}
+			if response.Code() == http.StatusNoContent {
+				return payload, nil
+			}
+			if response.Code()/100 != 2 {
+				var errorPayload interface{}
+				consumer.Consume(response.Body(), &errorPayload)
+				return nil, runtime.NewAPIError(fmt.Sprintf("%s %s", method, path), errorPayload, response.Code())
+			}
+			if err := consumer.Consume(response.Body(), &payload); err != nil {
+				return nil, err
+			}
+			return payload, nil
+		}),
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	return api.Transport.Submit(op)
+}
+
+// objectTypesNetboxVersion is the first Netbox version that calls the content types of objects like event rules and
+// saved filters object types.
+const objectTypesNetboxVersion = "4.0.0"
+
+// getObjectTypesField returns the name of the field with the object types of an object in the Netbox version.
+func getObjectTypesField(api *providerState) string {
+	if api.hasNetboxVersion(objectTypesNetboxVersion) {
+		return "object_types"
+	}
+	return "content_types"
+}
+
+// genericAPIListPageSize is the number of objects requested per page by genericAPIList.
+const genericAPIListPageSize = 1000
+
+// genericAPIList returns all objects of a list endpoint matching the given query parameters, requesting one page
+// after the other until the count of the list is reached.
+func genericAPIList(api *providerState, path string, query url.Values) ([]map[string]interface{}, error) {
+	objects := []map[string]interface{}{}
+	for {
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		pageQuery.Set("limit", strconv.Itoa(genericAPIListPageSize))
+		pageQuery.Set("offset", strconv.Itoa(len(objects)))
+
+		res, err := genericAPIRequestWithQuery(api, "GET", path, pageQuery, nil)
+		if err != nil {
+			return nil, err
+		}
+		results, _ := res["results"].([]interface{})
+		for _, result := range results {
+			if object, ok := result.(map[string]interface{}); ok {
+				objects = append(objects, object)
+			}
+		}
+		count, _ := getGenericInt(res, "count")
+		if len(results) == 0 || int64(len(objects)) >= count {
+			return objects, nil
+		}
+	}
+}
+
+// getGenericFieldChoices returns the values that the given field of the list endpoint at the given path accepts, as
+// advertised by the OPTIONS response of the endpoint. This includes choices that are added by the configuration of
+// the Netbox instance, e.g. with FIELD_CHOICES. It returns false if the endpoint does not advertise choices for the
+// field, e.g. if the token lacks the permission to create objects. The OPTIONS response is only requested once per
+// path.
+func getGenericFieldChoices(api *providerState, path string, field string) ([]string, bool, error) {
+	fields, err := getGenericFields(api, path)
+	if err != nil {
+		return nil, false, err
+	}
+	fieldInfo, _ := fields[field].(map[string]interface{})
+	rawChoices, ok := fieldInfo["choices"].([]interface{})
+	if !ok {
+		return nil, false, nil
+	}
+
+	choices := []string{}
+	for _, rawChoice := range rawChoices {
+		choice, _ := rawChoice.(map[string]interface{})
+		if value, ok := choice["value"].(string); ok {
+			choices = append(choices, value)
+		}
+	}
+	return choices, true, nil
+}
+
+// getGenericFields returns the fields that the list endpoint at the given path accepts on creation, as advertised by
+// the OPTIONS response of the endpoint. The fields are cached in the provider state.
+func getGenericFields(api *providerState, path string) (map[string]interface{}, error) {
+	api.fieldChoicesLock.Lock()
+	fields, ok := api.fieldChoices[path]
+	api.fieldChoicesLock.Unlock()
+	if ok {
+		return fields, nil
+	}
+
+	res, err := genericAPIRequest(api, "OPTIONS", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	actions, _ := res["actions"].(map[string]interface{})
+	fields, _ = actions["POST"].(map[string]interface{})
+
+	api.fieldChoicesLock.Lock()
+	defer api.fieldChoicesLock.Unlock()
+	if api.fieldChoices == nil {
+		api.fieldChoices = map[string]map[string]interface{}{}
+	}
+	api.fieldChoices[path] = fields
+	return fields, nil
+}
+
+// withIncludeConfigContext is a client option that requests the rendered config context of devices and virtual
+// machines. Netbox omits the config context from list responses unless it is explicitly included.
+func withIncludeConfigContext(op *runtime.ClientOperation) {
+	params := op.Params
+	op.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if err := params.WriteToRequest(r, reg); err != nil {
+			return err
+		}
+		return r.SetQueryParam("include", "config_context")
+	})
+}
+
+// getConfigContextJSON returns the given config context as JSON string, or an empty string if there is none.
+func getConfigContextJSON(configContext interface{}) (string, error) {
+	if configContext == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(configContext)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// isGenericAPINotFound checks whether the error returned by genericAPIRequest is a 404.
+func isGenericAPINotFound(err error) bool {
+	apiErr, ok := err.(*runtime.APIError)
+	return ok && apiErr.Code == http.StatusNotFound
+}
+
+// getGenericObjectID returns the ID of an object decoded by genericAPIRequest.
+func getGenericObjectID(object map[string]interface{}) (int64, error) {
+	id, ok := object["id"].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("response does not contain an object ID")
+	}
+	return id.Int64()
+}
+
+// getGenericNestedObjectID returns the ID of the nested object in the given field of an object decoded by
+// genericAPIRequest. It returns false if the field is null or missing, e.g. on older Netbox versions.
+func getGenericNestedObjectID(object map[string]interface{}, field string) (int64, bool) {
+	nested, ok := object[field].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	id, err := getGenericObjectID(nested)
+	return id, err == nil
+}
+
+// getGenericNestedObjectIDList returns the IDs of the list of nested objects in the given field of an object decoded
+// by genericAPIRequest.
+func getGenericNestedObjectIDList(object map[string]interface{}, field string) []int64 {
+	ids := []int64{}
+	nestedList, _ := object[field].([]interface{})
+	for _, nested := range nestedList {
+		nestedMap, ok := nested.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, err := getGenericObjectID(nestedMap); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// getGenericInt returns the integer in the given field of an object decoded by genericAPIRequest. It returns
+// false if the field is null or missing.
+func getGenericInt(object map[string]interface{}, field string) (int64, bool) {
+	value, ok := object[field].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := value.Int64()
+	return i, err == nil
+}
+
+// getNestedTagListFromGenericObject returns the tags of an object decoded by genericAPIRequest.
+func getNestedTagListFromGenericObject(object map[string]interface{}) []*models.NestedTag {
+	tags := []*models.NestedTag{}
+	tagList, _ := object[tagsKey].([]interface{})
+	for _, tag := range tagList {
+		tagMap, ok := tag.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := tagMap["name"].(string)
+		slug, _ := tagMap["slug"].(string)
+		tags = append(tags, &models.NestedTag{
+			Name: strToPtr(name),
+			Slug: strToPtr(slug),
+		})
+	}
+	return tags
+}
+
+// clearRemovedFields clears the API fields of all given attributes that were removed from the configuration.
+// The generated client omits empty values from requests, so these fields have to be cleared with a separate
+// request. The fields map attribute names to API field names. Strings are cleared with an empty string, booleans
+// are set to false and all other fields are cleared with null. While a resource is still being created, e.g. when
+// an existing object is adopted, all attributes that are not set are cleared.
+func clearRemovedFields(api *providerState, d *schema.ResourceData, path string, fields map[string]string) error {
+	cleared := map[string]interface{}{}
+	for attribute, field := range fields {
+		if _, ok := d.GetOk(attribute); ok || (!d.HasChange(attribute) && !d.IsNewResource()) {
+			continue
+		}
+		switch d.Get(attribute).(type) {
+		case string:
+			cleared[field] = ""
+		case bool:
+			cleared[field] = false
+		default:
+			cleared[field] = nil
+		}
+	}
+	if len(cleared) == 0 {
+		return nil
+	}
+
+	_, err := genericAPIRequest(api, "PATCH", path, cleared)
+	return err
+}
@@ -0,0 +1,252 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectAPIPath(t *testing.T) {
+	path, err := getObjectAPIPath("dcim.device", 42)
+	assert.NoError(t, err)
+	assert.Equal(t, "/dcim/devices/42/", path)
+
+	_, err = getObjectAPIPath("dcim.foo", 42)
+	assert.Error(t, err)
+}
+
+func TestGenericAPIRequest(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/dcim/devices/42/" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 42, "tags": [{"name": "Foo", "slug": "foo"}]}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	res, err := genericAPIRequest(api, "GET", "/dcim/devices/42/", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("42"), res["id"])
+
+	_, err = genericAPIRequest(api, "GET", "/dcim/devices/43/", nil)
+	assert.Error(t, err)
+	assert.True(t, isGenericAPINotFound(err))
+}
+
+func TestClearRemovedFields(t *testing.T) {
+
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/dcim/interfaces/42/", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 42}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	// The label and the speed are removed from the configuration, the LAG is kept
+	r := resourceNetboxDeviceInterface()
+	state := &terraform.InstanceState{
+		ID: "42",
+		Attributes: map[string]string{
+			"name":                    "eth0",
+			"device_id":               "1",
+			"type":                    "1000base-t",
+			"label":                   "uplink",
+			"speed":                   "1000000",
+			"lag_device_interface_id": "1",
+		},
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                    "eth0",
+		"device_id":               1,
+		"type":                    "1000base-t",
+		"lag_device_interface_id": 1,
+	}), nil)
+	assert.NoError(t, err)
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+
+	err = clearRemovedFields(api, d, "/dcim/interfaces/42/", map[string]string{
+		"label":                   "label",
+		"speed":                   "speed",
+		"lag_device_interface_id": "lag",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"label": "", "speed": nil}, body)
+}
+
+func TestGetGenericObjectID(t *testing.T) {
+	id, err := getGenericObjectID(map[string]interface{}{"id": json.Number("42")})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+
+	_, err = getGenericObjectID(map[string]interface{}{"display": "test"})
+	assert.Error(t, err)
+}
+
+func TestGenericAPIList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "test", r.URL.Query().Get("tag"))
+		assert.Equal(t, "1000", r.URL.Query().Get("limit"))
+		// Netbox caps the page size at MAX_PAGE_SIZE, so the list takes several pages
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			w.Write([]byte(`{"count": 3, "results": [{"id": 1}, {"id": 2}]}`))
+		case "2":
+			w.Write([]byte(`{"count": 3, "results": [{"id": 3}]}`))
+		default:
+			t.Fatalf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*netboxClient.NetBoxAPI)}
+
+	objects, err := genericAPIList(api, "/dcim/sites/", url.Values{"tag": []string{"test"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"id": json.Number("1")},
+		{"id": json.Number("2")},
+		{"id": json.Number("3")},
+	}, objects)
+}
+
+func TestGetGenericInt(t *testing.T) {
+	value, ok := getGenericInt(map[string]interface{}{"start": json.Number("64512")}, "start")
+	assert.True(t, ok)
+	assert.Equal(t, int64(64512), value)
+
+	_, ok = getGenericInt(map[string]interface{}{"start": nil}, "start")
+	assert.False(t, ok)
+
+	_, ok = getGenericInt(map[string]interface{}{}, "start")
+	assert.False(t, ok)
+}
+
+func TestGetGenericNestedObjectIDList(t *testing.T) {
+	ids := getGenericNestedObjectIDList(map[string]interface{}{
+		"proposals": []interface{}{
+			map[string]interface{}{"id": json.Number("1"), "name": "first"},
+			map[string]interface{}{"id": json.Number("2"), "name": "second"},
+		},
+	}, "proposals")
+	assert.Equal(t, []int64{1, 2}, ids)
+
+	assert.Equal(t, []int64{}, getGenericNestedObjectIDList(map[string]interface{}{}, "proposals"))
+}
+
+func TestGetGenericFieldChoices(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "OPTIONS", r.Method)
+		assert.Equal(t, "/api/ipam/services/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "Service List", "actions": {"POST": {
+			"name": {"type": "string", "required": true},
+			"protocol": {"type": "choice", "required": true, "choices": [
+				{"value": "tcp", "display_name": "TCP"},
+				{"value": "udp", "display_name": "UDP"},
+				{"value": "quic", "display_name": "QUIC"}
+			]}
+		}}}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	choices, ok, err := getGenericFieldChoices(api, "/ipam/services/", "protocol")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"tcp", "udp", "quic"}, choices)
+
+	_, ok, err = getGenericFieldChoices(api, "/ipam/services/", "name")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestWithIncludeConfigContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/devices/1/", r.URL.Path)
+		assert.Equal(t, "config_context", r.URL.Query().Get("include"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "name": "test", "config_context": {"ntp_servers": ["10.0.0.1"]}}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	res, err := api.Dcim.DcimDevicesRead(dcim.NewDcimDevicesReadParams().WithID(1), nil, withIncludeConfigContext)
+	assert.NoError(t, err)
+
+	configContext, err := getConfigContextJSON(res.GetPayload().ConfigContext)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"ntp_servers":["10.0.0.1"]}`, configContext)
+
+	configContext, err = getConfigContextJSON(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", configContext)
+}
+
+func TestGetContentTypeFromObjectURL(t *testing.T) {
+	assert.Equal(t, "dcim.interface", getContentTypeFromObjectURL("https://netbox.example.com/api/dcim/interfaces/1/"))
+	assert.Equal(t, "dcim.consoleserverport", getContentTypeFromObjectURL("http://localhost:8001/netbox/api/dcim/console-server-ports/12/"))
+	assert.Equal(t, "circuits.circuittermination", getContentTypeFromObjectURL("/api/circuits/circuit-terminations/3/"))
+	assert.Equal(t, "dcim.interface", getContentTypeFromObjectURL("https://netbox.example.com/netbox-api/v1/dcim/interfaces/1/"))
+	assert.Equal(t, "virtualization.vminterface", getContentTypeFromObjectURL("https://netbox.example.com/custom/virtualization/interfaces/7/"))
+	assert.Equal(t, "", getContentTypeFromObjectURL("https://netbox.example.com/api/dcim/interfaces/1/trace/"))
+	assert.Equal(t, "", getContentTypeFromObjectURL("https://netbox.example.com/api/extras/scripts/1/"))
+}
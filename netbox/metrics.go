@@ -0,0 +1,226 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiMetrics collects the number of calls, the number of failed calls and the
+// cumulative latency of API calls, grouped by endpoint.
+type apiMetrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointMetrics
+}
+
+type endpointMetrics struct {
+	calls   int
+	errors  int
+	latency time.Duration
+}
+
+// metricsTransport is a transport that records every request in an apiMetrics.
+type metricsTransport struct {
+	original http.RoundTripper
+	metrics  *apiMetrics
+}
+
+// collectedAPIMetrics holds the metrics of all clients with metrics collection enabled.
+// The metrics are process-wide because the summary covers the whole run.
+var collectedAPIMetrics = newAPIMetrics()
+
+// pendingOperations is the number of CRUD operations of resources and data sources in progress.
+var pendingOperations int64
+
+var numericPathSegmentRegex = regexp.MustCompile(`/\d+/`)
+
+func newAPIMetrics() *apiMetrics {
+	return &apiMetrics{
+		endpoints: map[string]*endpointMetrics{},
+	}
+}
+
+// RoundTrip sends the request and records its result.
+func (t metricsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.original.RoundTrip(r)
+
+	failed := err != nil || resp.StatusCode >= 400
+	t.metrics.record(r.Method, r.URL.Path, time.Since(start), failed)
+
+	return resp, err
+}
+
+func (m *apiMetrics) record(method string, path string, latency time.Duration, failed bool) {
+	endpoint := fmt.Sprintf("%s %s", method, normalizeEndpointPath(path))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.endpoints[endpoint]
+	if !ok {
+		e = &endpointMetrics{}
+		m.endpoints[endpoint] = e
+	}
+	e.calls++
+	e.latency += latency
+	if failed {
+		e.errors++
+	}
+}
+
+// summary returns a human readable summary of the collected metrics, with the
+// endpoints with the highest cumulative latency first. An empty string is
+// returned if no calls were recorded.
+func (m *apiMetrics) summary() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.endpoints) == 0 {
+		return ""
+	}
+
+	endpoints := make([]string, 0, len(m.endpoints))
+	totalCalls, totalErrors := 0, 0
+	var totalLatency time.Duration
+	for endpoint, e := range m.endpoints {
+		endpoints = append(endpoints, endpoint)
+		totalCalls += e.calls
+		totalErrors += e.errors
+		totalLatency += e.latency
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		a, b := m.endpoints[endpoints[i]], m.endpoints[endpoints[j]]
+		if a.latency != b.latency {
+			return a.latency > b.latency
+		}
+		return endpoints[i] < endpoints[j]
+	})
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Netbox API calls: %d, errors: %d, cumulative latency: %s\n", totalCalls, totalErrors, totalLatency.Round(time.Millisecond))
+	for _, endpoint := range endpoints {
+		e := m.endpoints[endpoint]
+		fmt.Fprintf(&sb, "  %s: calls: %d, errors: %d (%.1f%%), cumulative latency: %s, average latency: %s\n",
+			endpoint, e.calls, e.errors, float64(e.errors)/float64(e.calls)*100,
+			e.latency.Round(time.Millisecond), (e.latency / time.Duration(e.calls)).Round(time.Millisecond))
+	}
+	return sb.String()
+}
+
+// normalizeEndpointPath replaces object IDs in the given path so that calls to
+// the same endpoint are grouped together, e.g. /api/dcim/devices/1/ becomes
+// /api/dcim/devices/{id}/.
+func normalizeEndpointPath(path string) string {
+	// Replace twice because adjacent matches share a slash
+	path = numericPathSegmentRegex.ReplaceAllString(path, "/{id}/")
+	return numericPathSegmentRegex.ReplaceAllString(path, "/{id}/")
+}
+
+// metricsSummaryDiagnostic returns the summary of the API calls made by all providers with `collect_metrics` enabled
+// as warning. The totals are part of the summary of the warning, so Terraform does not consolidate the warnings of
+// different operations.
+func metricsSummaryDiagnostic() diag.Diagnostic {
+	summary, detail, _ := strings.Cut(strings.TrimSpace(collectedAPIMetrics.summary()), "\n")
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   detail,
+	}
+}
+
+// withProviderMetricsSummary wraps the CRUD functions of all resources and data sources of the provider with
+// withMetricsSummary once the provider is configured with `collect_metrics` enabled. Without metrics collection, the
+// resources and data sources keep their original functions.
+func withProviderMetricsSummary(provider *schema.Provider) *schema.Provider {
+	configure := provider.ConfigureContextFunc
+	var once sync.Once
+	provider.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		meta, diags := configure(ctx, data)
+		if api, ok := meta.(*providerState); ok && api.collectMetrics {
+			once.Do(func() {
+				for _, r := range provider.ResourcesMap {
+					withMetricsSummary(r)
+				}
+				for _, r := range provider.DataSourcesMap {
+					withMetricsSummary(r)
+				}
+			})
+		}
+		return meta, diags
+	}
+	return provider
+}
+
+type crudContextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// withMetricsSummary wraps the CRUD functions of the resource, so that the summary of the API metrics is returned as
+// warning by every operation that finishes while no other operation is pending. The last of these warnings is
+// returned by the last operation of the run and covers the whole run. Functions without context are converted to
+// their context variant, as only those can return warnings.
+func withMetricsSummary(r *schema.Resource) *schema.Resource {
+	if r.Create != nil {
+		f := r.Create
+		r.Create = nil
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, m))
+		}
+	}
+	if r.Read != nil {
+		f := r.Read
+		r.Read = nil
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, m))
+		}
+	}
+	if r.Update != nil {
+		f := r.Update
+		r.Update = nil
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, m))
+		}
+	}
+	if r.Delete != nil {
+		f := r.Delete
+		r.Delete = nil
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, m))
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrapWithMetricsSummary(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrapWithMetricsSummary(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapWithMetricsSummary(r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrapWithMetricsSummary(r.DeleteContext)
+	}
+	return r
+}
+
+func wrapWithMetricsSummary(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		atomic.AddInt64(&pendingOperations, 1)
+		diags := f(ctx, d, m)
+		if atomic.AddInt64(&pendingOperations, -1) == 0 {
+			if api, ok := m.(*providerState); ok && api.collectMetrics && collectedAPIMetrics.summary() != "" {
+				diags = append(diags, metricsSummaryDiagnostic())
+			}
+		}
+		return diags
+	}
+}
@@ -0,0 +1,156 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEndpointPath(t *testing.T) {
+	for _, tt := range []struct {
+		path     string
+		expected string
+	}{
+		{"/api/dcim/devices/", "/api/dcim/devices/"},
+		{"/api/dcim/devices/12/", "/api/dcim/devices/{id}/"},
+		{"/api/ipam/prefixes/3/available-ips/", "/api/ipam/prefixes/{id}/available-ips/"},
+		{"/api/foo/1/2/", "/api/foo/{id}/{id}/"},
+	} {
+		assert.Equal(t, tt.expected, normalizeEndpointPath(tt.path))
+	}
+}
+
+func TestMetricsTransport(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/dcim/devices/2/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	metrics := newAPIMetrics()
+	httpClient := &http.Client{
+		Transport: metricsTransport{
+			original: http.DefaultTransport,
+			metrics:  metrics,
+		},
+	}
+
+	for _, path := range []string{"/api/dcim/devices/1/", "/api/dcim/devices/2/", "/api/dcim/sites/"} {
+		resp, err := httpClient.Get(ts.URL + path)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, 2, metrics.endpoints["GET /api/dcim/devices/{id}/"].calls)
+	assert.Equal(t, 1, metrics.endpoints["GET /api/dcim/devices/{id}/"].errors)
+	assert.Equal(t, 1, metrics.endpoints["GET /api/dcim/sites/"].calls)
+	assert.Equal(t, 0, metrics.endpoints["GET /api/dcim/sites/"].errors)
+}
+
+func TestMetricsSummary(t *testing.T) {
+	metrics := newAPIMetrics()
+	assert.Empty(t, metrics.summary())
+
+	metrics.record("GET", "/api/dcim/sites/", 100*time.Millisecond, false)
+	metrics.record("GET", "/api/dcim/devices/1/", 300*time.Millisecond, false)
+	metrics.record("GET", "/api/dcim/devices/2/", 100*time.Millisecond, true)
+
+	summary := metrics.summary()
+	lines := strings.Split(strings.TrimSpace(summary), "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, "Netbox API calls: 3, errors: 1, cumulative latency: 500ms", lines[0])
+	assert.Equal(t, "  GET /api/dcim/devices/{id}/: calls: 2, errors: 1 (50.0%), cumulative latency: 400ms, average latency: 200ms", lines[1])
+	assert.Equal(t, "  GET /api/dcim/sites/: calls: 1, errors: 0 (0.0%), cumulative latency: 100ms, average latency: 100ms", lines[2])
+}
+
+func TestWithMetricsSummary(t *testing.T) {
+	collectedAPIMetrics.record("GET", "/api/dcim/sites/", 100*time.Millisecond, false)
+
+	var nested diag.Diagnostics
+	r := withMetricsSummary(&schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return errors.New("create failed")
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			// An operation finishing while another one is pending does not return the summary
+			r := withMetricsSummary(&schema.Resource{ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return nil
+			}})
+			nested = r.ReadContext(context.Background(), nil, m)
+			return nil
+		},
+	})
+	assert.Nil(t, r.Create)
+	assert.Nil(t, r.Delete)
+
+	api := &providerState{collectMetrics: true}
+
+	diags := r.CreateContext(context.Background(), nil, api)
+	assert.Len(t, diags, 2)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "create failed", diags[0].Summary)
+	assert.Equal(t, diag.Warning, diags[1].Severity)
+	assert.True(t, strings.HasPrefix(diags[1].Summary, "Netbox API calls: "))
+	assert.Contains(t, diags[1].Detail, "GET /api/dcim/sites/")
+
+	diags = r.DeleteContext(context.Background(), nil, api)
+	assert.Len(t, diags, 1)
+	assert.Empty(t, nested)
+
+	// Without metrics collection, no summary is returned
+	diags = r.ReadContext(context.Background(), nil, &providerState{})
+	assert.Empty(t, diags)
+}
+
+func TestWithProviderMetricsSummary(t *testing.T) {
+	read := func(d *schema.ResourceData, m interface{}) error {
+		return nil
+	}
+	newProvider := func(collectMetrics bool) *schema.Provider {
+		return withProviderMetricsSummary(&schema.Provider{
+			ResourcesMap: map[string]*schema.Resource{
+				"netbox_test": {Read: read},
+			},
+			DataSourcesMap: map[string]*schema.Resource{
+				"netbox_test": {Read: read},
+			},
+			ConfigureContextFunc: func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				return &providerState{collectMetrics: collectMetrics}, nil
+			},
+		})
+	}
+
+	// Without metrics collection, the original functions are kept
+	p := newProvider(false)
+	_, diags := p.ConfigureContextFunc(context.Background(), nil)
+	assert.Empty(t, diags)
+	assert.NotNil(t, p.ResourcesMap["netbox_test"].Read)
+	assert.Nil(t, p.ResourcesMap["netbox_test"].ReadContext)
+	assert.NotNil(t, p.DataSourcesMap["netbox_test"].Read)
+
+	// With metrics collection, the functions are wrapped once
+	p = newProvider(true)
+	_, diags = p.ConfigureContextFunc(context.Background(), nil)
+	assert.Empty(t, diags)
+	_, diags = p.ConfigureContextFunc(context.Background(), nil)
+	assert.Empty(t, diags)
+	assert.Nil(t, p.ResourcesMap["netbox_test"].Read)
+	assert.NotNil(t, p.ResourcesMap["netbox_test"].ReadContext)
+	assert.Nil(t, p.DataSourcesMap["netbox_test"].Read)
+	assert.NotNil(t, p.DataSourcesMap["netbox_test"].ReadContext)
+}
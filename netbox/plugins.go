@@ -0,0 +1,45 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+// pluginNetboxDNS is the name of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin.
+const pluginNetboxDNS = "netbox_dns"
+
+// pluginNetboxBGP is the name of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin.
+const pluginNetboxBGP = "netbox_bgp"
+
+// pluginNetboxSecrets is the name of the [netbox-secrets](https://github.com/Onemind-Services-LLC/netbox-secrets)
+// plugin.
+const pluginNetboxSecrets = "netbox_secrets"
+
+// supportedPlugins are the names of the Netbox plugins that have resources in the provider. Plugins are named after
+// their Python package, as in the PLUGINS setting of Netbox.
+var supportedPlugins = []string{pluginNetboxDNS, pluginNetboxBGP, pluginNetboxSecrets}
+
+// hasPlugin reports whether the resources of the given plugin are enabled in the provider configuration.
+func (state *providerState) hasPlugin(plugin string) bool {
+	return slices.Contains(state.plugins, plugin)
+}
+
+// customizeDiffRequirePlugin returns a CustomizeDiffFunc that fails unless the given plugin is enabled in the provider
+// configuration and otherwise runs the given CustomizeDiffFuncs.
+func customizeDiffRequirePlugin(plugin string, resourceName string, customizeDiffs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		api := m.(*providerState)
+		if !api.hasPlugin(plugin) {
+			return fmt.Errorf("%s requires the %s plugin, add %q to the `plugins` argument of the provider to enable its resources", resourceName, plugin, plugin)
+		}
+		for _, customizeDiff := range customizeDiffs {
+			if err := customizeDiff(ctx, d, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
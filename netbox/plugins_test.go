@@ -0,0 +1,22 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomizeDiffRequirePlugin(t *testing.T) {
+	r := resourceNetboxDNSView()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "internal",
+	})
+
+	_, err := r.Diff(context.Background(), nil, config, &providerState{})
+	assert.ErrorContains(t, err, `netbox_dns_view requires the netbox_dns plugin, add "netbox_dns" to the `+"`plugins`")
+
+	_, err = r.Diff(context.Background(), nil, config, &providerState{plugins: []string{pluginNetboxDNS}})
+	assert.NoError(t, err)
+}
@@ -4,12 +4,18 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/status"
+	"github.com/fbreckle/go-netbox/netbox/client/users"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"golang.org/x/exp/slices"
 )
 
@@ -54,77 +60,210 @@ func init() {
 	}
 }
 
+// supportedNetboxVersions are the Netbox versions this provider was successfully tested against, in ascending order.
+var supportedNetboxVersions = []string{
+	"3.3.0", "3.3.1", "3.3.2", "3.3.3", "3.3.4", "3.3.5", "3.3.6", "3.3.7", "3.3.8", "3.3.9",
+	"3.4.0", "3.4.1", "3.4.2", "3.4.3",
+	"3.5.0", "3.5.1", "3.5.2", "3.5.3", "3.5.4", "3.5.5", "3.5.6", "3.5.7", "3.5.8", "3.5.9",
+	"3.6.0", "3.6.1", "3.6.2", "3.6.3", "3.6.4", "3.6.5", "3.6.6", "3.6.7", "3.6.8", "3.6.9",
+	"3.7.0", "3.7.1", "3.7.2", "3.7.3", "3.7.4", "3.7.5", "3.7.6", "3.7.7", "3.7.8",
+	"4.0.0", "4.0.1", "4.0.2", "4.0.3", "4.0.4", "4.0.5", "4.0.6", "4.0.7", "4.0.8", "4.0.9", "4.0.10", "4.0.11",
+	"4.1.0", "4.1.1", "4.1.2", "4.1.3", "4.1.4", "4.1.5", "4.1.6", "4.1.7", "4.1.8", "4.1.9", "4.1.10", "4.1.11",
+	"4.2.0", "4.2.1", "4.2.2", "4.2.3", "4.2.4", "4.2.5", "4.2.6", "4.2.7", "4.2.8", "4.2.9",
+}
+
+// providerState is the meta object that is passed to all resources and data sources.
+// It embeds the Netbox API client so the API can be accessed directly.
+type providerState struct {
+	*client.NetBoxAPI
+
+	// netboxVersion is the version of the Netbox instance, as reported by its status endpoint.
+	// It is empty if the version check is skipped.
+	netboxVersion string
+
+	// defaultTags are the names of the tags that are added to every object managed by the provider.
+	defaultTags []string
+
+	// manageAllCustomFields is true if resources manage all custom fields of their objects instead of only the
+	// configured ones.
+	manageAllCustomFields bool
+
+	// plugins are the names of the Netbox plugins whose resources are enabled.
+	plugins []string
+
+	// secretsPrivateKey is the private key that the session key of the secrets plugin is negotiated with.
+	secretsPrivateKey string
+
+	// secretsSessionKey is the session key of the secrets plugin. It is negotiated on first use unless configured.
+	secretsSessionKey     string
+	secretsSessionKeyLock sync.Mutex
+
+	// userGroupsLock serializes changes of the groups of users, as they are read, modified and written as a whole.
+	userGroupsLock sync.Mutex
+
+	// collectMetrics is true if the API calls are counted and their summary is returned as warning.
+	collectMetrics bool
+
+	// fieldChoices caches the fields of the OPTIONS responses of list endpoints by path, as the choices of Netbox do
+	// not change while the provider runs.
+	fieldChoices     map[string]map[string]interface{}
+	fieldChoicesLock sync.Mutex
+}
+
 // Provider returns a schema.Provider for Netbox.
 func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"netbox_available_ip_address": resourceNetboxAvailableIPAddress(),
-			"netbox_virtual_machine":      resourceNetboxVirtualMachine(),
-			"netbox_cluster_type":         resourceNetboxClusterType(),
-			"netbox_cluster":              resourceNetboxCluster(),
-			"netbox_contact":              resourceNetboxContact(),
-			"netbox_contact_assignment":   resourceNetboxContactAssignment(),
-			"netbox_contact_role":         resourceNetboxContactRole(),
-			"netbox_device":               resourceNetboxDevice(),
-			"netbox_device_interface":     resourceNetboxDeviceInterface(),
-			"netbox_device_type":          resourceNetboxDeviceType(),
-			"netbox_manufacturer":         resourceNetboxManufacturer(),
-			"netbox_tenant":               resourceNetboxTenant(),
-			"netbox_tenant_group":         resourceNetboxTenantGroup(),
-			"netbox_vrf":                  resourceNetboxVrf(),
-			"netbox_ip_address":           resourceNetboxIPAddress(),
-			"netbox_interface":            resourceNetboxInterface(),
-			"netbox_service":              resourceNetboxService(),
-			"netbox_platform":             resourceNetboxPlatform(),
-			"netbox_prefix":               resourceNetboxPrefix(),
-			"netbox_available_prefix":     resourceNetboxAvailablePrefix(),
-			"netbox_primary_ip":           resourceNetboxPrimaryIP(),
-			"netbox_device_role":          resourceNetboxDeviceRole(),
-			"netbox_tag":                  resourceNetboxTag(),
-			"netbox_cluster_group":        resourceNetboxClusterGroup(),
-			"netbox_site":                 resourceNetboxSite(),
-			"netbox_vlan":                 resourceNetboxVlan(),
-			"netbox_ipam_role":            resourceNetboxIpamRole(),
-			"netbox_ip_range":             resourceNetboxIpRange(),
-			"netbox_region":               resourceNetboxRegion(),
-			"netbox_aggregate":            resourceNetboxAggregate(),
-			"netbox_rir":                  resourceNetboxRir(),
-			"netbox_circuit":              resourceNetboxCircuit(),
-			"netbox_circuit_type":         resourceNetboxCircuitType(),
-			"netbox_circuit_provider":     resourceNetboxCircuitProvider(),
-			"netbox_circuit_termination":  resourceNetboxCircuitTermination(),
-			"netbox_user":                 resourceNetboxUser(),
-			"netbox_token":                resourceNetboxToken(),
-			"netbox_custom_field":         resourceCustomField(),
-			"netbox_asn":                  resourceNetboxAsn(),
-			"netbox_location":             resourceNetboxLocation(),
-			"netbox_site_group":           resourceNetboxSiteGroup(),
+			"netbox_available_ip_address":        resourceNetboxAvailableIPAddress(),
+			"netbox_virtual_machine":             resourceNetboxVirtualMachine(),
+			"netbox_cluster_type":                resourceNetboxClusterType(),
+			"netbox_cluster":                     resourceNetboxCluster(),
+			"netbox_contact":                     resourceNetboxContact(),
+			"netbox_contact_assignment":          resourceNetboxContactAssignment(),
+			"netbox_contact_group":               resourceNetboxContactGroup(),
+			"netbox_contact_role":                resourceNetboxContactRole(),
+			"netbox_device":                      resourceNetboxDevice(),
+			"netbox_device_interface":            resourceNetboxDeviceInterface(),
+			"netbox_device_type":                 resourceNetboxDeviceType(),
+			"netbox_ike_policy":                  resourceNetboxIkePolicy(),
+			"netbox_ike_proposal":                resourceNetboxIkeProposal(),
+			"netbox_ipsec_policy":                resourceNetboxIpsecPolicy(),
+			"netbox_ipsec_profile":               resourceNetboxIpsecProfile(),
+			"netbox_ipsec_proposal":              resourceNetboxIpsecProposal(),
+			"netbox_l2vpn":                       resourceNetboxL2VPN(),
+			"netbox_l2vpn_termination":           resourceNetboxL2VPNTermination(),
+			"netbox_manufacturer":                resourceNetboxManufacturer(),
+			"netbox_tenant":                      resourceNetboxTenant(),
+			"netbox_tenant_group":                resourceNetboxTenantGroup(),
+			"netbox_tunnel":                      resourceNetboxTunnel(),
+			"netbox_tunnel_group":                resourceNetboxTunnelGroup(),
+			"netbox_tunnel_termination":          resourceNetboxTunnelTermination(),
+			"netbox_vrf":                         resourceNetboxVrf(),
+			"netbox_wireless_lan":                resourceNetboxWirelessLAN(),
+			"netbox_wireless_lan_group":          resourceNetboxWirelessLANGroup(),
+			"netbox_ip_address":                  resourceNetboxIPAddress(),
+			"netbox_interface":                   resourceNetboxInterface(),
+			"netbox_service":                     resourceNetboxService(),
+			"netbox_platform":                    resourceNetboxPlatform(),
+			"netbox_prefix":                      resourceNetboxPrefix(),
+			"netbox_available_prefix":            resourceNetboxAvailablePrefix(),
+			"netbox_primary_ip":                  resourceNetboxPrimaryIP(),
+			"netbox_virtual_machine_primary_ip":  resourceNetboxVirtualMachinePrimaryIP(),
+			"netbox_device_role":                 resourceNetboxDeviceRole(),
+			"netbox_tag":                         resourceNetboxTag(),
+			"netbox_cluster_group":               resourceNetboxClusterGroup(),
+			"netbox_site":                        resourceNetboxSite(),
+			"netbox_vlan":                        resourceNetboxVlan(),
+			"netbox_vlan_group":                  resourceNetboxVlanGroup(),
+			"netbox_vlan_translation_policy":     resourceNetboxVlanTranslationPolicy(),
+			"netbox_vlan_translation_rule":       resourceNetboxVlanTranslationRule(),
+			"netbox_vm_interfaces":               resourceNetboxVMInterfaces(),
+			"netbox_ipam_role":                   resourceNetboxIpamRole(),
+			"netbox_ip_range":                    resourceNetboxIpRange(),
+			"netbox_region":                      resourceNetboxRegion(),
+			"netbox_aggregate":                   resourceNetboxAggregate(),
+			"netbox_rir":                         resourceNetboxRir(),
+			"netbox_circuit":                     resourceNetboxCircuit(),
+			"netbox_circuit_type":                resourceNetboxCircuitType(),
+			"netbox_circuit_provider":            resourceNetboxCircuitProvider(),
+			"netbox_circuit_termination":         resourceNetboxCircuitTermination(),
+			"netbox_provider_account":            resourceNetboxProviderAccount(),
+			"netbox_virtual_circuit":             resourceNetboxVirtualCircuit(),
+			"netbox_virtual_circuit_type":        resourceNetboxVirtualCircuitType(),
+			"netbox_virtual_circuit_termination": resourceNetboxVirtualCircuitTermination(),
+			"netbox_user":                        resourceNetboxUser(),
+			"netbox_token":                       resourceNetboxToken(),
+			"netbox_group":                       resourceNetboxGroup(),
+			"netbox_user_group_assignment":       resourceNetboxUserGroupAssignment(),
+			"netbox_config_context":              resourceNetboxConfigContext(),
+			"netbox_custom_field":                resourceCustomField(),
+			"netbox_event_rule":                  resourceNetboxEventRule(),
+			"netbox_saved_filter":                resourceNetboxSavedFilter(),
+			"netbox_script_execution":            resourceNetboxScriptExecution(),
+			"netbox_data_source":                 resourceNetboxDataSource(),
+			"netbox_data_source_sync":            resourceNetboxDataSourceSync(),
+			"netbox_bookmark":                    resourceNetboxBookmark(),
+			"netbox_branch":                      resourceNetboxBranch(),
+			"netbox_dns_view":                    resourceNetboxDNSView(),
+			"netbox_dns_nameserver":              resourceNetboxDNSNameserver(),
+			"netbox_dns_zone":                    resourceNetboxDNSZone(),
+			"netbox_dns_record":                  resourceNetboxDNSRecord(),
+			"netbox_bgp_session":                 resourceNetboxBGPSession(),
+			"netbox_bgp_peer_group":              resourceNetboxBGPPeerGroup(),
+			"netbox_bgp_community":               resourceNetboxBGPCommunity(),
+			"netbox_routing_policy":              resourceNetboxRoutingPolicy(),
+			"netbox_secret_role":                 resourceNetboxSecretRole(),
+			"netbox_secret":                      resourceNetboxSecret(),
+			"netbox_asn":                         resourceNetboxAsn(),
+			"netbox_asn_range":                   resourceNetboxAsnRange(),
+			"netbox_available_asn":               resourceNetboxAvailableAsn(),
+			"netbox_fhrp_group":                  resourceNetboxFhrpGroup(),
+			"netbox_fhrp_group_assignment":       resourceNetboxFhrpGroupAssignment(),
+			"netbox_ip_address_assignment":       resourceNetboxIPAddressAssignment(),
+			"netbox_location":                    resourceNetboxLocation(),
+			"netbox_site_group":                  resourceNetboxSiteGroup(),
+			"netbox_object_tags":                 resourceNetboxObjectTags(),
+			"netbox_rack_role":                   resourceNetboxRackRole(),
+			"netbox_cable":                       resourceNetboxCable(),
+			"netbox_device_console_port":         resourceNetboxDeviceConsolePort(),
+			"netbox_device_console_server_port":  resourceNetboxDeviceConsoleServerPort(),
+			"netbox_device_power_port":           resourceNetboxDevicePowerPort(),
+			"netbox_device_power_outlet":         resourceNetboxDevicePowerOutlet(),
+			"netbox_power_panel":                 resourceNetboxPowerPanel(),
+			"netbox_power_feed":                  resourceNetboxPowerFeed(),
+			"netbox_module_type":                 resourceNetboxModuleType(),
+			"netbox_module":                      resourceNetboxModule(),
+			"netbox_device_module_bay":           resourceNetboxDeviceModuleBay(),
+			"netbox_device_bay":                  resourceNetboxDeviceBay(),
+			"netbox_inventory_item_role":         resourceNetboxInventoryItemRole(),
+			"netbox_inventory_item":              resourceNetboxInventoryItem(),
+			"netbox_device_rear_port":            resourceNetboxDeviceRearPort(),
+			"netbox_device_front_port":           resourceNetboxDeviceFrontPort(),
+			"netbox_device_interface_template":   resourceNetboxDeviceInterfaceTemplate(),
+			"netbox_console_port_template":       resourceNetboxConsolePortTemplate(),
+			"netbox_power_port_template":         resourceNetboxPowerPortTemplate(),
+			"netbox_power_outlet_template":       resourceNetboxPowerOutletTemplate(),
+			"netbox_front_port_template":         resourceNetboxFrontPortTemplate(),
+			"netbox_rear_port_template":          resourceNetboxRearPortTemplate(),
+			"netbox_module_bay_template":         resourceNetboxModuleBayTemplate(),
+			"netbox_device_bay_template":         resourceNetboxDeviceBayTemplate(),
+			"netbox_mac_address":                 resourceNetboxMACAddress(),
+			"netbox_device_primary_ip":           resourceNetboxDevicePrimaryIP(),
+			"netbox_device_interfaces":           resourceNetboxDeviceInterfaces(),
+			"netbox_available_rack_position":     resourceNetboxAvailableRackPosition(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"netbox_asn":              dataSourceNetboxAsn(),
-			"netbox_asns":             dataSourceNetboxAsns(),
-			"netbox_cluster":          dataSourceNetboxCluster(),
-			"netbox_cluster_group":    dataSourceNetboxClusterGroup(),
-			"netbox_cluster_type":     dataSourceNetboxClusterType(),
-			"netbox_tenant":           dataSourceNetboxTenant(),
-			"netbox_tenants":          dataSourceNetboxTenants(),
-			"netbox_tenant_group":     dataSourceNetboxTenantGroup(),
-			"netbox_vrf":              dataSourceNetboxVrf(),
-			"netbox_platform":         dataSourceNetboxPlatform(),
-			"netbox_prefix":           dataSourceNetboxPrefix(),
-			"netbox_prefixes":         dataSourceNetboxPrefixes(),
-			"netbox_devices":          dataSourceNetboxDevices(),
-			"netbox_device_role":      dataSourceNetboxDeviceRole(),
-			"netbox_device_type":      dataSourceNetboxDeviceType(),
-			"netbox_site":             dataSourceNetboxSite(),
-			"netbox_tag":              dataSourceNetboxTag(),
-			"netbox_virtual_machines": dataSourceNetboxVirtualMachine(),
-			"netbox_interfaces":       dataSourceNetboxInterfaces(),
-			"netbox_ip_addresses":     dataSourceNetboxIpAddresses(),
-			"netbox_ip_range":         dataSourceNetboxIpRange(),
-			"netbox_region":           dataSourceNetboxRegion(),
-			"netbox_vlan":             dataSourceNetboxVlan(),
-			"netbox_site_group":       dataSourceNetboxSiteGroup(),
+			"netbox_asn":               dataSourceNetboxAsn(),
+			"netbox_asns":              dataSourceNetboxAsns(),
+			"netbox_cluster":           dataSourceNetboxCluster(),
+			"netbox_cluster_group":     dataSourceNetboxClusterGroup(),
+			"netbox_cluster_type":      dataSourceNetboxClusterType(),
+			"netbox_tenant":            dataSourceNetboxTenant(),
+			"netbox_tenants":           dataSourceNetboxTenants(),
+			"netbox_tenant_group":      dataSourceNetboxTenantGroup(),
+			"netbox_contact":           dataSourceNetboxContact(),
+			"netbox_vrf":               dataSourceNetboxVrf(),
+			"netbox_platform":          dataSourceNetboxPlatform(),
+			"netbox_prefix":            dataSourceNetboxPrefix(),
+			"netbox_prefixes":          dataSourceNetboxPrefixes(),
+			"netbox_devices":           dataSourceNetboxDevices(),
+			"netbox_device_role":       dataSourceNetboxDeviceRole(),
+			"netbox_device_type":       dataSourceNetboxDeviceType(),
+			"netbox_site":              dataSourceNetboxSite(),
+			"netbox_object_changes":    dataSourceNetboxObjectChanges(),
+			"netbox_data_file":         dataSourceNetboxDataFile(),
+			"netbox_tag":               dataSourceNetboxTag(),
+			"netbox_virtual_machine":   dataSourceNetboxVirtualMachine(),
+			"netbox_virtual_machines":  dataSourceNetboxVirtualMachines(),
+			"netbox_interfaces":        dataSourceNetboxInterfaces(),
+			"netbox_device_interfaces": dataSourceNetboxDeviceInterfaces(),
+			"netbox_ip_addresses":      dataSourceNetboxIpAddresses(),
+			"netbox_ip_range":          dataSourceNetboxIpRange(),
+			"netbox_region":            dataSourceNetboxRegion(),
+			"netbox_vlan":              dataSourceNetboxVlan(),
+			"netbox_vlans":             dataSourceNetboxVlans(),
+			"netbox_site_group":        dataSourceNetboxSiteGroup(),
+			"netbox_cable_trace":       dataSourceNetboxCableTrace(),
 		},
 		Schema: map[string]*schema.Schema{
 			"server_url": {
@@ -135,9 +274,31 @@ func Provider() *schema.Provider {
 			},
 			"api_token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_API_TOKEN", nil),
-				Description: "Netbox API authentication token. Can be set via the `NETBOX_API_TOKEN` environment variable.",
+				Description: "Netbox API authentication token. Required unless `username` and `password` are given. Can be set via the `NETBOX_API_TOKEN` environment variable.",
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_USERNAME", nil),
+				RequiredWith: []string{"password"},
+				Description:  "Netbox username. If no `api_token` is given, `username` and `password` are exchanged for a new API token that expires after `token_lifetime`. The token is reused while the provider runs and revoked when it shuts down. Tokens that could not be revoked, e.g. because Terraform was interrupted, are deleted once they are expired the next time a token is provisioned for the user. Can be set via the `NETBOX_USERNAME` environment variable.",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_PASSWORD", nil),
+				RequiredWith: []string{"username"},
+				Description:  "Password of the Netbox user given in `username`. Can be set via the `NETBOX_PASSWORD` environment variable.",
+			},
+			"token_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_TOKEN_LIFETIME", 1440),
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "Lifetime in minutes of the API token provisioned from `username` and `password`. Has to be longer than the longest Terraform run. Can be set via the `NETBOX_TOKEN_LIFETIME` environment variable. Defaults to `1440`.",
 			},
 			"allow_insecure_https": {
 				Type:        schema.TypeBool,
@@ -145,6 +306,101 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_ALLOW_INSECURE_HTTPS", false),
 				Description: "Flag to set whether to allow https with invalid certificates. Can be set via the `NETBOX_ALLOW_INSECURE_HTTPS` environment variable. Defaults to `false`.",
 			},
+			"base_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_BASE_PATH", "/api"),
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with a slash"),
+				Description:  "Path of the Netbox API relative to the `server_url`. Only needs to be changed if a reverse proxy in front of Netbox rewrites the API path. Note that Netbox instances mounted under a sub-path can be used by including the sub-path in the `server_url`. Can be set via the `NETBOX_BASE_PATH` environment variable. Defaults to `/api`.",
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path to a PEM encoded CA certificate file that is trusted in addition to the system certificate pool when connecting to Netbox via https. Useful for Netbox instances using certificates issued by an internal PKI. Can be set via the `NETBOX_CA_CERT_FILE` environment variable.",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_PEM", nil),
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA certificate that is trusted in addition to the system certificate pool when connecting to Netbox via https. Can be set via the `NETBOX_CA_CERT_PEM` environment variable.",
+			},
+			"client_cert_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_FILE", nil),
+				RequiredWith: []string{"client_key_file"},
+				Description:  "Path to a PEM encoded client certificate file used for TLS client authentication (mTLS). Can be set via the `NETBOX_CLIENT_CERT_FILE` environment variable.",
+			},
+			"client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_FILE", nil),
+				RequiredWith: []string{"client_cert_file"},
+				Description:  "Path to the PEM encoded private key file belonging to `client_cert_file`. Can be set via the `NETBOX_CLIENT_KEY_FILE` environment variable.",
+			},
+			"debug_api_calls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_DEBUG_API_CALLS", false),
+				Description: "If true, log method, path, status and elapsed time of every request to Netbox. The log entries are written at the `INFO` level and can be viewed by setting the `TF_LOG` environment variable. Can be set via the `NETBOX_DEBUG_API_CALLS` environment variable. Defaults to `false`.",
+			},
+			"collect_metrics": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_COLLECT_METRICS", false),
+				Description: "If true, count the API calls, failed calls and cumulative latency per endpoint and show a summary as warning at the end of the run. The warning is returned by every operation that finishes while no other operation is pending, so a run can show several of them, the last one covers the whole run. Useful to tune parallelism and to find slow data sources. Can be set via the `NETBOX_COLLECT_METRICS` environment variable. Defaults to `false`.",
+			},
+			"default_tags": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: "Names of tags that are added to every object with a `tags` attribute that is created or updated by this provider, e.g. to mark all objects as managed by Terraform. The tags must already exist in Netbox. Default tags are not shown in the `tags` attribute of resources unless they are also given there explicitly.",
+			},
+			"branch": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_BRANCH", nil),
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{8}$`), "must be the schema ID of a branch, e.g. `td5smq0f`"),
+				Description:  "Schema ID of a branch of the [branching plugin](https://github.com/netboxlabs/netbox-branching), e.g. `td5smq0f`. If set, all changes are made in the branch instead of the main schema of Netbox, so they can be reviewed and merged later in Netbox. Branches can be created with the `netbox_branch` resource. Can be set via the `NETBOX_BRANCH` environment variable.",
+			},
+			"plugins": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(supportedPlugins, false),
+				},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: "Names of the Netbox plugins whose resources are enabled. Supported are `netbox_dns` for the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin, `netbox_bgp` for the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin and `netbox_secrets` for the [netbox-secrets](https://github.com/Onemind-Services-LLC/netbox-secrets) plugin. The plugins must be installed in Netbox. Resources of plugins that are not given here fail during plan, so they are not used by mistake against Netbox instances without the plugin.",
+			},
+			"secrets_private_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_SECRETS_PRIVATE_KEY", nil),
+				ConflictsWith: []string{"secrets_session_key"},
+				Description:   "PEM encoded private RSA key of the user key of the Netbox user in the secrets plugin. The provider negotiates a session key with it when a secret is first created or changed. The existing session keys of the user are preserved. Can be set via the `NETBOX_SECRETS_PRIVATE_KEY` environment variable.",
+			},
+			"secrets_session_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_SECRETS_SESSION_KEY", nil),
+				ConflictsWith: []string{"secrets_private_key"},
+				Description:   "Session key of the secrets plugin to encrypt secrets with, as an alternative to `secrets_private_key`. Can be set via the `NETBOX_SECRETS_SESSION_KEY` environment variable.",
+			},
+			"manage_all_custom_fields": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_MANAGE_ALL_CUSTOM_FIELDS", false),
+				Description: "If true, resources manage all custom fields of their objects: custom fields that are set in Netbox but not in the `custom_fields` attribute are shown as drift and cleared on apply. By default, only the custom fields given in the `custom_fields` attribute are managed and all other custom fields are left untouched, so they can be set by other means, e.g. Netbox scripts. Can be set via the `NETBOX_MANAGE_ALL_CUSTOM_FIELDS` environment variable. Defaults to `false`.",
+			},
 			"headers": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -157,6 +413,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_STRIP_TRAILING_SLASHES_FROM_URL", true),
 				Description: "If true, strip trailing slashes from the `server_url` parameter and print a warning when doing so. Note that using trailing slashes in the `server_url` parameter will usually lead to errors. Can be set via the `NETBOX_STRIP_TRAILING_SLASHES_FROM_URL` environment variable. Defaults to `true`.",
 			},
+			"validate_credentials": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_VALIDATE_CREDENTIALS", false),
+				Description: "If true, perform an authenticated request at provider startup to make sure the given credentials are valid. Can be set via the `NETBOX_VALIDATE_CREDENTIALS` environment variable. Defaults to `false`.",
+			},
 			"skip_version_check": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -164,14 +426,54 @@ func Provider() *schema.Provider {
 				Description: "If true, do not try to determine the running Netbox version at provider startup. Disables warnings about possibly unsupported Netbox version. Also useful for local testing on terraform plans. Can be set via the `NETBOX_SKIP_VERSION_CHECK` environment variable. Defaults to `false`.",
 			},
 			"request_timeout": {
-				Type:        schema.TypeInt,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_REQUEST_TIMEOUT", 10),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Netbox API HTTP request timeout in seconds. Increase this value for large Netbox instances where heavy list queries take longer. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable. Defaults to `10`.",
+			},
+			"disable_compression": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("NETBOX_REQUEST_TIMEOUT", 10),
-				Description: "Netbox API HTTP request timeout in seconds. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable.",
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_DISABLE_COMPRESSION", false),
+				Description: "If true, do not request gzip compressed responses from Netbox. Compression greatly reduces the transfer time of large list queries, but may have to be disabled for proxies that mishandle compressed responses. Can be set via the `NETBOX_DISABLE_COMPRESSION` environment variable. Defaults to `false`.",
+			},
+			"max_parallel_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAX_PARALLEL_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests to Netbox that are in flight at the same time, regardless of Terraform's parallelism. Useful for small Netbox instances that get overwhelmed by many parallel requests. A value of `0` disables the limit. Can be set via the `NETBOX_MAX_PARALLEL_REQUESTS` environment variable. Defaults to `0`.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAX_RETRIES", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of times a request to Netbox is retried if it is answered with one of the status codes in `retry_on_status_codes`. Retries use exponential backoff with jitter. Requests that create or modify objects, e.g. the allocation of available IP addresses, are only retried on `429` or if Netbox sends a `Retry-After` header, as they may already have been processed. Can be set via the `NETBOX_MAX_RETRIES` environment variable. Defaults to `0`.",
+			},
+			"retry_on_status_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(400, 599),
+				},
+				Description: "HTTP status codes that cause a request to be retried if `max_retries` is greater than zero. Defaults to `[429, 502, 503, 504]`.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of requests per second sent to Netbox. Use this to avoid hitting rate limits of Netbox or a reverse proxy in front of it during large applies. A value of `0` disables rate limiting. Can be set via the `NETBOX_REQUESTS_PER_SECOND` environment variable. Defaults to `0`.",
 			},
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	withProviderMetricsSummary(provider)
+
 	return provider
 }
 
@@ -181,10 +483,30 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 
 	config := Config{
 		APIToken:                    data.Get("api_token").(string),
+		BasePath:                    data.Get("base_path").(string),
+		Username:                    data.Get("username").(string),
+		Password:                    data.Get("password").(string),
+		TokenLifetime:               data.Get("token_lifetime").(int),
 		AllowInsecureHttps:          data.Get("allow_insecure_https").(bool),
+		CACertFile:                  data.Get("ca_cert_file").(string),
+		CACertPEM:                   data.Get("ca_cert_pem").(string),
+		ClientCertFile:              data.Get("client_cert_file").(string),
+		ClientKeyFile:               data.Get("client_key_file").(string),
 		Headers:                     data.Get("headers").(map[string]interface{}),
 		RequestTimeout:              data.Get("request_timeout").(int),
 		StripTrailingSlashesFromURL: data.Get("strip_trailing_slashes_from_url").(bool),
+		MaxRetries:                  data.Get("max_retries").(int),
+		RequestsPerSecond:           data.Get("requests_per_second").(float64),
+		DebugAPICalls:               data.Get("debug_api_calls").(bool),
+		MaxParallelRequests:         data.Get("max_parallel_requests").(int),
+		DisableCompression:          data.Get("disable_compression").(bool),
+		CollectMetrics:              data.Get("collect_metrics").(bool),
+		Branch:                      data.Get("branch").(string),
+		LogContext:                  ctx,
+	}
+
+	for _, code := range data.Get("retry_on_status_codes").(*schema.Set).List() {
+		config.RetryOnStatusCodes = append(config.RetryOnStatusCodes, code.(int))
 	}
 
 	serverURL := data.Get("server_url").(string)
@@ -213,37 +535,149 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 
 	config.ServerURL = serverURL
 
+	if config.APIToken == "" && (config.Username == "" || config.Password == "") {
+		return nil, append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Missing Netbox credentials",
+			Detail:        "Either `api_token` or `username` and `password` must be set. They can also be set via the `NETBOX_API_TOKEN` or the `NETBOX_USERNAME` and `NETBOX_PASSWORD` environment variables.",
+			AttributePath: cty.GetAttrPath("api_token"),
+		})
+	}
+
 	netboxClient, clientError := config.Client()
 	if clientError != nil {
-		return nil, diag.FromErr(clientError)
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Netbox client",
+			Detail:   clientError.Error(),
+		})
+	}
+
+	if data.Get("validate_credentials").(bool) {
+		credentialsAttribute := "api_token"
+		if config.APIToken == "" {
+			credentialsAttribute = "username"
+		}
+		diags = append(diags, validateCredentials(netboxClient.(*client.NetBoxAPI), credentialsAttribute)...)
+		if diags.HasError() {
+			return nil, diags
+		}
 	}
 
 	// Unless explicitly switched off, use the client to retrieve the Netbox version
 	// so we can determine compatibility of the provider with the used Netbox
 	skipVersionCheck := data.Get("skip_version_check").(bool)
 
+	state := &providerState{
+		NetBoxAPI:             netboxClient.(*client.NetBoxAPI),
+		manageAllCustomFields: data.Get("manage_all_custom_fields").(bool),
+		secretsPrivateKey:     data.Get("secrets_private_key").(string),
+		secretsSessionKey:     data.Get("secrets_session_key").(string),
+		collectMetrics:        config.CollectMetrics,
+	}
+
+	for _, tag := range data.Get("default_tags").(*schema.Set).List() {
+		state.defaultTags = append(state.defaultTags, tag.(string))
+	}
+
+	for _, plugin := range data.Get("plugins").(*schema.Set).List() {
+		state.plugins = append(state.plugins, plugin.(string))
+	}
+
 	if !skipVersionCheck {
 		req := status.NewStatusListParams()
-		res, err := netboxClient.(*client.NetBoxAPI).Status.StatusList(req, nil)
+		res, err := state.Status.StatusList(req, nil)
 
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to retrieve Netbox version",
+				Detail:   fmt.Sprintf("The request to the status endpoint of Netbox at %s failed: %s. Make sure that `server_url` is correct and Netbox is reachable, or use the `skip_version_check` parameter to disable the version check.", serverURL, err),
+			})
+		}
+
+		payload, _ := res.GetPayload().(map[string]interface{})
+		netboxVersion, ok := payload["netbox-version"].(string)
+		if !ok {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to determine Netbox version",
+				Detail:   "The status endpoint of Netbox did not report a version. Use the `skip_version_check` parameter to disable the version check.",
+			})
 		}
 
-		netboxVersion := res.GetPayload().(map[string]interface{})["netbox-version"].(string)
+		diags = append(diags, checkNetboxVersion(netboxVersion)...)
+		if diags.HasError() {
+			return nil, diags
+		}
 
-		supportedVersions := []string{"3.3.0", "3.3.1", "3.3.2", "3.3.3", "3.3.4", "3.3.5", "3.3.6", "3.3.7", "3.3.8", "3.3.9", "3.4.0", "3.4.1", "3.4.2", "3.4.3"}
+		state.netboxVersion = netboxVersion
+	}
 
-		if !slices.Contains(supportedVersions, netboxVersion) {
+	return state, diags
+}
 
-			// Currently, there is no way to test these warnings. There is an issue to track this: https://github.com/hashicorp/terraform-plugin-sdk/issues/864
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "Possibly unsupported Netbox version",
-				Detail:   fmt.Sprintf("Your Netbox version is v%v. The provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersion, strings.Join(supportedVersions, ", ")),
+// validateCredentials performs a request that requires authentication and converts authentication
+// failures into a diagnostic for the attribute holding the credentials.
+func validateCredentials(api *client.NetBoxAPI, credentialsAttribute string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := users.NewUsersConfigListParams()
+	_, err := api.Users.UsersConfigList(params, nil)
+	if err == nil {
+		return diags
+	}
+
+	if apiErr, ok := err.(*users.UsersConfigListDefault); ok && (apiErr.Code() == 401 || apiErr.Code() == 403) {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid Netbox credentials",
+			Detail:        fmt.Sprintf("Netbox rejected the given credentials with status code %d. Make sure that the API token exists, is not expired and is allowed to be used from this IP address, or that username and password are correct.", apiErr.Code()),
+			AttributePath: cty.GetAttrPath(credentialsAttribute),
+		})
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Unable to validate Netbox credentials",
+		Detail:   err.Error(),
+	})
+}
+
+// checkNetboxVersion returns an error if the given Netbox version is older than the oldest supported version
+// and a warning if the provider was not tested against the given version.
+func checkNetboxVersion(netboxVersion string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !slices.Contains(supportedNetboxVersions, netboxVersion) {
+		parsedVersion, err := version.NewVersion(netboxVersion)
+		if err == nil && parsedVersion.LessThan(version.Must(version.NewVersion(supportedNetboxVersions[0]))) {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unsupported Netbox version",
+				Detail:   fmt.Sprintf("Your Netbox version is v%v. This provider requires at least Netbox v%v. Use an older version of the provider (see the provider documentation for a compatibility table) or use the `skip_version_check` parameter to disable this check.", netboxVersion, supportedNetboxVersions[0]),
 			})
 		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Possibly unsupported Netbox version",
+			Detail:   fmt.Sprintf("Your Netbox version is v%v. The provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersion, strings.Join(supportedNetboxVersions, ", ")),
+		})
 	}
 
-	return netboxClient, diags
+	return diags
+}
+
+// hasNetboxVersion reports whether the Netbox instance has at least the given version.
+// If the version check is skipped, the version is unknown and the latest supported features are assumed.
+func (state *providerState) hasNetboxVersion(minimum string) bool {
+	if state.netboxVersion == "" {
+		return true
+	}
+	current, err := version.NewVersion(state.netboxVersion)
+	if err != nil {
+		return true
+	}
+	return current.GreaterThanOrEqual(version.Must(version.NewVersion(minimum)))
 }
@@ -3,15 +3,20 @@ package netbox
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -64,7 +69,7 @@ func providerInvalidConfigure() schema.ConfigureContextFunc {
 			return nil, diag.FromErr(clientError)
 		}
 
-		return netboxClient, diags
+		return &providerState{NetBoxAPI: netboxClient.(*client.NetBoxAPI)}, diags
 	}
 }
 
@@ -88,3 +93,134 @@ func TestAccNetboxProviderConfigure_failure(t *testing.T) {
 		},
 	})
 }
+
+func TestCheckNetboxVersion(t *testing.T) {
+	diags := checkNetboxVersion(supportedNetboxVersions[len(supportedNetboxVersions)-1])
+	assert.Len(t, diags, 0)
+
+	diags = checkNetboxVersion("99.0.0")
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+
+	diags = checkNetboxVersion("2.11.12")
+	assert.True(t, diags.HasError())
+}
+
+func TestProviderStateHasNetboxVersion(t *testing.T) {
+	state := &providerState{netboxVersion: "3.4.3"}
+	assert.True(t, state.hasNetboxVersion("3.3.0"))
+	assert.True(t, state.hasNetboxVersion("3.4.3"))
+	assert.False(t, state.hasNetboxVersion("3.5.0"))
+
+	// Unknown version, e.g. the version check was skipped
+	state = &providerState{}
+	assert.True(t, state.hasNetboxVersion("3.5.0"))
+}
+
+func TestAccNetboxProvider_defaultTags(t *testing.T) {
+
+	testSlug := "prov_deftags"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}`, testName),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "netbox" {
+  default_tags = ["%[1]s"]
+}
+
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateCredentials(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Token 0123456789abcdef0123456789abcdef01234567" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"detail": "Invalid token"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "0123456789abcdef0123456789abcdef01234567",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+
+	diags := validateCredentials(netboxClient.(*client.NetBoxAPI), "api_token")
+	assert.Len(t, diags, 0)
+
+	config.APIToken = "invalid"
+	netboxClient, err = config.Client()
+	assert.NoError(t, err)
+
+	diags = validateCredentials(netboxClient.(*client.NetBoxAPI), "api_token")
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Invalid Netbox credentials", diags[0].Summary)
+	assert.Equal(t, cty.GetAttrPath("api_token"), diags[0].AttributePath)
+}
+
+func TestProviderConfigureMalformedURL(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url": "xyz:/localhost:8080",
+		"api_token":  "0123456789abcdef0123456789abcdef01234567",
+	})
+
+	_, diags := providerConfigure(context.Background(), d)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Unable to create Netbox client", diags[len(diags)-1].Summary)
+}
+
+func TestProviderConfigureMissingCredentials(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url": "http://localhost:8080",
+	})
+	d.Set("api_token", "")
+
+	_, diags := providerConfigure(context.Background(), d)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Missing Netbox credentials", diags[len(diags)-1].Summary)
+	assert.Equal(t, cty.GetAttrPath("api_token"), diags[len(diags)-1].AttributePath)
+}
+
+func TestProviderConfigureUnreachableHost(t *testing.T) {
+	// Start and immediately stop a server to get the address of a closed port
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url": ts.URL + "/",
+		"api_token":  "0123456789abcdef0123456789abcdef01234567",
+	})
+
+	_, diags := providerConfigure(context.Background(), d)
+	assert.True(t, diags.HasError())
+	// The warning about the stripped trailing slash is preserved
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "Unable to retrieve Netbox version", diags[len(diags)-1].Summary)
+}
@@ -1,9 +1,10 @@
 package netbox
 
 import (
+	"fmt"
+	"regexp"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,7 +40,14 @@ func resourceNetboxAggregate() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"date_added": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format YYYY-MM-DD"),
+				Description:  "The date the prefix was allocated by the RIR, in the format `YYYY-MM-DD`.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -47,7 +55,7 @@ func resourceNetboxAggregate() *schema.Resource {
 	}
 }
 func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableAggregate{}
 
 	prefix := d.Get("prefix").(string)
@@ -66,6 +74,14 @@ func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	dateAdded, err := getOptionalDate(d, "date_added")
+	if err != nil {
+		return err
+	}
+	data.DateAdded = dateAdded
+
 	params := ipam.NewIpamAggregatesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamAggregatesCreate(params, nil)
 	if err != nil {
@@ -77,7 +93,7 @@ func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAggregatesReadParams().WithID(id)
 
@@ -109,13 +125,24 @@ func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("rir_id", nil)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	if res.GetPayload().DateAdded != nil {
+		d.Set("date_added", res.GetPayload().DateAdded.String())
+	} else {
+		d.Set("date_added", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
 	return nil
 }
 
 func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableAggregate{}
 	prefix := d.Get("prefix").(string)
@@ -134,16 +161,32 @@ func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	dateAdded, err := getOptionalDate(d, "date_added")
+	if err != nil {
+		return err
+	}
+	data.DateAdded = dateAdded
+
 	params := ipam.NewIpamAggregatesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamAggregatesUpdate(params, nil)
+	_, err = api.Ipam.IpamAggregatesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
+
+	// The generated client omits an empty date, which would keep the old one
+	if d.HasChange("date_added") && dateAdded == nil {
+		_, err = genericAPIRequest(api, "PATCH", fmt.Sprintf("/ipam/aggregates/%d/", id), map[string]interface{}{"date_added": nil})
+		if err != nil {
+			return err
+		}
+	}
 	return resourceNetboxAggregateRead(d, m)
 }
 
 func resourceNetboxAggregateDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAggregatesDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamAggregatesDelete(params, nil)
@@ -36,6 +36,23 @@ resource "netbox_aggregate" "test" {
 					resource.TestCheckResourceAttr("netbox_aggregate.test", "prefix", testPrefix),
 					resource.TestCheckResourceAttr("netbox_aggregate.test", "description", testDesc),
 					resource.TestCheckResourceAttrPair("netbox_aggregate.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("netbox_aggregate.test", "date_added", ""),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%s"
+  slug = "%s"
+}
+resource "netbox_aggregate" "test" {
+  prefix = "%s"
+  description = "%s"
+  rir_id = netbox_rir.test.id
+  date_added = "2020-01-31"
+}`, testName, randomSlug, testPrefix, testDesc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_aggregate.test", "date_added", "2020-01-31"),
 				),
 			},
 			{
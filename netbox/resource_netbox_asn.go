@@ -1,12 +1,13 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxAsn() *schema.Resource {
@@ -30,7 +31,17 @@ func resourceNetboxAsn() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -39,7 +50,7 @@ func resourceNetboxAsn() *schema.Resource {
 }
 
 func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableASN{}
 
@@ -49,8 +60,16 @@ func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
 	rir := int64(d.Get("rir_id").(int))
 	data.Rir = &rir
 
+	data.Description = d.Get("description").(string)
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data.Tenant = int64ToPtr(int64(tenantID.(int)))
+	}
+
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamAsnsCreateParams().WithData(&data)
 
 	res, err := api.Ipam.IpamAsnsCreate(params, nil)
@@ -64,7 +83,7 @@ func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAsnsReadParams().WithID(id)
 
@@ -81,15 +100,27 @@ func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Set("asn", res.GetPayload().Asn)
+	d.Set("description", res.GetPayload().Description)
 	d.Set("rir_id", res.GetPayload().Rir)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	if res.GetPayload().Tenant != nil {
+		d.Set("tenant_id", res.GetPayload().Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
 	return nil
 }
 
 func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableASN{}
@@ -100,8 +131,16 @@ func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
 	rir := int64(d.Get("rir_id").(int))
 	data.Rir = &rir
 
+	data.Description = d.Get("description").(string)
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data.Tenant = int64ToPtr(int64(tenantID.(int)))
+	}
+
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamAsnsUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Ipam.IpamAsnsUpdate(params, nil)
@@ -109,11 +148,19 @@ func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/ipam/asns/%d/", id), map[string]string{
+		"tenant_id":   "tenant",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxAsnRead(d, m)
 }
 
 func resourceNetboxAsnDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAsnsDeleteParams().WithID(id)
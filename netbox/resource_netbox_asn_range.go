@@ -0,0 +1,207 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// asnRangeMinimumNetboxVersion is the first Netbox version with ASN ranges.
+const asnRangeMinimumNetboxVersion = "3.5.0"
+
+func resourceNetboxAsnRange() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxAsnRangeCreate,
+		Read:          resourceNetboxAsnRangeRead,
+		Update:        resourceNetboxAsnRangeUpdate,
+		Delete:        resourceNetboxAsnRangeDelete,
+		CustomizeDiff: resourceNetboxAsnRangeCustomizeDiff,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/asnrange/):
+
+> Ranges can be defined to group AS numbers numerically and to facilitate their automatic provisioning. Each range must be assigned to a RIR.
+
+This resource requires Netbox 3.5 or later. It is not covered by the generated API client and therefore uses the generic API of the provider. Use ` + "`netbox_available_asn`" + ` to allocate ASNs from a range.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"start": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+				Description:  "The first ASN of the range.",
+			},
+			"end": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+				Description:  "The last ASN of the range.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxAsnRangeCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(asnRangeMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_asn_range requires Netbox %s or later, but the Netbox version is %s", asnRangeMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/ipam/asn-ranges/", getAsnRangeRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxAsnRangeRead(d, m)
+}
+
+func resourceNetboxAsnRangeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	asnRange, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/asn-ranges/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", asnRange["name"])
+	d.Set("slug", asnRange["slug"])
+	d.Set("description", asnRange["description"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(asnRange)))
+
+	cf := getManagedCustomFields(api, d, asnRange[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	if start, ok := getGenericInt(asnRange, "start"); ok {
+		d.Set("start", start)
+	}
+	if end, ok := getGenericInt(asnRange, "end"); ok {
+		d.Set("end", end)
+	}
+
+	if rirID, ok := getGenericNestedObjectID(asnRange, "rir"); ok {
+		d.Set("rir_id", rirID)
+	}
+
+	if tenantID, ok := getGenericNestedObjectID(asnRange, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	return nil
+}
+
+func resourceNetboxAsnRangeUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/ipam/asn-ranges/%d/", id), getAsnRangeRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxAsnRangeRead(d, m)
+}
+
+func resourceNetboxAsnRangeDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/ipam/asn-ranges/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxAsnRangeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("start") || !d.NewValueKnown("end") {
+		return nil
+	}
+	start, end := d.Get("start").(int), d.Get("end").(int)
+	if start > end {
+		return fmt.Errorf("start (%d) must not be greater than end (%d)", start, end)
+	}
+	return nil
+}
+
+// getAsnRangeRequestData returns the request body for creating or updating an ASN range. All fields are
+// always sent, so removed attributes are cleared as well.
+func getAsnRangeRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	name := d.Get("name").(string)
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	data := map[string]interface{}{
+		"name":        name,
+		"slug":        slug,
+		"rir":         d.Get("rir_id").(int),
+		"start":       d.Get("start").(int),
+		"end":         d.Get("end").(int),
+		"tenant":      nil,
+		"description": d.Get("description").(string),
+	}
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxAsnRangeRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.4.3"}
+	d := resourceNetboxAsnRange().TestResourceData()
+	d.Set("name", "test")
+
+	err := resourceNetboxAsnRangeCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.5.0 or later")
+}
+
+func TestAccNetboxAsnRange_basic(t *testing.T) {
+	testSlug := "asn_range_basic"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200000000
+  end    = 4200000010
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "start", "4200000000"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "end", "4200000010"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tenant_id", "0"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_asn_range" "test" {
+  name        = "%[1]s"
+  rir_id      = netbox_rir.test.id
+  start       = 4200000000
+  end         = 4200000010
+  tenant_id   = netbox_tenant.test.id
+  description = "%[1]s"
+}
+
+resource "netbox_available_asn" "test" {
+  asn_range_id = netbox_asn_range.test.id
+  description  = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "asn", "4200000000"),
+					resource.TestCheckResourceAttrPair("netbox_available_asn.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "description", testName),
+				),
+			},
+			{
+				ResourceName:      "netbox_asn_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200000010
+  end    = 4200000000
+}`, testName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("start \\(4200000010\\) must not be greater than end \\(4200000000\\)"),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_asn_range", &resource.Sweeper{
+		Name:         "netbox_asn_range",
+		Dependencies: []string{"netbox_asn"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := &providerState{NetBoxAPI: m.(*client.NetBoxAPI)}
+			res, err := genericAPIRequest(api, "GET", "/ipam/asn-ranges/", nil)
+			if err != nil {
+				return err
+			}
+			results, _ := res["results"].([]interface{})
+			for _, result := range results {
+				asnRange, ok := result.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _ := asnRange["name"].(string)
+				if !strings.HasPrefix(name, testPrefix) {
+					continue
+				}
+				id, err := getGenericObjectID(asnRange)
+				if err != nil {
+					return err
+				}
+				_, err = genericAPIRequest(api, "DELETE", fmt.Sprintf("/ipam/asn-ranges/%d/", id), nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an asn range")
+			}
+			return nil
+		},
+	})
+}
@@ -40,6 +40,34 @@ resource "netbox_asn" "test" {
 					resource.TestCheckResourceAttr("netbox_asn.test", "tags.0", testName+"a"),
 				),
 			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn" "test" {
+  asn         = 1337
+  rir_id      = netbox_rir.test.id
+  tenant_id   = netbox_tenant.test.id
+  description = "%[1]s"
+
+  tags = ["%[1]sa"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_asn.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_asn.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_asn.test", "description", testName),
+				),
+			},
 			{
 				ResourceName:      "netbox_asn.test",
 				ImportState:       true,
@@ -0,0 +1,152 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxAvailableAsn() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAvailableAsnCreate,
+		Read:   resourceNetboxAvailableAsnRead,
+		Update: resourceNetboxAvailableAsnUpdate,
+		Delete: resourceNetboxAvailableAsnDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/asnrange/):
+
+> Ranges can be defined to group AS numbers numerically and to facilitate their automatic provisioning.
+
+This resource allocates the next available ASN in the given ` + "`netbox_asn_range`" + ` and manages it like a ` + "`netbox_asn`" + `. This resource requires Netbox 3.5 or later.`,
+
+		Schema: map[string]*schema.Schema{
+			"asn_range_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"asn": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The allocated ASN.",
+			},
+			"rir_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The RIR of the ASN range.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxAvailableAsnCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(asnRangeMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_available_asn requires Netbox %s or later, but the Netbox version is %s", asnRangeMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	asnRangeID := int64(d.Get("asn_range_id").(int))
+
+	res, err := genericAPIRequest(api, "POST", fmt.Sprintf("/ipam/asn-ranges/%d/available-asns/", asnRangeID), getAvailableAsnRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return fmt.Errorf("netbox did not allocate an ASN, the ASN range may be exhausted: %w", err)
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxAvailableAsnRead(d, m)
+}
+
+func resourceNetboxAvailableAsnRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	asn, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/asns/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("description", asn["description"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(asn)))
+
+	if number, ok := getGenericInt(asn, "asn"); ok {
+		d.Set("asn", number)
+	}
+
+	if rirID, ok := getGenericNestedObjectID(asn, "rir"); ok {
+		d.Set("rir_id", rirID)
+	}
+
+	if tenantID, ok := getGenericNestedObjectID(asn, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	return nil
+}
+
+func resourceNetboxAvailableAsnUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/ipam/asns/%d/", id), getAvailableAsnRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxAvailableAsnRead(d, m)
+}
+
+func resourceNetboxAvailableAsnDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/ipam/asns/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getAvailableAsnRequestData returns the request body for allocating or updating an ASN. The ASN and the RIR
+// are determined by the ASN range.
+func getAvailableAsnRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"tenant":      nil,
+		"description": d.Get("description").(string),
+	}
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	return data
+}
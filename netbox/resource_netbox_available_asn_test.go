@@ -0,0 +1,73 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxAvailableAsnRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.4.3"}
+	d := resourceNetboxAvailableAsn().TestResourceData()
+	d.Set("asn_range_id", 1)
+
+	err := resourceNetboxAvailableAsnCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.5.0 or later")
+}
+
+func TestNetboxAvailableAsnAllocation(t *testing.T) {
+	var allocation map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/ipam/asn-ranges/1/available-asns/":
+			json.NewDecoder(r.Body).Decode(&allocation)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 5, "asn": 64512}`))
+		case r.Method == "POST" && r.URL.Path == "/api/ipam/asn-ranges/2/available-asns/":
+			// Netbox answers an exhausted range with an error message instead of an ASN
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"detail": "Insufficient resources are available to satisfy the request"}`))
+		case r.Method == "GET" && r.URL.Path == "/api/ipam/asns/5/":
+			w.Write([]byte(`{"id": 5, "asn": 64512, "rir": {"id": 3}, "tenant": {"id": 4}, "description": "test", "tags": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI), netboxVersion: "3.5.0"}
+
+	d := resourceNetboxAvailableAsn().TestResourceData()
+	d.Set("asn_range_id", 1)
+	d.Set("tenant_id", 4)
+	d.Set("description", "test")
+
+	err = resourceNetboxAvailableAsnCreate(d, api)
+	assert.NoError(t, err)
+	assert.Equal(t, "5", d.Id())
+	assert.Equal(t, 64512, d.Get("asn"))
+	assert.Equal(t, 3, d.Get("rir_id"))
+	assert.Equal(t, 4, d.Get("tenant_id"))
+	assert.Equal(t, float64(4), allocation["tenant"])
+	assert.Equal(t, "test", allocation["description"])
+
+	d = resourceNetboxAvailableAsn().TestResourceData()
+	d.Set("asn_range_id", 2)
+
+	err = resourceNetboxAvailableAsnCreate(d, api)
+	assert.Error(t, err)
+	assert.Equal(t, "", d.Id())
+}
@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,10 +12,11 @@ import (
 
 func resourceNetboxAvailableIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAvailableIPAddressCreate,
-		Read:   resourceNetboxAvailableIPAddressRead,
-		Update: resourceNetboxAvailableIPAddressUpdate,
-		Delete: resourceNetboxAvailableIPAddressDelete,
+		Create:        resourceNetboxAvailableIPAddressCreate,
+		Read:          resourceNetboxAvailableIPAddressRead,
+		Update:        resourceNetboxAvailableIPAddressUpdate,
+		Delete:        resourceNetboxAvailableIPAddressDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/ipam/ip-addresses/"),
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):Per [the docs](https://netbox.readthedocs.io/en/stable/models/ipam/ipaddress/):
 
@@ -29,17 +30,20 @@ func resourceNetboxAvailableIPAddress() *schema.Resource {
 > * DHCP
 > * SLAAC (IPv6 Stateless Address Autoconfiguration)
 
-This resource will retrieve the next available IP address from a given prefix or IP range (specified by ID)`,
+This resource will retrieve the next available IP address from a given prefix or IP range (specified by ID). Netbox allocates the IP address atomically, so several IP addresses can be allocated from the same prefix in parallel. The IP address is deleted when the resource is destroyed.`,
 
 		Schema: map[string]*schema.Schema{
 			"prefix_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				ForceNew:     true,
 				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
 			},
 			"ip_range_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
 			},
 			"ip_address": {
 				Type:     schema.TypeString,
@@ -49,6 +53,13 @@ This resource will retrieve the next available IP address from a given prefix or
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"object_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "virtualization.vminterface",
+				ValidateFunc: validation.StringInSlice([]string{"virtualization.vminterface", "dcim.interface"}, false),
+				Description:  "The type of the interface given in `interface_id`. One of `virtualization.vminterface` or `dcim.interface`.",
+			},
 			"vrf_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -58,10 +69,10 @@ This resource will retrieve the next available IP address from a given prefix or
 				Optional: true,
 			},
 			"status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"active", "reserved", "deprecated", "dhcp", "slaac"}, false),
-				Default:      "active",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("active", "reserved", "deprecated", "dhcp", "slaac"),
 			},
 			"dns_name": {
 				Type:     schema.TypeString,
@@ -71,7 +82,8 @@ This resource will retrieve the next available IP address from a given prefix or
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 			"role": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -85,7 +97,7 @@ This resource will retrieve the next available IP address from a given prefix or
 }
 
 func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	prefixId := int64(d.Get("prefix_id").(int))
 	vrfId := int64(int64(d.Get("vrf_id").(int)))
 	rangeId := int64(d.Get("ip_range_id").(int))
@@ -95,26 +107,36 @@ func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{
 	data := models.AvailableIP{
 		Vrf: &nestedvrf,
 	}
+	var payload []*models.IPAddress
 	if prefixId != 0 {
 		params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithID(prefixId).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
-		// Since we generated the ip_address set that now
-		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
-		d.Set("ip_address", *res.Payload[0].Address)
+		res, err := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
+		if err != nil {
+			return err
+		}
+		payload = res.Payload
 	}
 	if rangeId != 0 {
 		params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(rangeId).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
-		// Since we generated the ip_address set that now
-		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
-		d.Set("ip_address", *res.Payload[0].Address)
+		res, err := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
+		if err != nil {
+			return err
+		}
+		payload = res.Payload
+	}
+	if len(payload) == 0 || payload[0].Address == nil {
+		return fmt.Errorf("netbox did not allocate an IP address, the prefix or IP range may be exhausted")
 	}
+	// Since we generated the ip_address set that now
+	d.SetId(strconv.FormatInt(payload[0].ID, 10))
+	d.Set("ip_address", *payload[0].Address)
+
 	return resourceNetboxAvailableIPAddressUpdate(d, m)
 }
 
 func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{}) error {
 
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesReadParams().WithID(id)
 
@@ -131,6 +153,7 @@ func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{})
 
 	if res.GetPayload().AssignedObjectID != nil {
 		d.Set("interface_id", res.GetPayload().AssignedObjectID)
+		d.Set("object_type", res.GetPayload().AssignedObjectType)
 	} else {
 		d.Set("interface_id", nil)
 	}
@@ -151,16 +174,28 @@ func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{})
 		d.Set("dns_name", res.GetPayload().DNSName)
 	}
 
+	if res.GetPayload().Role != nil {
+		d.Set("role", res.GetPayload().Role.Value)
+	} else {
+		d.Set("role", nil)
+	}
+
 	d.Set("ip_address", res.GetPayload().Address)
 	d.Set("description", res.GetPayload().Description)
 	d.Set("status", res.GetPayload().Status.Value)
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
 
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableIPAddress{}
@@ -185,8 +220,7 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 	}
 
 	if interfaceID, ok := d.GetOk("interface_id"); ok {
-		// The other possible type is dcim.interface for devices
-		data.AssignedObjectType = strToPtr("virtualization.vminterface")
+		data.AssignedObjectType = strToPtr(d.Get("object_type").(string))
 		data.AssignedObjectID = int64ToPtr(int64(interfaceID.(int)))
 	}
 
@@ -200,6 +234,8 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Ipam.IpamIPAddressesUpdate(params, nil)
@@ -210,7 +246,7 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 }
 
 func resourceNetboxAvailableIPAddressDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
@@ -3,6 +3,8 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNetboxAvailableIPAddress_basic(t *testing.T) {
@@ -225,6 +228,43 @@ resource "netbox_available_ip_address" "test_range3" {
 	})
 }
 
+func TestNetboxAvailableIPAddressExhaustedPrefix(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/ipam/prefixes/1/available-ips/":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"detail": "An insufficient number of IP addresses are available within this prefix (1 requested, 0 available)"}`))
+		case "/api/ipam/prefixes/2/available-ips/":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	d := resourceNetboxAvailableIPAddress().TestResourceData()
+	d.Set("prefix_id", 1)
+	assert.Error(t, resourceNetboxAvailableIPAddressCreate(d, api))
+	assert.Equal(t, "", d.Id())
+
+	d = resourceNetboxAvailableIPAddress().TestResourceData()
+	d.Set("prefix_id", 2)
+	assert.ErrorContains(t, resourceNetboxAvailableIPAddressCreate(d, api), "did not allocate an IP address")
+	assert.Equal(t, "", d.Id())
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_ip_address", &resource.Sweeper{
 		Name:         "netbox_available_ip_address",
@@ -6,21 +6,21 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
-	"github.com/fbreckle/go-netbox/netbox/client/ipam"
-	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxAvailablePrefix() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAvailablePrefixCreate,
-		Read:   resourceNetboxPrefixRead,
-		Update: resourceNetboxPrefixUpdate,
-		Delete: resourceNetboxPrefixDelete,
+		Create:        resourceNetboxAvailablePrefixCreate,
+		Read:          resourceNetboxPrefixRead,
+		Update:        resourceNetboxPrefixUpdate,
+		DeleteContext: resourceNetboxPrefixDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/ipam/prefixes/"),
 
-		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource allocates the next available child prefix of the given length from a parent prefix, e.g. to dynamically assign a subnet per tenant or cluster. Netbox allocates the prefix atomically together with its attributes, so several prefixes can be allocated from the same parent prefix. The prefix is deleted when the resource is destroyed.
+
+The allocated prefix inherits the VRF of the parent prefix unless ` + "`vrf_id`" + ` is set.`,
 
 		Schema: map[string]*schema.Schema{
 			"parent_prefix_id": {
@@ -39,9 +39,9 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				Computed: true,
 			},
 			"status": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"active", "container", "reserved", "deprecated"}, false),
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: statusDescription("active", "container", "reserved", "deprecated"),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -75,7 +75,29 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the prefix in percent. Container prefixes are utilized by their child prefixes, all other prefixes by their child IP addresses and IP ranges.",
+			},
+			"children": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of child prefixes.",
+			},
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of the prefix in the prefix hierarchy, `0` for a top-level prefix.",
+			},
+			"delete_children_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, all IP addresses and child prefixes within the prefix (in the same VRF) are deleted before the prefix is destroyed, including those not managed by Terraform. Use with care.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(c context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -114,23 +136,49 @@ func resourceNetboxAvailablePrefixParseImport(import_str string) (int, string, i
 }
 
 func resourceNetboxAvailablePrefixCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	parent_prefix_id := int64(d.Get("parent_prefix_id").(int))
-	prefix_length := int64(d.Get("prefix_length").(int))
-	data := models.PrefixLength{
-		PrefixLength: &prefix_length,
+
+	// The generated client only sends the prefix length, but Netbox accepts all attributes of the prefix here. Sending
+	// them with the allocation avoids a prefix that briefly exists with default attributes.
+	data := map[string]interface{}{
+		"prefix_length": d.Get("prefix_length").(int),
+		"status":        d.Get("status").(string),
+		"description":   d.Get("description").(string),
+		"is_pool":       d.Get("is_pool").(bool),
+		"mark_utilized": d.Get("mark_utilized").(bool),
+	}
+	for attribute, field := range map[string]string{
+		"tenant_id": "tenant",
+		"site_id":   "site",
+		"vlan_id":   "vlan",
+		"role_id":   "role",
+	} {
+		if id, ok := d.GetOk(attribute); ok {
+			data[field] = id.(int)
+		}
+	}
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
 	}
-	params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithID(parent_prefix_id).WithData(&data)
 
-	res, err := api.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
+	res, err := genericAPIRequest(api, "POST", fmt.Sprintf("/ipam/prefixes/%d/available-prefixes/", parent_prefix_id), data)
 	if err != nil {
 		return err
 	}
 
-	payload := res.GetPayload()
-	d.SetId(strconv.FormatInt(payload.ID, 10))
-	d.Set("prefix", payload.Prefix)
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return fmt.Errorf("netbox did not allocate a prefix, the parent prefix may be exhausted: %w", err)
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+	d.Set("prefix", res["prefix"])
 
-	return resourceNetboxPrefixUpdate(d, m)
+	// Netbox always assigns the VRF of the parent prefix during allocation
+	if _, ok := d.GetOk("vrf_id"); ok {
+		return resourceNetboxPrefixUpdate(d, m)
+	}
+	return resourceNetboxPrefixRead(d, m)
 }
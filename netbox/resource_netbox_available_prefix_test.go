@@ -1,7 +1,10 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
@@ -11,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxAvailablePrefixFullDependencies(testName string, parent_prefix string) string {
@@ -80,9 +84,10 @@ resource "netbox_available_prefix" "test" {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_children_on_destroy"},
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					parent, ok := s.RootModule().Resources[parentResourceName]
 					if !ok {
@@ -166,6 +171,59 @@ resource "netbox_available_prefix" "test3" {
 	})
 }
 
+func TestNetboxAvailablePrefixAllocation(t *testing.T) {
+	var requestBody map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/ipam/prefixes/1/available-prefixes/":
+			json.NewDecoder(r.Body).Decode(&requestBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 10, "prefix": "10.0.0.0/25", "status": {"value": "reserved"}, "tags": []}`))
+		case r.Method == "POST" && r.URL.Path == "/api/ipam/prefixes/2/available-prefixes/":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"detail": "Insufficient space is available to accommodate the requested prefix size(s)"}`))
+		case r.Method == "GET" && r.URL.Path == "/api/ipam/prefixes/10/":
+			w.Write([]byte(`{"id": 10, "prefix": "10.0.0.0/25", "status": {"value": "reserved"}, "tags": []}`))
+		case r.Method == "GET" && (r.URL.Path == "/api/ipam/ip-addresses/" || r.URL.Path == "/api/ipam/ip-ranges/"):
+			w.Write([]byte(`{"count": 0, "results": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	d := resourceNetboxAvailablePrefix().TestResourceData()
+	d.Set("parent_prefix_id", 1)
+	d.Set("prefix_length", 25)
+	d.Set("status", "reserved")
+	d.Set("tenant_id", 5)
+	assert.NoError(t, resourceNetboxAvailablePrefixCreate(d, api))
+	assert.Equal(t, "10", d.Id())
+	assert.Equal(t, "10.0.0.0/25", d.Get("prefix"))
+	// The attributes are sent with the allocation
+	assert.Equal(t, float64(25), requestBody["prefix_length"])
+	assert.Equal(t, "reserved", requestBody["status"])
+	assert.Equal(t, float64(5), requestBody["tenant"])
+
+	d = resourceNetboxAvailablePrefix().TestResourceData()
+	d.Set("parent_prefix_id", 2)
+	d.Set("prefix_length", 25)
+	d.Set("status", "active")
+	assert.ErrorContains(t, resourceNetboxAvailablePrefixCreate(d, api), "Insufficient space")
+	assert.Equal(t, "", d.Id())
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_prefix", &resource.Sweeper{
 		Name:         "netbox_available_prefix",
@@ -0,0 +1,177 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxAvailableRackPosition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAvailableRackPositionCreate,
+		Read:   resourceNetboxAvailableRackPositionRead,
+		Delete: resourceNetboxAvailableRackPositionDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This resource finds the lowest free contiguous range of rack units in a rack that fits a device of the given height, similar to how ` + "`netbox_available_ip_address`" + ` allocates IP addresses. Use the ` + "`position`" + ` as ` + "`rack_position`" + ` of a ` + "`netbox_device`" + `.
+
+The position is determined once when this resource is created and does not change afterwards, even when the rack units are occupied by the device. Netbox does not reserve the position, so several positions in the same rack that are allocated in the same apply have to be chained with ` + "`depends_on`" + ` on the devices that occupy them.`,
+
+		Schema: map[string]*schema.Schema{
+			"rack_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"u_height": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.FloatAtLeast(0.5),
+				Description:  "The height of the device in rack units, e.g. the `u_height` of its device type. Half units are rounded up.",
+			},
+			"face": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "front",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"front", "rear"}, false),
+				Description:  "One of `front` or `rear`.",
+			},
+			"is_full_depth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "If true, the rack units have to be free on both faces of the rack.",
+			},
+			"position": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The lowest rack unit of the free range.",
+			},
+		},
+	}
+}
+
+func resourceNetboxAvailableRackPositionCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	rackID := int64(d.Get("rack_id").(int))
+	faces := []string{d.Get("face").(string)}
+	if d.Get("is_full_depth").(bool) {
+		faces = []string{"front", "rear"}
+	}
+
+	free := map[int]bool{}
+	for i, face := range faces {
+		units, err := getFreeRackUnits(api, rackID, face)
+		if err != nil {
+			return err
+		}
+		for unit, isFree := range units {
+			// A unit is only free if it is free on all faces
+			if i == 0 {
+				free[unit] = isFree
+			} else {
+				free[unit] = free[unit] && isFree
+			}
+		}
+	}
+
+	height := int(math.Ceil(d.Get("u_height").(float64)))
+	position, ok := findRackPosition(free, height)
+	if !ok {
+		return fmt.Errorf("rack %d has no %d contiguous free rack units", rackID, height)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", rackID, position))
+	d.Set("position", float64(position))
+
+	return resourceNetboxAvailableRackPositionRead(d, m)
+}
+
+func resourceNetboxAvailableRackPositionRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	_, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/racks/%d/", d.Get("rack_id").(int)), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxAvailableRackPositionDelete(d *schema.ResourceData, m interface{}) error {
+	// Nothing is reserved in Netbox, so there is nothing to release
+	return nil
+}
+
+// getFreeRackUnits returns whether the rack units of the given face of the rack are free, keyed by unit number.
+func getFreeRackUnits(api *providerState, rackID int64, face string) (map[int]bool, error) {
+	query := url.Values{
+		"face":  []string{face},
+		"limit": []string{"1000"},
+	}
+	res, err := genericAPIRequestWithQuery(api, "GET", fmt.Sprintf("/dcim/racks/%d/elevation/", rackID), query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	units := map[int]bool{}
+	results, _ := res["results"].([]interface{})
+	for _, result := range results {
+		unit, ok := result.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, ok := unit["id"].(json.Number)
+		if !ok {
+			continue
+		}
+		unitNumber, err := id.Float64()
+		if err != nil {
+			return nil, err
+		}
+		// Half units are only listed for devices that occupy them, only whole units are allocated here
+		if unitNumber != math.Trunc(unitNumber) {
+			continue
+		}
+		occupied, _ := unit["occupied"].(bool)
+		units[int(unitNumber)] = !occupied && unit["device"] == nil
+	}
+	return units, nil
+}
+
+// findRackPosition returns the lowest unit of the lowest range of the given number of free contiguous units.
+func findRackPosition(free map[int]bool, height int) (int, bool) {
+	units := make([]int, 0, len(free))
+	for unit := range free {
+		units = append(units, unit)
+	}
+	sort.Ints(units)
+
+	start, length := 0, 0
+	for i, unit := range units {
+		if !free[unit] {
+			length = 0
+			continue
+		}
+		if length == 0 || unit != units[i-1]+1 {
+			start, length = unit, 0
+		}
+		length++
+		if length == height {
+			return start, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,79 @@
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindRackPosition(t *testing.T) {
+	free := map[int]bool{1: false, 2: true, 3: false, 4: true, 5: true, 6: true, 8: true, 9: true}
+
+	for _, tt := range []struct {
+		height   int
+		position int
+		ok       bool
+	}{
+		{height: 1, position: 2, ok: true},
+		{height: 2, position: 4, ok: true},
+		{height: 3, position: 4, ok: true},
+		{height: 4, ok: false},
+	} {
+		position, ok := findRackPosition(free, tt.height)
+		if ok != tt.ok || position != tt.position {
+			t.Fatalf("\n\nexpected:\n\n%d %t\n\ngot:\n\n%d %t\n\n", tt.position, tt.ok, position, ok)
+		}
+	}
+
+	// Unit 7 is missing, so 6 and 8 are not contiguous
+	_, ok := findRackPosition(map[int]bool{6: true, 8: true}, 2)
+	assert.False(t, ok)
+}
+
+func TestGetFreeRackUnits(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/dcim/racks/1/elevation/" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+			return
+		}
+		switch r.URL.Query().Get("face") {
+		case "front":
+			w.Write([]byte(`{"count": 4, "results": [
+  {"id": 4, "name": "U4", "device": null, "occupied": false},
+  {"id": 3, "name": "U3", "device": {"id": 10}, "occupied": true},
+  {"id": 2.5, "name": "U2.5", "device": null, "occupied": false},
+  {"id": 2, "name": "U2", "device": null, "occupied": false}
+]}`))
+		case "rear":
+			w.Write([]byte(`{"count": 1, "results": [
+  {"id": 4, "name": "U4", "device": null, "occupied": true}
+]}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	units, err := getFreeRackUnits(api, 1, "front")
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]bool{4: true, 3: false, 2: true}, units)
+
+	units, err = getFreeRackUnits(api, 1, "rear")
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]bool{4: false}, units)
+
+	_, err = getFreeRackUnits(api, 2, "front")
+	assert.True(t, isGenericAPINotFound(err))
+}
@@ -0,0 +1,171 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxBGPCommunity() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxBGPCommunityCreate,
+		Read:          resourceNetboxBGPCommunityRead,
+		Update:        resourceNetboxBGPCommunityUpdate,
+		Delete:        resourceNetboxBGPCommunityDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxBGP, "netbox_bgp_community", customizeDiffStatusChoices(netboxBGPAPIPath+"community/")),
+
+		Description: `:meta:subcategory:Plugins:A community of the netbox-bgp plugin is a BGP community that routes are tagged with, e.g. to select them in routing policies.
+
+This resource requires the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+:\d+(:\d+)?$`), "must be a BGP community, e.g. `65000:100`"),
+				Description:  "The community, e.g. `65000:100`. Large communities like `65000:1:100` are supported as well.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("active", "reserved", "deprecated"),
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the IPAM role of the community.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxBGPCommunityCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxBGPAPIPath+"community/", getBGPCommunityRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxBGPCommunityRead(d, m)
+}
+
+func resourceNetboxBGPCommunityRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	community, err := genericAPIRequest(api, "GET", fmt.Sprintf("%scommunity/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("value", community["value"])
+	if status, ok := community["status"].(map[string]interface{}); ok {
+		d.Set("status", status["value"])
+	}
+	if roleID, ok := getGenericNestedObjectID(community, "role"); ok {
+		d.Set("role_id", roleID)
+	} else {
+		d.Set("role_id", nil)
+	}
+	if tenantID, ok := getGenericNestedObjectID(community, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", community["description"])
+	d.Set("comments", community["comments"])
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(community)))
+
+	cf := getManagedCustomFields(api, d, community[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxBGPCommunityUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%scommunity/%d/", netboxBGPAPIPath, id), getBGPCommunityRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxBGPCommunityRead(d, m)
+}
+
+func resourceNetboxBGPCommunityDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%scommunity/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getBGPCommunityRequestData returns the request body for creating or updating a BGP community. Unset references are
+// sent as null, so removing them from the configuration clears them.
+func getBGPCommunityRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"value":       d.Get("value").(string),
+		"status":      d.Get("status").(string),
+		"role":        nil,
+		"tenant":      nil,
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+	}
+
+	if roleID, ok := d.GetOk("role_id"); ok {
+		data["role"] = roleID.(int)
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,150 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxBGPPeerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxBGPPeerGroupCreate,
+		Read:          resourceNetboxBGPPeerGroupRead,
+		Update:        resourceNetboxBGPPeerGroupUpdate,
+		Delete:        resourceNetboxBGPPeerGroupDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxBGP, "netbox_bgp_peer_group"),
+
+		Description: `:meta:subcategory:Plugins:A peer group of the netbox-bgp plugin groups BGP sessions that share the same routing policies.
+
+This resource requires the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"import_policy_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the routing policies for routes received from the peers of the group.",
+			},
+			"export_policy_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the routing policies for routes sent to the peers of the group.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxBGPPeerGroupCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxBGPAPIPath+"peer-group/", getBGPPeerGroupRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxBGPPeerGroupRead(d, m)
+}
+
+func resourceNetboxBGPPeerGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	peerGroup, err := genericAPIRequest(api, "GET", fmt.Sprintf("%speer-group/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", peerGroup["name"])
+	d.Set("description", peerGroup["description"])
+	d.Set("import_policy_ids", getGenericNestedObjectIDList(peerGroup, "import_policies"))
+	d.Set("export_policy_ids", getGenericNestedObjectIDList(peerGroup, "export_policies"))
+	d.Set("comments", peerGroup["comments"])
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(peerGroup)))
+
+	cf := getManagedCustomFields(api, d, peerGroup[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxBGPPeerGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%speer-group/%d/", netboxBGPAPIPath, id), getBGPPeerGroupRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxBGPPeerGroupRead(d, m)
+}
+
+func resourceNetboxBGPPeerGroupDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%speer-group/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getBGPPeerGroupRequestData returns the request body for creating or updating a BGP peer group.
+func getBGPPeerGroupRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"import_policies": toInt64List(d.Get("import_policy_ids")),
+		"export_policies": toInt64List(d.Get("export_policy_ids")),
+		"comments":        d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
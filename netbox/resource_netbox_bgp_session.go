@@ -0,0 +1,237 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// bgpSessionReferences maps the optional references of a BGP session to the fields in the plugin.
+var bgpSessionReferences = []struct {
+	attribute string
+	field     string
+}{
+	{"site_id", "site"},
+	{"peer_group_id", "peer_group"},
+	{"tenant_id", "tenant"},
+}
+
+func resourceNetboxBGPSession() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxBGPSessionCreate,
+		Read:          resourceNetboxBGPSessionRead,
+		Update:        resourceNetboxBGPSessionUpdate,
+		Delete:        resourceNetboxBGPSessionDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxBGP, "netbox_bgp_session", customizeDiffStatusChoices(netboxBGPAPIPath+"session/")),
+
+		Description: `:meta:subcategory:Plugins:A session of the netbox-bgp plugin is a BGP session between a local address of a device and a remote peer, with the autonomous systems of both sides.
+
+This resource requires the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"local_address_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the IP address of the local side of the session.",
+			},
+			"remote_address_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the IP address of the remote peer.",
+			},
+			"local_as_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the ASN of the local side of the session, as managed by the `netbox_asn` resource.",
+			},
+			"remote_as_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the ASN of the remote peer, as managed by the `netbox_asn` resource.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("offline", "active", "planned", "failed"),
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"peer_group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"import_policy_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the routing policies for routes received from the peer.",
+			},
+			"export_policy_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the routing policies for routes sent to the peer.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxBGPSessionCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxBGPAPIPath+"session/", getBGPSessionRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxBGPSessionRead(d, m)
+}
+
+func resourceNetboxBGPSessionRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	session, err := genericAPIRequest(api, "GET", fmt.Sprintf("%ssession/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", session["name"])
+	for attribute, field := range map[string]string{
+		"device_id":         "device",
+		"local_address_id":  "local_address",
+		"remote_address_id": "remote_address",
+		"local_as_id":       "local_as",
+		"remote_as_id":      "remote_as",
+	} {
+		if id, ok := getGenericNestedObjectID(session, field); ok {
+			d.Set(attribute, id)
+		}
+	}
+	if status, ok := session["status"].(map[string]interface{}); ok {
+		d.Set("status", status["value"])
+	}
+	for _, reference := range bgpSessionReferences {
+		if id, ok := getGenericNestedObjectID(session, reference.field); ok {
+			d.Set(reference.attribute, id)
+		} else {
+			d.Set(reference.attribute, nil)
+		}
+	}
+	d.Set("import_policy_ids", getGenericNestedObjectIDList(session, "import_policies"))
+	d.Set("export_policy_ids", getGenericNestedObjectIDList(session, "export_policies"))
+	d.Set("description", session["description"])
+	d.Set("comments", session["comments"])
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(session)))
+
+	cf := getManagedCustomFields(api, d, session[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxBGPSessionUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%ssession/%d/", netboxBGPAPIPath, id), getBGPSessionRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxBGPSessionRead(d, m)
+}
+
+func resourceNetboxBGPSessionDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%ssession/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getBGPSessionRequestData returns the request body for creating or updating a BGP session. Unset references are
+// sent as null, so removing them from the configuration clears them.
+func getBGPSessionRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"device":          d.Get("device_id").(int),
+		"local_address":   d.Get("local_address_id").(int),
+		"remote_address":  d.Get("remote_address_id").(int),
+		"local_as":        d.Get("local_as_id").(int),
+		"remote_as":       d.Get("remote_as_id").(int),
+		"status":          d.Get("status").(string),
+		"import_policies": toInt64List(d.Get("import_policy_ids")),
+		"export_policies": toInt64List(d.Get("export_policy_ids")),
+		"description":     d.Get("description").(string),
+		"comments":        d.Get("comments").(string),
+	}
+
+	for _, reference := range bgpSessionReferences {
+		data[reference.field] = nil
+		if id, ok := d.GetOk(reference.attribute); ok {
+			data[reference.field] = id.(int)
+		}
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,37 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBGPSessionRequestData(t *testing.T) {
+	d := resourceNetboxBGPSession().TestResourceData()
+	d.Set("name", "uplink")
+	d.Set("device_id", 1)
+	d.Set("local_address_id", 2)
+	d.Set("remote_address_id", 3)
+	d.Set("local_as_id", 4)
+	d.Set("remote_as_id", 5)
+	d.Set("status", "planned")
+	d.Set("peer_group_id", 6)
+	d.Set("import_policy_ids", []interface{}{7})
+
+	data := getBGPSessionRequestData(&providerState{}, d)
+	assert.Equal(t, "uplink", data["name"])
+	assert.Equal(t, 1, data["device"])
+	assert.Equal(t, 2, data["local_address"])
+	assert.Equal(t, 3, data["remote_address"])
+	assert.Equal(t, 4, data["local_as"])
+	assert.Equal(t, 5, data["remote_as"])
+	assert.Equal(t, "planned", data["status"])
+	assert.Equal(t, 6, data["peer_group"])
+	assert.Equal(t, []int64{7}, data["import_policies"])
+	assert.Equal(t, []int64{}, data["export_policies"])
+
+	// Unset references are cleared
+	assert.Contains(t, data, "site")
+	assert.Nil(t, data["site"])
+	assert.Nil(t, data["tenant"])
+}
@@ -0,0 +1,111 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// bookmarkMinimumNetboxVersion is the first Netbox version with bookmarks.
+const bookmarkMinimumNetboxVersion = "3.6.0"
+
+func resourceNetboxBookmark() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxBookmarkCreate,
+		Read:   resourceNetboxBookmarkRead,
+		Delete: resourceNetboxBookmarkDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/models/extras/bookmark/):
+
+> A user can bookmark individual objects for convenient access. Bookmarks are listed under a user's profile, and can be displayed with custom filtering and ordering on the user's personal dashboard.
+
+Bookmarks cannot be changed, so every change recreates the bookmark. This resource requires Netbox 3.6 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The object type of the bookmarked object, e.g. `dcim.device`.",
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the user the bookmark belongs to.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxBookmarkCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(bookmarkMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_bookmark requires Netbox %s or later, but the Netbox version is %s", bookmarkMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	data := map[string]interface{}{
+		"object_type": d.Get("object_type").(string),
+		"object_id":   d.Get("object_id").(int),
+		"user":        d.Get("user_id").(int),
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/extras/bookmarks/", data)
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxBookmarkRead(d, m)
+}
+
+func resourceNetboxBookmarkRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	bookmark, err := genericAPIRequest(api, "GET", fmt.Sprintf("/extras/bookmarks/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("object_type", bookmark["object_type"])
+	if objectID, ok := getGenericInt(bookmark, "object_id"); ok {
+		d.Set("object_id", objectID)
+	}
+	if userID, ok := getGenericNestedObjectID(bookmark, "user"); ok {
+		d.Set("user_id", userID)
+	}
+
+	return nil
+}
+
+func resourceNetboxBookmarkDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/extras/bookmarks/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
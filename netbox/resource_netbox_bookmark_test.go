@@ -0,0 +1,18 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxBookmarkRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.5.9"}
+	d := resourceNetboxBookmark().TestResourceData()
+	d.Set("object_type", "dcim.site")
+	d.Set("object_id", 1)
+	d.Set("user_id", 1)
+
+	err := resourceNetboxBookmarkCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.6.0 or later")
+}
@@ -0,0 +1,191 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// branchingMinimumNetboxVersion is the first Netbox version supported by the branching plugin.
+const branchingMinimumNetboxVersion = "4.1.0"
+
+func resourceNetboxBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxBranchCreate,
+		ReadContext:   resourceNetboxBranchRead,
+		UpdateContext: resourceNetboxBranchUpdate,
+		DeleteContext: resourceNetboxBranchDelete,
+
+		Description: `:meta:subcategory:Plugins:From the [official documentation](https://docs.netboxlabs.com/netbox-extensions/branching/):
+
+> Branching is a feature which allows users to make changes to NetBox data in an isolated context, which can later be merged into the main database. This allows for changes to be staged and reviewed before being applied to the production data set.
+
+This resource requires the [branching plugin](https://github.com/netboxlabs/netbox-branching) and Netbox 4.1 or later. Creating a branch waits until the branch is provisioned, which is limited by the ` + "`create`" + ` timeout that defaults to 10 minutes. To make the changes of a Terraform configuration in a branch, set the ` + "`branch`" + ` argument of the provider to the ` + "`schema_id`" + ` of the branch. As the provider is configured before any resource is created, the branch is usually managed in a separate configuration. Branches are merged in Netbox. Destroying a branch discards all changes in it that were not merged.
+
+This resource is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schema_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The schema ID of the branch, used in the `branch` argument of the provider.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceNetboxBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(branchingMinimumNetboxVersion) {
+		return diag.Errorf("netbox_branch requires Netbox %s or later, but the Netbox version is %s", branchingMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/plugins/branching/branches/", getBranchRequestData(api, d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	// The branching plugin provisions the schema of a new branch in a background worker, so wait until it is ready
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new", "provisioning"},
+		Target:     []string{"ready", "failed"},
+		Refresh:    getBranchStateRefreshFunc(api, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 2 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for branch %d to be provisioned: %s", id, err)
+	}
+	if status := getBranchStatus(result.(map[string]interface{})); status != "ready" {
+		// The resource is tainted because its ID is already set, so the branch is recreated on the next apply
+		return diag.Errorf("branch %d could not be provisioned and has status %s", id, status)
+	}
+
+	return resourceNetboxBranchRead(ctx, d, m)
+}
+
+func resourceNetboxBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	branch, err := genericAPIRequest(api, "GET", fmt.Sprintf("/plugins/branching/branches/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", branch["name"])
+	d.Set("description", branch["description"])
+	d.Set("comments", branch["comments"])
+	d.Set("schema_id", branch["schema_id"])
+	d.Set("status", getBranchStatus(branch))
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(branch)))
+
+	cf := getManagedCustomFields(api, d, branch[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxBranchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/plugins/branching/branches/%d/", id), getBranchRequestData(api, d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxBranchRead(ctx, d, m)
+}
+
+func resourceNetboxBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/plugins/branching/branches/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// getBranchRequestData returns the request body for creating or updating a branch.
+func getBranchRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
+
+func getBranchStateRefreshFunc(api *providerState, id int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		branch, err := genericAPIRequest(api, "GET", fmt.Sprintf("/plugins/branching/branches/%d/", id), nil)
+		if err != nil {
+			return nil, "", err
+		}
+		return branch, getBranchStatus(branch), nil
+	}
+}
+
+func getBranchStatus(branch map[string]interface{}) string {
+	status, _ := branch["status"].(map[string]interface{})
+	value, _ := status["value"].(string)
+	return value
+}
@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxBranchCreate(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/plugins/branching/branches/":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "staging", body["name"])
+			assert.Equal(t, "Changes of the next maintenance", body["description"])
+			w.Write([]byte(`{"id": 3, "name": "staging", "status": {"value": "new"}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/branching/branches/3/":
+			// The branch is provisioned in the background, so it is not ready when it is polled first
+			polls++
+			if polls == 1 {
+				w.Write([]byte(`{"id": 3, "name": "staging", "schema_id": "td5smq0f", "status": {"value": "provisioning"}}`))
+				return
+			}
+			w.Write([]byte(`{"id": 3, "name": "staging", "description": "Changes of the next maintenance", "schema_id": "td5smq0f", "status": {"value": "ready"}, "tags": []}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI), netboxVersion: "4.2.0"}
+
+	d := resourceNetboxBranch().TestResourceData()
+	d.Set("name", "staging")
+	d.Set("description", "Changes of the next maintenance")
+
+	diags := resourceNetboxBranchCreate(context.Background(), d, api)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "3", d.Id())
+	assert.Equal(t, "td5smq0f", d.Get("schema_id"))
+	assert.Equal(t, "ready", d.Get("status"))
+	assert.Equal(t, 3, polls)
+}
+
+func TestNetboxBranchCreateRequiresNetboxVersion(t *testing.T) {
+	d := resourceNetboxBranch().TestResourceData()
+	d.Set("name", "staging")
+
+	diags := resourceNetboxBranchCreate(context.Background(), d, &providerState{netboxVersion: "4.0.11"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "requires Netbox 4.1.0 or later")
+}
@@ -0,0 +1,261 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxCableTerminationObjectTypes = []string{
+	"circuits.circuittermination",
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.powerfeed",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+var resourceNetboxCableTerminationSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Required: true,
+	MinItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxCableTerminationObjectTypes, false),
+				Description:  "The type of the terminated object, e.g. `dcim.interface` or `circuits.circuittermination`.",
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	},
+}
+
+func resourceNetboxCable() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxCableCreate,
+		Read:          resourceNetboxCableRead,
+		Update:        resourceNetboxCableUpdate,
+		Delete:        resourceNetboxCableDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/dcim/cables/"),
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/cable/):
+
+> All connections between device components in NetBox are represented using cables. A cable represents a direct physical connection between two sets of endpoints (A and B), such as a console port and a patch panel port, or between two network interfaces.`,
+
+		Schema: map[string]*schema.Schema{
+			"a_termination": resourceNetboxCableTerminationSchema,
+			"b_termination": resourceNetboxCableTerminationSchema,
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cat3", "cat5", "cat5e", "cat6", "cat6a", "cat7", "cat7a", "cat8", "dac-active", "dac-passive", "mrj21-trunk", "coaxial", "mmf", "mmf-om1", "mmf-om2", "mmf-om3", "mmf-om4", "mmf-om5", "smf", "smf-os1", "smf-os2", "aoc", "power"}, false),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "connected",
+				Description: statusDescription("connected", "planned", "decommissioning"),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"color_hex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"length": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"length_unit"},
+			},
+			"length_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"km", "m", "cm", "mi", "ft", "in"}, false),
+				RequiredWith: []string{"length"},
+				Description:  "One of `km`, `m`, `cm`, `mi`, `ft` or `in`.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableCableFromResourceData(api, d)
+
+	params := dcim.NewDcimCablesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimCablesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxCableRead(d, m)
+}
+
+func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimCablesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimCablesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimCablesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	cable := res.GetPayload()
+
+	d.Set("a_termination", getCableTerminationList(cable.ATerminations))
+	d.Set("b_termination", getCableTerminationList(cable.BTerminations))
+	d.Set("type", cable.Type)
+	if cable.Status != nil {
+		d.Set("status", cable.Status.Value)
+	} else {
+		d.Set("status", nil)
+	}
+	if cable.Tenant != nil {
+		d.Set("tenant_id", cable.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("label", cable.Label)
+	d.Set("color_hex", cable.Color)
+	d.Set("length", cable.Length)
+	if cable.LengthUnit != nil {
+		d.Set("length_unit", cable.LengthUnit.Value)
+	} else {
+		d.Set("length_unit", nil)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, cable.Tags))
+
+	cf := getManagedCustomFields(api, d, cable.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableCableFromResourceData(api, d)
+
+	params := dcim.NewDcimCablesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimCablesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/cables/%d/", id), map[string]string{
+		"type":        "type",
+		"tenant_id":   "tenant",
+		"label":       "label",
+		"color_hex":   "color",
+		"length":      "length",
+		"length_unit": "length_unit",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxCableRead(d, m)
+}
+
+func resourceNetboxCableDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimCablesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimCablesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableCableFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableCable {
+	data := models.WritableCable{
+		ATerminations: getGenericObjectsFromCableTerminationSet(d.Get("a_termination").(*schema.Set)),
+		BTerminations: getGenericObjectsFromCableTerminationSet(d.Get("b_termination").(*schema.Set)),
+		Type:          d.Get("type").(string),
+		Status:        d.Get("status").(string),
+		Label:         d.Get("label").(string),
+		Color:         d.Get("color_hex").(string),
+		LengthUnit:    d.Get("length_unit").(string),
+	}
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data.Tenant = int64ToPtr(int64(tenantID.(int)))
+	}
+
+	if length, ok := d.GetOk("length"); ok {
+		data.Length = float64ToPtr(length.(float64))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
+
+func getGenericObjectsFromCableTerminationSet(terminations *schema.Set) []*models.GenericObject {
+	objects := []*models.GenericObject{}
+	for _, termination := range terminations.List() {
+		terminationMap := termination.(map[string]interface{})
+		objects = append(objects, &models.GenericObject{
+			ObjectType: strToPtr(terminationMap["object_type"].(string)),
+			ObjectID:   int64ToPtr(int64(terminationMap["object_id"].(int))),
+		})
+	}
+	return objects
+}
+
+func getCableTerminationList(objects []*models.GenericObject) []map[string]interface{} {
+	terminations := []map[string]interface{}{}
+	for _, object := range objects {
+		if object.ObjectType == nil || object.ObjectID == nil {
+			continue
+		}
+		terminations = append(terminations, map[string]interface{}{
+			"object_type": *object.ObjectType,
+			"object_id":   *object.ObjectID,
+		})
+	}
+	return terminations
+}
@@ -0,0 +1,154 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxCableFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test1" {
+  name = "%[1]s_1"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}
+
+resource "netbox_device_interface" "test2" {
+  name = "%[1]s_2"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}
+
+resource "netbox_device_interface" "test3" {
+  name = "%[1]s_3"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}`, testName)
+}
+
+func TestAccNetboxCable_basic(t *testing.T) {
+
+	testSlug := "cable_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxCableFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.test1.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.test2.id
+  }
+  type        = "cat6"
+  status      = "planned"
+  label       = "%[1]s"
+  color_hex   = "ff0000"
+  length      = 2.5
+  length_unit = "m"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cable.test", "a_termination.#", "1"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "b_termination.#", "1"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "type", "cat6"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_cable.test", "color_hex", "ff0000"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "length", "2.5"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "length_unit", "m"),
+				),
+			},
+			{
+				Config: testAccNetboxCableFullDependencies(testName) + `
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.test1.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.test3.id
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cable.test", "status", "connected"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_cable.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_cable.test", "length_unit", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_cable.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_cable", &resource.Sweeper{
+		Name:         "netbox_cable",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimCablesListParams()
+			res, err := api.Dcim.DcimCablesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, cable := range res.GetPayload().Results {
+				if strings.HasPrefix(cable.Label, testPrefix) {
+					deleteParams := dcim.NewDcimCablesDeleteParams().WithID(cable.ID)
+					_, err := api.Dcim.DcimCablesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a cable")
+				}
+			}
+			return nil
+		},
+	})
+}
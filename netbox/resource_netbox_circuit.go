@@ -1,9 +1,10 @@
 package netbox
 
 import (
+	"fmt"
+	"regexp"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,10 +13,11 @@ import (
 
 func resourceNetboxCircuit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxCircuitCreate,
-		Read:   resourceNetboxCircuitRead,
-		Update: resourceNetboxCircuitUpdate,
-		Delete: resourceNetboxCircuitDelete,
+		Create:        resourceNetboxCircuitCreate,
+		Read:          resourceNetboxCircuitRead,
+		Update:        resourceNetboxCircuitUpdate,
+		Delete:        resourceNetboxCircuitDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/circuits/circuits/"),
 
 		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/features/circuits/#circuits_1):
 
@@ -41,10 +43,39 @@ func resourceNetboxCircuit() *schema.Resource {
 				Optional: true,
 			},
 			"status": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: statusDescription("planned", "provisioning", "active", "offline", "deprovisioning", "decommissioning"),
+			},
+			"install_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format YYYY-MM-DD"),
+				Description:  "The date the circuit was installed, in the format `YYYY-MM-DD`.",
+			},
+			"termination_date": {
 				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"planned", "provisioning", "active", "offline", "deprovisioning", "decommissioning"}, false),
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format YYYY-MM-DD"),
+				Description:  "The date the circuit is terminated, in the format `YYYY-MM-DD`.",
+			},
+			"commit_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+				Description:  "The committed rate of the circuit in Kbps.",
 			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -53,7 +84,7 @@ func resourceNetboxCircuit() *schema.Resource {
 }
 
 func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableCircuit{}
 
@@ -77,7 +108,27 @@ func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
 		data.Tenant = int64ToPtr(int64(tenantIDValue.(int)))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	installDate, err := getOptionalDate(d, "install_date")
+	if err != nil {
+		return err
+	}
+	data.InstallDate = installDate
+
+	terminationDate, err := getOptionalDate(d, "termination_date")
+	if err != nil {
+		return err
+	}
+	data.TerminationDate = terminationDate
+
+	if commitRate, ok := d.GetOk("commit_rate"); ok {
+		data.CommitRate = int64ToPtr(int64(commitRate.(int)))
+	}
+
+	data.Description = d.Get("description").(string)
+	data.Comments = d.Get("comments").(string)
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := circuits.NewCircuitsCircuitsCreateParams().WithData(&data)
 
@@ -92,7 +143,7 @@ func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxCircuitRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitsReadParams().WithID(id)
 
@@ -129,11 +180,38 @@ func resourceNetboxCircuitRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant_id", nil)
 	}
 
+	if res.GetPayload().InstallDate != nil {
+		d.Set("install_date", res.GetPayload().InstallDate.String())
+	} else {
+		d.Set("install_date", nil)
+	}
+
+	if res.GetPayload().TerminationDate != nil {
+		d.Set("termination_date", res.GetPayload().TerminationDate.String())
+	} else {
+		d.Set("termination_date", nil)
+	}
+
+	if res.GetPayload().CommitRate != nil {
+		d.Set("commit_rate", *res.GetPayload().CommitRate)
+	} else {
+		d.Set("commit_rate", nil)
+	}
+
+	d.Set("description", res.GetPayload().Description)
+	d.Set("comments", res.GetPayload().Comments)
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxCircuitUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableCircuit{}
@@ -158,20 +236,64 @@ func resourceNetboxCircuitUpdate(d *schema.ResourceData, m interface{}) error {
 		data.Tenant = int64ToPtr(int64(tenantIDValue.(int)))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	installDate, err := getOptionalDate(d, "install_date")
+	if err != nil {
+		return err
+	}
+	data.InstallDate = installDate
+
+	terminationDate, err := getOptionalDate(d, "termination_date")
+	if err != nil {
+		return err
+	}
+	data.TerminationDate = terminationDate
+
+	if commitRate, ok := d.GetOk("commit_rate"); ok {
+		data.CommitRate = int64ToPtr(int64(commitRate.(int)))
+	}
+
+	data.Description = d.Get("description").(string)
+	data.Comments = d.Get("comments").(string)
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := circuits.NewCircuitsCircuitsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Circuits.CircuitsCircuitsPartialUpdate(params, nil)
+	_, err = api.Circuits.CircuitsCircuitsPartialUpdate(params, nil)
 	if err != nil {
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/circuits/circuits/%d/", id), map[string]string{
+		"tenant_id":   "tenant",
+		"commit_rate": "commit_rate",
+		"description": "description",
+		"comments":    "comments",
+	})
+	if err != nil {
+		return err
+	}
+
+	// The generated client omits empty dates, which would keep the old ones
+	cleared := map[string]interface{}{}
+	for _, attribute := range []string{"install_date", "termination_date"} {
+		if _, ok := d.GetOk(attribute); !ok && d.HasChange(attribute) {
+			cleared[attribute] = nil
+		}
+	}
+	if len(cleared) > 0 {
+		_, err = genericAPIRequest(api, "PATCH", fmt.Sprintf("/circuits/circuits/%d/", id), cleared)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceNetboxCircuitRead(d, m)
 }
 
 func resourceNetboxCircuitDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitsDeleteParams().WithID(id)
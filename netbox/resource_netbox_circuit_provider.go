@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,6 +34,19 @@ func resourceNetboxCircuitProvider() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 30),
 			},
+			"asn_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -42,7 +55,7 @@ func resourceNetboxCircuitProvider() *schema.Resource {
 }
 
 func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableProvider{}
 
@@ -57,8 +70,15 @@ func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{})
 		data.Slug = strToPtr(slugValue.(string))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	data.Comments = d.Get("comments").(string)
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
 	data.Asns = []int64{}
+	if asnsValue, ok := d.GetOk("asn_ids"); ok {
+		data.Asns = toInt64List(asnsValue)
+	}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := circuits.NewCircuitsProvidersCreateParams().WithData(&data)
 
@@ -73,7 +93,7 @@ func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxCircuitProviderRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsProvidersReadParams().WithID(id)
 
@@ -89,14 +109,23 @@ func resourceNetboxCircuitProviderRead(d *schema.ResourceData, m interface{}) er
 		return err
 	}
 
-	d.Set("name", res.GetPayload().Name)
-	d.Set("slug", res.GetPayload().Slug)
+	provider := res.GetPayload()
+	d.Set("name", provider.Name)
+	d.Set("slug", provider.Slug)
+	d.Set("asn_ids", getIDsFromNestedASNList(provider.Asns))
+	d.Set("comments", provider.Comments)
+	d.Set(tagsKey, getManagedTagList(api, d, provider.Tags))
+
+	cf := getManagedCustomFields(api, d, provider.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
 	return nil
 }
 
 func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableProvider{}
@@ -112,8 +141,15 @@ func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{})
 		data.Slug = strToPtr(slugValue.(string))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	data.Comments = d.Get("comments").(string)
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
 	data.Asns = []int64{}
+	if asnsValue, ok := d.GetOk("asn_ids"); ok {
+		data.Asns = toInt64List(asnsValue)
+	}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := circuits.NewCircuitsProvidersPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -122,11 +158,18 @@ func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/circuits/providers/%d/", id), map[string]string{
+		"comments": "comments",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxCircuitProviderRead(d, m)
 }
 
 func resourceNetboxCircuitProviderDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsProvidersDeleteParams().WithID(id)
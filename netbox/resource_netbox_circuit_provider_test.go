@@ -51,6 +51,76 @@ resource "netbox_circuit_provider" "test" {
 	})
 }
 
+func TestAccNetboxCircuitProvider_full(t *testing.T) {
+
+	testSlug := "circuit_prov_full"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn" "test" {
+  asn    = 1339
+  rir_id = netbox_rir.test.id
+}
+
+resource "netbox_circuit_provider" "test" {
+  name     = "%[1]s"
+  asn_ids  = [netbox_asn.test.id]
+  comments = "Carrier for the DC uplinks"
+  tags     = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "asn_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_circuit_provider.test", "asn_ids.*", "netbox_asn.test", "id"),
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "comments", "Carrier for the DC uplinks"),
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "tags.0", testName),
+				),
+			},
+			{
+				ResourceName:      "netbox_circuit_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn" "test" {
+  asn    = 1339
+  rir_id = netbox_rir.test.id
+}
+
+resource "netbox_circuit_provider" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "asn_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "comments", ""),
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_circuit_provider", &resource.Sweeper{
 		Name:         "netbox_circuit_provider",
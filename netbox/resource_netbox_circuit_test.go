@@ -74,6 +74,72 @@ resource "netbox_circuit" "test" {
 	})
 }
 
+func TestAccNetboxCircuit_full(t *testing.T) {
+	testSlug := "circuit_full"
+	testName := testAccGetTestName(testSlug)
+	randomSlug := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxCircuitDependencies(testName, randomSlug) + fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_circuit" "test" {
+  cid              = "%[1]s"
+  status           = "active"
+  provider_id      = netbox_circuit_provider.test.id
+  type_id          = netbox_circuit_type.test.id
+  install_date     = "2023-04-01"
+  termination_date = "2026-03-31"
+  commit_rate      = 10000
+  description      = "Internet uplink"
+  comments         = "Three year contract"
+  tags             = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_circuit.test", "install_date", "2023-04-01"),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "termination_date", "2026-03-31"),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "commit_rate", "10000"),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "description", "Internet uplink"),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "comments", "Three year contract"),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "tags.0", testName),
+				),
+			},
+			{
+				ResourceName:      "netbox_circuit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetboxCircuitDependencies(testName, randomSlug) + fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_circuit" "test" {
+  cid         = "%[1]s"
+  status      = "active"
+  provider_id = netbox_circuit_provider.test.id
+  type_id     = netbox_circuit_type.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_circuit.test", "install_date", ""),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "termination_date", ""),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "commit_rate", "0"),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "comments", ""),
+					resource.TestCheckResourceAttr("netbox_circuit.test", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_circuit", &resource.Sweeper{
 		Name:         "netbox_circuit",
@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +32,13 @@ func resourceNetboxCircuitType() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 30),
 			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -40,7 +47,7 @@ func resourceNetboxCircuitType() *schema.Resource {
 }
 
 func resourceNetboxCircuitTypeCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.CircuitType{}
 
@@ -55,7 +62,10 @@ func resourceNetboxCircuitTypeCreate(d *schema.ResourceData, m interface{}) erro
 		data.Slug = strToPtr(slugValue.(string))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	data.Description = d.Get("description").(string)
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := circuits.NewCircuitsCircuitTypesCreateParams().WithData(&data)
 
@@ -70,7 +80,7 @@ func resourceNetboxCircuitTypeCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxCircuitTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitTypesReadParams().WithID(id)
 
@@ -88,12 +98,19 @@ func resourceNetboxCircuitTypeRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
+	d.Set("description", res.GetPayload().Description)
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
 	return nil
 }
 
 func resourceNetboxCircuitTypeUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.CircuitType{}
@@ -109,7 +126,10 @@ func resourceNetboxCircuitTypeUpdate(d *schema.ResourceData, m interface{}) erro
 		data.Slug = strToPtr(slugValue.(string))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	data.Description = d.Get("description").(string)
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := circuits.NewCircuitsCircuitTypesPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -118,11 +138,18 @@ func resourceNetboxCircuitTypeUpdate(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/circuits/circuit-types/%d/", id), map[string]string{
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxCircuitTypeRead(d, m)
 }
 
 func resourceNetboxCircuitTypeDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitTypesDeleteParams().WithID(id)
@@ -31,6 +31,24 @@ resource "netbox_circuit_type" "test" {
 					resource.TestCheckResourceAttr("netbox_circuit_type.test", "slug", randomSlug),
 				),
 			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_circuit_type" "test" {
+  name        = "%[1]s"
+  slug        = "%[2]s"
+  description = "Dedicated internet access"
+  tags        = [netbox_tag.test.name]
+}`, testName, randomSlug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_circuit_type.test", "description", "Dedicated internet access"),
+					resource.TestCheckResourceAttr("netbox_circuit_type.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_circuit_type.test", "tags.0", testName),
+				),
+			},
 			{
 				ResourceName:      "netbox_circuit_type.test",
 				ImportState:       true,
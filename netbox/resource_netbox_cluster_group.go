@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +35,8 @@ func resourceNetboxClusterGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -44,7 +45,7 @@ func resourceNetboxClusterGroup() *schema.Resource {
 }
 
 func resourceNetboxClusterGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.ClusterGroup{}
 
@@ -65,7 +66,9 @@ func resourceNetboxClusterGroupCreate(d *schema.ResourceData, m interface{}) err
 		data.Description = description.(string)
 	}
 
-	data.Tags = []*models.NestedTag{}
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := virtualization.NewVirtualizationClusterGroupsCreateParams().WithData(&data)
 
@@ -80,7 +83,7 @@ func resourceNetboxClusterGroupCreate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxClusterGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClusterGroupsReadParams().WithID(id)
 
@@ -98,11 +101,17 @@ func resourceNetboxClusterGroupRead(d *schema.ResourceData, m interface{}) error
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
 	d.Set("description", res.GetPayload().Description)
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	return nil
 }
 
 func resourceNetboxClusterGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.ClusterGroup{}
@@ -129,7 +138,9 @@ func resourceNetboxClusterGroupUpdate(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
-	data.Tags = []*models.NestedTag{}
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := virtualization.NewVirtualizationClusterGroupsPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -142,7 +153,7 @@ func resourceNetboxClusterGroupUpdate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxClusterGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClusterGroupsDeleteParams().WithID(id)
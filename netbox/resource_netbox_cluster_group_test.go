@@ -21,15 +21,22 @@ func TestAccNetboxClusterGroup_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
 resource "netbox_cluster_group" "test" {
   name = "%[1]s"
   slug = "%[1]s"
   description = "%[1]s"
+  tags = [netbox_tag.test.name]
 }`, testName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("netbox_cluster_group.test", "name", testName),
 					resource.TestCheckResourceAttr("netbox_cluster_group.test", "slug", testName),
 					resource.TestCheckResourceAttr("netbox_cluster_group.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_cluster_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_cluster_group.test", "tags.0", testName),
 				),
 			},
 			{
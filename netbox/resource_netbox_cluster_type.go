@@ -1,12 +1,13 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxClusterType() *schema.Resource {
@@ -30,6 +31,13 @@ func resourceNetboxClusterType() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -38,7 +46,7 @@ func resourceNetboxClusterType() *schema.Resource {
 }
 
 func resourceNetboxClusterTypeCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	slugValue, slugOk := d.GetOk("slug")
@@ -51,13 +59,18 @@ func resourceNetboxClusterTypeCreate(d *schema.ResourceData, m interface{}) erro
 		slug = slugValue.(string)
 	}
 
-	params := virtualization.NewVirtualizationClusterTypesCreateParams().WithData(
-		&models.ClusterType{
-			Name: &name,
-			Slug: &slug,
-			Tags: []*models.NestedTag{},
-		},
-	)
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data := &models.ClusterType{
+		Name:        &name,
+		Slug:        &slug,
+		Description: d.Get("description").(string),
+		Tags:        tags,
+	}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	params := virtualization.NewVirtualizationClusterTypesCreateParams().WithData(data)
 
 	res, err := api.Virtualization.VirtualizationClusterTypesCreate(params, nil)
 	if err != nil {
@@ -71,7 +84,7 @@ func resourceNetboxClusterTypeCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxClusterTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClusterTypesReadParams().WithID(id)
 
@@ -88,11 +101,18 @@ func resourceNetboxClusterTypeRead(d *schema.ResourceData, m interface{}) error
 
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
+	d.Set("description", res.GetPayload().Description)
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	return nil
 }
 
 func resourceNetboxClusterTypeUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.ClusterType{}
@@ -110,7 +130,10 @@ func resourceNetboxClusterTypeUpdate(d *schema.ResourceData, m interface{}) erro
 
 	data.Slug = &slug
 	data.Name = &name
-	data.Tags = []*models.NestedTag{}
+	data.Description = d.Get("description").(string)
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := virtualization.NewVirtualizationClusterTypesPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -119,11 +142,18 @@ func resourceNetboxClusterTypeUpdate(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/virtualization/cluster-types/%d/", id), map[string]string{
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxClusterTypeRead(d, m)
 }
 
 func resourceNetboxClusterTypeDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClusterTypesDeleteParams().WithID(id)
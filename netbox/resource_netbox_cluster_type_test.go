@@ -29,6 +29,25 @@ resource "netbox_cluster_type" "test" {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("netbox_cluster_type.test", "name", testName),
 					resource.TestCheckResourceAttr("netbox_cluster_type.test", "slug", randomSlug),
+					resource.TestCheckResourceAttr("netbox_cluster_type.test", "description", ""),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_cluster_type" "test" {
+  name        = "%[1]s"
+  slug        = "%[2]s"
+  description = "%[1]s"
+  tags        = [netbox_tag.test.name]
+}`, testName, randomSlug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cluster_type.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_cluster_type.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_cluster_type.test", "tags.0", testName),
 				),
 			},
 			{
@@ -0,0 +1,208 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// configContextScopes maps the attributes that scope a config context to the fields in Netbox.
+var configContextScopes = []struct {
+	attribute string
+	field     string
+}{
+	{"region_ids", "regions"},
+	{"site_group_ids", "site_groups"},
+	{"site_ids", "sites"},
+	{"location_ids", "locations"},
+	{"device_type_ids", "device_types"},
+	{"role_ids", "roles"},
+	{"platform_ids", "platforms"},
+	{"cluster_type_ids", "cluster_types"},
+	{"cluster_group_ids", "cluster_groups"},
+	{"cluster_ids", "clusters"},
+	{"tenant_group_ids", "tenant_groups"},
+	{"tenant_ids", "tenants"},
+}
+
+func resourceNetboxConfigContext() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 100),
+		},
+		"description": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 200),
+		},
+		"weight": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1000,
+			ValidateFunc: validation.IntBetween(0, 32767),
+			Description:  "Config contexts with a higher weight take precedence when the data of several config contexts is merged.",
+		},
+		"is_active": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"data": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
+			Description:      "The context data as JSON object, e.g. `jsonencode({ ntp_servers = [\"10.0.0.1\"] })`.",
+		},
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The slugs of the tags of the devices and virtual machines the config context applies to. Unlike the `tags` attribute of other resources, these tags do not tag the config context itself.",
+		},
+	}
+	for _, scope := range configContextScopes {
+		s[scope.attribute] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		}
+	}
+	s["role_ids"].Description = "The IDs of the device roles the config context applies to."
+
+	return &schema.Resource{
+		Create: resourceNetboxConfigContextCreate,
+		Read:   resourceNetboxConfigContextRead,
+		Update: resourceNetboxConfigContextUpdate,
+		Delete: resourceNetboxConfigContextDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/features/context-data/):
+
+> Configuration context data (or "config contexts" for short) is a powerful feature that enables users to define arbitrary data that applies to device and virtual machines based on certain characteristics. For example, suppose you want to define syslog servers for devices assigned to sites within a particular region. In NetBox, you can create a config context instance containing this data and apply it to the desired region. All devices within this region will now include this data when fetched via an API.
+
+A config context without any scope applies to all devices and virtual machines. This resource uses the generic API of the provider, as the generated API client cannot deactivate config contexts.`,
+
+		Schema: s,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxConfigContextCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getConfigContextRequestData(d)
+	if err != nil {
+		return err
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/extras/config-contexts/", data)
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxConfigContextRead(d, m)
+}
+
+func resourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	configContext, err := genericAPIRequest(api, "GET", fmt.Sprintf("/extras/config-contexts/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", configContext["name"])
+	d.Set("description", configContext["description"])
+	if weight, ok := getGenericInt(configContext, "weight"); ok {
+		d.Set("weight", weight)
+	}
+	d.Set("is_active", configContext["is_active"])
+
+	contextData, err := formatOptionalJSON(configContext["data"])
+	if err != nil {
+		return err
+	}
+	d.Set("data", contextData)
+
+	// The tags of a config context are a list of slugs, not of nested tags
+	d.Set("tags", configContext["tags"])
+	for _, scope := range configContextScopes {
+		d.Set(scope.attribute, getGenericNestedObjectIDList(configContext, scope.field))
+	}
+
+	return nil
+}
+
+func resourceNetboxConfigContextUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := getConfigContextRequestData(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = genericAPIRequest(api, "PATCH", fmt.Sprintf("/extras/config-contexts/%d/", id), data)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxConfigContextRead(d, m)
+}
+
+func resourceNetboxConfigContextDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/extras/config-contexts/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getConfigContextRequestData returns the request body for creating or updating a config context. All scopes are
+// sent, so scopes that were removed from the configuration are cleared.
+func getConfigContextRequestData(d *schema.ResourceData) (map[string]interface{}, error) {
+	contextData, err := getOptionalJSON(d, "data")
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"weight":      d.Get("weight").(int),
+		"is_active":   d.Get("is_active").(bool),
+		"data":        contextData,
+		"tags":        d.Get("tags").(*schema.Set).List(),
+	}
+	for _, scope := range configContextScopes {
+		data[scope.field] = d.Get(scope.attribute).(*schema.Set).List()
+	}
+
+	return data, nil
+}
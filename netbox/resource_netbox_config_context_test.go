@@ -0,0 +1,120 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetConfigContextRequestData(t *testing.T) {
+	d := resourceNetboxConfigContext().TestResourceData()
+	d.Set("name", "ntp")
+	d.Set("weight", 2000)
+	d.Set("is_active", false)
+	d.Set("data", `{"ntp_servers": ["10.0.0.1"]}`)
+	d.Set("tags", []interface{}{"core"})
+	d.Set("site_ids", []interface{}{1, 2})
+
+	data, err := getConfigContextRequestData(d)
+	assert.NoError(t, err)
+	assert.Equal(t, "ntp", data["name"])
+	assert.Equal(t, 2000, data["weight"])
+	// is_active is sent explicitly, so config contexts can be deactivated
+	assert.Equal(t, false, data["is_active"])
+	assert.Equal(t, map[string]interface{}{"ntp_servers": []interface{}{"10.0.0.1"}}, data["data"])
+	assert.Equal(t, []interface{}{"core"}, data["tags"])
+	assert.ElementsMatch(t, []interface{}{1, 2}, data["sites"])
+	// Unset scopes are sent as empty lists to clear them
+	assert.Equal(t, []interface{}{}, data["regions"])
+	assert.Equal(t, []interface{}{}, data["tenants"])
+}
+
+func TestAccNetboxConfigContext_basic(t *testing.T) {
+	testSlug := "cfg_ctx_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_config_context" "test" {
+  name     = "%[1]s"
+  weight   = 2000
+  data     = jsonencode({ ntp_servers = ["10.0.0.1"] })
+  site_ids = [netbox_site.test.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_config_context.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_config_context.test", "weight", "2000"),
+					resource.TestCheckResourceAttr("netbox_config_context.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("netbox_config_context.test", "data", `{"ntp_servers":["10.0.0.1"]}`),
+					resource.TestCheckResourceAttr("netbox_config_context.test", "site_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_config_context.test", "site_ids.*", "netbox_site.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_config_context" "test" {
+  name      = "%[1]s"
+  is_active = false
+  data      = jsonencode({ ntp_servers = ["10.0.0.2"] })
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_config_context.test", "is_active", "false"),
+					resource.TestCheckResourceAttr("netbox_config_context.test", "data", `{"ntp_servers":["10.0.0.2"]}`),
+					resource.TestCheckResourceAttr("netbox_config_context.test", "site_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_config_context.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_config_context", &resource.Sweeper{
+		Name:         "netbox_config_context",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := extras.NewExtrasConfigContextsListParams()
+			res, err := api.Extras.ExtrasConfigContextsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, configContext := range res.GetPayload().Results {
+				if strings.HasPrefix(*configContext.Name, testPrefix) {
+					deleteParams := extras.NewExtrasConfigContextsDeleteParams().WithID(configContext.ID)
+					_, err := api.Extras.ExtrasConfigContextsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a config context")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,173 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxConsolePortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxConsolePortTemplateCreate,
+		Read:   resourceNetboxConsolePortTemplateRead,
+		Update: resourceNetboxConsolePortTemplateUpdate,
+		Delete: resourceNetboxConsolePortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/consoleporttemplate/):
+
+> A template for a console port that will be created on all instantiations of the parent device type or module type.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instantiated component. On module types, `{module}` is replaced with the position of the module bay.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(consolePortTypes, false),
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxConsolePortTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableConsolePortTemplateFromResourceData(d)
+
+	params := dcim.NewDcimConsolePortTemplatesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimConsolePortTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxConsolePortTemplateRead(d, m)
+}
+
+func resourceNetboxConsolePortTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimConsolePortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimConsolePortTemplatesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimConsolePortTemplatesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+
+	if template.DeviceType != nil {
+		d.Set("device_type_id", template.DeviceType.ID)
+	} else {
+		d.Set("device_type_id", nil)
+	}
+	if template.ModuleType != nil {
+		d.Set("module_type_id", template.ModuleType.ID)
+	} else {
+		d.Set("module_type_id", nil)
+	}
+	d.Set("name", template.Name)
+	if template.Type != nil {
+		d.Set("type", template.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+	d.Set("label", template.Label)
+	d.Set("description", template.Description)
+
+	return nil
+}
+
+func resourceNetboxConsolePortTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableConsolePortTemplateFromResourceData(d)
+
+	params := dcim.NewDcimConsolePortTemplatesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimConsolePortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/console-port-templates/%d/", id), map[string]string{
+		"type":        "type",
+		"label":       "label",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxConsolePortTemplateRead(d, m)
+}
+
+func resourceNetboxConsolePortTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimConsolePortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimConsolePortTemplatesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableConsolePortTemplateFromResourceData(d *schema.ResourceData) *models.WritableConsolePortTemplate {
+	data := models.WritableConsolePortTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Type:        d.Get("type").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if deviceTypeID, ok := d.GetOk("device_type_id"); ok {
+		data.DeviceType = int64ToPtr(int64(deviceTypeID.(int)))
+	}
+	if moduleTypeID, ok := d.GetOk("module_type_id"); ok {
+		data.ModuleType = int64ToPtr(int64(moduleTypeID.(int)))
+	}
+
+	return &data
+}
@@ -0,0 +1,50 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxConsolePortTemplate_basic(t *testing.T) {
+
+	testSlug := "console_port_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_console_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+  type           = "rj-45"
+  label          = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_console_port_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_console_port_template.test", "type", "rj-45"),
+					resource.TestCheckResourceAttr("netbox_console_port_template.test", "label", testName),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_console_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_console_port_template.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_console_port_template.test", "label", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_console_port_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/go-openapi/strfmt"
@@ -28,10 +28,12 @@ func resourceNetboxContact() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 			"group_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the `netbox_contact_group` this contact belongs to.",
 			},
 			"email": {
 				Type:     schema.TypeString,
@@ -49,7 +51,7 @@ func resourceNetboxContact() *schema.Resource {
 }
 
 func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	phone := d.Get("phone").(string)
@@ -62,6 +64,8 @@ func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Name = &name
 	data.Tags = tags
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 	data.Phone = phone
 	data.Email = strfmt.Email(email)
 
@@ -82,7 +86,7 @@ func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactsReadParams().WithID(id)
 
@@ -102,13 +106,21 @@ func resourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("email", res.GetPayload().Email)
 	if res.GetPayload().Group != nil {
 		d.Set("group_id", res.GetPayload().Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
 	}
 
 	return nil
 }
 
 func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableContact{}
@@ -122,6 +134,8 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Name = &name
 	data.Tags = tags
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 	data.Phone = phone
 	data.Email = strfmt.Email(email)
 	if group_id != 0 {
@@ -135,11 +149,18 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/tenancy/contacts/%d/", id), map[string]string{
+		"group_id": "group",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxContactRead(d, m)
 }
 
 func resourceNetboxContactDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactsDeleteParams().WithID(id)
@@ -3,10 +3,10 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxContactAssignment() *schema.Resource {
@@ -22,12 +22,14 @@ func resourceNetboxContactAssignment() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"content_type": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The content type of the object the contact is assigned to, e.g. `dcim.site`, `dcim.device`, `circuits.circuit` or `tenancy.tenant`.",
 			},
 			"object_id": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the object the contact is assigned to.",
 			},
 			"contact_id": {
 				Type:     schema.TypeInt,
@@ -37,6 +39,12 @@ func resourceNetboxContactAssignment() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"primary", "secondary", "tertiary", "inactive"}, false),
+				Description:  "The priority of the contact for the object. One of `primary`, `secondary`, `tertiary` or `inactive`.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -45,7 +53,7 @@ func resourceNetboxContactAssignment() *schema.Resource {
 }
 
 func resourceNetboxContactAssignmentCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	content_type := d.Get("content_type").(string)
 	object_id := int64(d.Get("object_id").(int))
@@ -59,6 +67,10 @@ func resourceNetboxContactAssignmentCreate(d *schema.ResourceData, m interface{}
 	data.Contact = &contact_id
 	data.Role = &role_id
 
+	if priority, ok := d.GetOk("priority"); ok {
+		data.Priority = strToPtr(priority.(string))
+	}
+
 	params := tenancy.NewTenancyContactAssignmentsCreateParams().WithData(data)
 
 	res, err := api.Tenancy.TenancyContactAssignmentsCreate(params, nil)
@@ -72,7 +84,7 @@ func resourceNetboxContactAssignmentCreate(d *schema.ResourceData, m interface{}
 }
 
 func resourceNetboxContactAssignmentRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactAssignmentsReadParams().WithID(id)
 
@@ -98,12 +110,17 @@ func resourceNetboxContactAssignmentRead(d *schema.ResourceData, m interface{})
 	if res.GetPayload().Role != nil {
 		d.Set("role_id", res.GetPayload().Role.ID)
 	}
+	if res.GetPayload().Priority != nil {
+		d.Set("priority", res.GetPayload().Priority.Value)
+	} else {
+		d.Set("priority", nil)
+	}
 
 	return nil
 }
 
 func resourceNetboxContactAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableContactAssignment{}
@@ -123,6 +140,10 @@ func resourceNetboxContactAssignmentUpdate(d *schema.ResourceData, m interface{}
 	if role_id != 0 {
 		data.Role = &role_id
 	}
+	// An unset priority is sent as null, which clears it
+	if priority, ok := d.GetOk("priority"); ok {
+		data.Priority = strToPtr(priority.(string))
+	}
 
 	params := tenancy.NewTenancyContactAssignmentsPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -135,7 +156,7 @@ func resourceNetboxContactAssignmentUpdate(d *schema.ResourceData, m interface{}
 }
 
 func resourceNetboxContactAssignmentDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactAssignmentsDeleteParams().WithID(id)
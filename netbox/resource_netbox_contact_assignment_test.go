@@ -70,6 +70,63 @@ resource "netbox_contact_assignment" "test" {
 	})
 }
 
+func testAccNetboxContactAssignmentTenant(testName, priority string) string {
+	return fmt.Sprintf(`
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+resource "netbox_contact" "test" {
+  name = "%[1]s"
+}
+resource "netbox_contact_role" "test" {
+  name = "%[1]s"
+}
+resource "netbox_contact_assignment" "test" {
+  content_type = "tenancy.tenant"
+  object_id    = netbox_tenant.test.id
+  contact_id   = netbox_contact.test.id
+  role_id      = netbox_contact_role.test.id
+  %[2]s
+}`, testName, priority)
+}
+
+func TestAccNetboxContactAssignment_priority(t *testing.T) {
+
+	testSlug := "contactassign_prio"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxContactAssignmentTenant(testName, `priority = "primary"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_contact_assignment.test", "content_type", "tenancy.tenant"),
+					resource.TestCheckResourceAttrPair("netbox_contact_assignment.test", "object_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_contact_assignment.test", "priority", "primary"),
+				),
+			},
+			{
+				ResourceName:      "netbox_contact_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetboxContactAssignmentTenant(testName, `priority = "secondary"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_contact_assignment.test", "priority", "secondary"),
+				),
+			},
+			{
+				Config: testAccNetboxContactAssignmentTenant(testName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_contact_assignment.test", "priority", ""),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_contact_assignment", &resource.Sweeper{
 		Name:         "netbox_contact_assignment",
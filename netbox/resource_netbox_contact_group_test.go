@@ -0,0 +1,114 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxContactGroup_basic(t *testing.T) {
+
+	testSlug := "contact_grp_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_contact_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_contact_group" "test" {
+  name        = "%[1]s"
+  parent_id   = netbox_contact_group.parent.id
+  description = "Network operations"
+  tags        = [netbox_tag.test.name]
+}
+
+resource "netbox_contact" "test" {
+  name     = "%[1]s"
+  group_id = netbox_contact_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "description", "Network operations"),
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "tags.0", testName),
+					resource.TestCheckResourceAttrPair("netbox_contact_group.test", "parent_id", "netbox_contact_group.parent", "id"),
+					resource.TestCheckResourceAttrPair("netbox_contact.test", "group_id", "netbox_contact_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_contact_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_contact_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_contact_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_contact" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "parent_id", "0"),
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_contact_group.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("netbox_contact.test", "group_id", "0"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_contact_group", &resource.Sweeper{
+		Name:         "netbox_contact_group",
+		Dependencies: []string{"netbox_contact"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := tenancy.NewTenancyContactGroupsListParams()
+			res, err := api.Tenancy.TenancyContactGroupsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, group := range res.GetPayload().Results {
+				if strings.HasPrefix(*group.Name, testPrefix) {
+					deleteParams := tenancy.NewTenancyContactGroupsDeleteParams().WithID(group.ID)
+					_, err := api.Tenancy.TenancyContactGroupsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a contact group")
+				}
+			}
+			return nil
+		},
+	})
+}
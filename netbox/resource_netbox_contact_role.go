@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +31,7 @@ func resourceNetboxContactRole() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 30),
 			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -40,7 +40,7 @@ func resourceNetboxContactRole() *schema.Resource {
 }
 
 func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -57,6 +57,8 @@ func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) erro
 	data.Name = &name
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := tenancy.NewTenancyContactRolesCreateParams().WithData(data)
 
 	res, err := api.Tenancy.TenancyContactRolesCreate(params, nil)
@@ -70,7 +72,7 @@ func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactRolesReadParams().WithID(id)
 
@@ -90,11 +92,16 @@ func resourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error
 	d.Set("name", contactrole.Name)
 	d.Set("slug", contactrole.Slug)
 
+	cf := getManagedCustomFields(api, d, contactrole.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.ContactRole{}
@@ -111,6 +118,8 @@ func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) erro
 	data.Name = &name
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := tenancy.NewTenancyContactRolesPartialUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Tenancy.TenancyContactRolesPartialUpdate(params, nil)
@@ -122,7 +131,7 @@ func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxContactRoleDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactRolesDeleteParams().WithID(id)
@@ -0,0 +1,243 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourcesNetboxVersion is the first Netbox version with remote data sources.
+const dataSourcesNetboxVersion = "3.5.0"
+
+// dataSourceSyncIntervalNetboxVersion is the first Netbox version that syncs data sources periodically.
+const dataSourceSyncIntervalNetboxVersion = "4.1.0"
+
+func resourceNetboxDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxDataSourceCreate,
+		Read:          resourceNetboxDataSourceRead,
+		Update:        resourceNetboxDataSourceUpdate,
+		Delete:        resourceNetboxDataSourceDelete,
+		CustomizeDiff: resourceNetboxDataSourceCustomizeDiff,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/models/core/datasource/):
+
+> A data source represents some external repository of data which NetBox can consume, such as a git repository. Files within the data source are synchronized to NetBox by saving them in the database as data file objects.
+
+Data files are used by config contexts and templates, and can be looked up with the ` + "`netbox_data_file`" + ` data source. Creating a data source does not sync it, use the ` + "`netbox_data_source_sync`" + ` resource for that.
+
+This resource requires Netbox 3.5 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The backend of the data source. By default one of `local`, `git` or `amazon-s3`. Backends added by plugins are supported as well, the type is validated against the backends of Netbox during plan.",
+			},
+			"source_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+				Description:  "The URL of the data source, e.g. `https://github.com/example/netbox-data.git`, or the path for the `local` type, e.g. `file:///opt/netbox-data`.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The parameters of the backend as JSON object, e.g. `jsonencode({ username = \"netbox\", password = var.git_token, branch = \"main\" })` for git or `jsonencode({ aws_access_key_id = \"...\", aws_secret_access_key = var.aws_secret_key })` for Amazon S3.",
+			},
+			"ignore_rules": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Patterns of files to ignore when syncing, one per line, e.g. `*.md`.",
+			},
+			"sync_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{60, 720, 1440, 10080, 43200}),
+				Description:  "The interval in minutes in which Netbox syncs the data source. One of `60`, `720`, `1440`, `10080` or `43200`. If not set, the data source is only synced on demand. Requires Netbox 4.1 or later.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last sync of the data source, e.g. `completed`.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDataSourceCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getDataSourceRequestData(api, d)
+	if err != nil {
+		return err
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/core/data-sources/", data)
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxDataSourceRead(d, m)
+}
+
+func resourceNetboxDataSourceRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	dataSource, err := genericAPIRequest(api, "GET", fmt.Sprintf("/core/data-sources/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", dataSource["name"])
+	if dataSourceType, ok := dataSource["type"].(map[string]interface{}); ok {
+		d.Set("type", dataSourceType["value"])
+	}
+	d.Set("source_url", dataSource["source_url"])
+	d.Set("enabled", dataSource["enabled"])
+	d.Set("description", dataSource["description"])
+	d.Set("comments", dataSource["comments"])
+	d.Set("ignore_rules", dataSource["ignore_rules"])
+	d.Set("status", getDataSourceStatus(dataSource))
+
+	parameters, err := formatOptionalJSON(dataSource["parameters"])
+	if err != nil {
+		return err
+	}
+	d.Set("parameters", parameters)
+
+	if syncInterval, ok := dataSource["sync_interval"].(map[string]interface{}); ok {
+		if value, ok := getGenericInt(syncInterval, "value"); ok {
+			d.Set("sync_interval", value)
+		}
+	} else {
+		d.Set("sync_interval", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(dataSource)))
+
+	cf := getManagedCustomFields(api, d, dataSource[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDataSourceUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := getDataSourceRequestData(api, d)
+	if err != nil {
+		return err
+	}
+
+	_, err = genericAPIRequest(api, "PATCH", fmt.Sprintf("/core/data-sources/%d/", id), data)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDataSourceRead(d, m)
+}
+
+func resourceNetboxDataSourceDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/core/data-sources/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxDataSourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(dataSourcesNetboxVersion) {
+		return fmt.Errorf("netbox_data_source requires Netbox %s or later, but the Netbox version is %s", dataSourcesNetboxVersion, api.netboxVersion)
+	}
+	if _, ok := d.GetOk("sync_interval"); ok && !api.hasNetboxVersion(dataSourceSyncIntervalNetboxVersion) {
+		return fmt.Errorf("sync_interval requires Netbox %s or later, but the Netbox version is %s", dataSourceSyncIntervalNetboxVersion, api.netboxVersion)
+	}
+	return validateChoiceAttribute(d, m, "/core/data-sources/", "type", "type")
+}
+
+// getDataSourceRequestData returns the request body for creating or updating a data source. The sync interval is only
+// sent on Netbox versions that support it.
+func getDataSourceRequestData(api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	parameters, err := getOptionalJSON(d, "parameters")
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"type":         d.Get("type").(string),
+		"source_url":   d.Get("source_url").(string),
+		"enabled":      d.Get("enabled").(bool),
+		"description":  d.Get("description").(string),
+		"comments":     d.Get("comments").(string),
+		"parameters":   parameters,
+		"ignore_rules": d.Get("ignore_rules").(string),
+	}
+
+	if api.hasNetboxVersion(dataSourceSyncIntervalNetboxVersion) {
+		data["sync_interval"] = nil
+		if syncInterval, ok := d.GetOk("sync_interval"); ok {
+			data["sync_interval"] = syncInterval.(int)
+		}
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data, nil
+}
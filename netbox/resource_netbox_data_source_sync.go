@@ -0,0 +1,121 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDataSourceSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDataSourceSyncCreate,
+		ReadContext:   resourceNetboxDataSourceSyncRead,
+		DeleteContext: resourceNetboxDataSourceSyncDelete,
+
+		Description: `:meta:subcategory:Extras:This resource syncs a data source in Netbox and waits until the sync is finished, so the data files of the data source are up to date.
+
+The data source is synced when the resource is created. Change ` + "`triggers`" + ` to sync it again, e.g. with the commit of a git repository. If the sync fails, the resource is tainted, so the data source is synced again on the next apply. Destroying the resource only removes it from the state. The time to wait for the sync is set with the ` + "`create`" + ` timeout, which defaults to 10 minutes.
+
+This resource requires Netbox 3.5 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"data_source_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values that sync the data source again when they change.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the data source when the sync finished.",
+			},
+			"last_synced": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the sync.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceNetboxDataSourceSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(dataSourcesNetboxVersion) {
+		return diag.Errorf("netbox_data_source_sync requires Netbox %s or later, but the Netbox version is %s", dataSourcesNetboxVersion, api.netboxVersion)
+	}
+
+	dataSourceID := int64(d.Get("data_source_id").(int))
+	_, err := genericAPIRequest(api, "POST", fmt.Sprintf("/core/data-sources/%d/sync/", dataSourceID), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resource.UniqueId())
+
+	// Netbox syncs data sources in a background worker, so wait for the sync to finish
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"queued", "syncing"},
+		Target:     []string{"completed", "failed"},
+		Refresh:    getDataSourceSyncStateRefreshFunc(api, dataSourceID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 2 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the sync of data source %d: %s", dataSourceID, err)
+	}
+	dataSource := result.(map[string]interface{})
+
+	status := getDataSourceStatus(dataSource)
+	d.Set("status", status)
+	d.Set("last_synced", dataSource["last_synced"])
+
+	if status != "completed" {
+		// The resource is tainted because its ID is already set, so the data source is synced again on the next apply
+		return diag.Errorf("sync of data source %d finished with status %s, see the jobs of the data source in Netbox for details", dataSourceID, status)
+	}
+
+	return nil
+}
+
+func resourceNetboxDataSourceSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The data source is not read again, as every later sync would otherwise show up as drift
+	return nil
+}
+
+func resourceNetboxDataSourceSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// A sync cannot be undone, so the data files are kept in Netbox
+	return nil
+}
+
+func getDataSourceSyncStateRefreshFunc(api *providerState, dataSourceID int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		dataSource, err := genericAPIRequest(api, "GET", fmt.Sprintf("/core/data-sources/%d/", dataSourceID), nil)
+		if err != nil {
+			return nil, "", err
+		}
+		return dataSource, getDataSourceStatus(dataSource), nil
+	}
+}
+
+func getDataSourceStatus(dataSource map[string]interface{}) string {
+	status, _ := dataSource["status"].(map[string]interface{})
+	value, _ := status["value"].(string)
+	return value
+}
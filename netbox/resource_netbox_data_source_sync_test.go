@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxDataSourceSyncCreate(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/core/data-sources/3/sync/":
+			w.Write([]byte(`{"id": 3, "status": {"value": "queued"}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/core/data-sources/3/":
+			// The data source is synced in the background, so it is syncing when it is polled first
+			polls++
+			if polls == 1 {
+				w.Write([]byte(`{"id": 3, "status": {"value": "syncing"}}`))
+				return
+			}
+			w.Write([]byte(`{"id": 3, "status": {"value": "completed"}, "last_synced": "2024-10-01T12:00:00Z"}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI), netboxVersion: "4.1.0"}
+
+	d := resourceNetboxDataSourceSync().TestResourceData()
+	d.Set("data_source_id", 3)
+
+	diags := resourceNetboxDataSourceSyncCreate(context.Background(), d, api)
+	assert.False(t, diags.HasError(), diags)
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, "completed", d.Get("status"))
+	assert.Equal(t, "2024-10-01T12:00:00Z", d.Get("last_synced"))
+}
+
+func TestNetboxDataSourceSyncCreateRequiresVersion(t *testing.T) {
+	d := resourceNetboxDataSourceSync().TestResourceData()
+	d.Set("data_source_id", 3)
+
+	diags := resourceNetboxDataSourceSyncCreate(context.Background(), d, &providerState{netboxVersion: "3.4.3"})
+	assert.True(t, diags.HasError())
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDataSourceRequestData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxDataSource().Schema, map[string]interface{}{
+		"name":          "templates",
+		"type":          "git",
+		"source_url":    "https://github.com/example/netbox-data.git",
+		"enabled":       true,
+		"parameters":    `{"branch": "main"}`,
+		"sync_interval": 60,
+	})
+
+	data, err := getDataSourceRequestData(&providerState{netboxVersion: "4.1.0"}, d)
+	assert.NoError(t, err)
+	assert.Equal(t, "git", data["type"])
+	assert.Equal(t, map[string]interface{}{"branch": "main"}, data["parameters"])
+	assert.Equal(t, 60, data["sync_interval"])
+
+	// Older versions do not know the sync interval
+	data, err = getDataSourceRequestData(&providerState{netboxVersion: "4.0.0"}, d)
+	assert.NoError(t, err)
+	assert.NotContains(t, data, "sync_interval")
+}
+
+func TestAccNetboxDataSource_basic(t *testing.T) {
+	testSlug := "data_source_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_data_source" "test" {
+  name         = "%s"
+  type         = "local"
+  source_url   = "file:///tmp/%s"
+  enabled      = false
+  description  = "This is a test"
+  ignore_rules = "*.md"
+}`, testName, testSlug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_data_source.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "type", "local"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "source_url", "file:///tmp/"+testSlug),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "description", "This is a test"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "ignore_rules", "*.md"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "status", "new"),
+				),
+			},
+			{
+				ResourceName:      "netbox_data_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,12 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// deviceOOBIPMinimumNetboxVersion is the first Netbox version with out-of-band IPs on devices.
+const deviceOOBIPMinimumNetboxVersion = "4.0.0"
+
 func resourceNetboxDevice() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNetboxDeviceCreate,
 		ReadContext:   resourceNetboxDeviceRead,
 		UpdateContext: resourceNetboxDeviceUpdate,
 		DeleteContext: resourceNetboxDeviceDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/dcim/devices/"),
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/devices/#devices):
 
@@ -56,6 +60,12 @@ func resourceNetboxDevice() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"airflow": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"front-to-rear", "rear-to-front", "left-to-right", "right-to-left", "side-to-rear", "passive", "mixed"}, false),
+				Description:  "One of `front-to-rear`, `rear-to-front`, `left-to-right`, `right-to-left`, `side-to-rear`, `passive` or `mixed`.",
+			},
 			"site_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -73,12 +83,58 @@ func resourceNetboxDevice() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"oob_ip_address_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the out-of-band management IP address of the device. The IP address must be assigned to an interface of this device. Requires Netbox 4.0 or later.",
+			},
+			"config_context": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered config context of the device as JSON string, e.g. for use with `jsondecode`.",
+			},
 			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("offline", "active", "planned", "staged", "failed", "inventory", "decommissioning"),
+			},
+			"asset_tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rack_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"rack_position": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"rack_id", "rack_face"},
+				Description:  "The lowest rack unit occupied by the device. Half units such as `1.5` are allowed.",
+			},
+			"rack_face": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"offline", "active", "planned", "staged", "failed", "inventory"}, false),
-				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"front", "rear"}, false),
+				RequiredWith: []string{"rack_id"},
+				Description:  "One of `front` or `rear`.",
 			},
+			"virtual_chassis_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"virtual_chassis_position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"virtual_chassis_id"},
+			},
+			"virtual_chassis_priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"virtual_chassis_id"},
+			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -87,7 +143,7 @@ func resourceNetboxDevice() *schema.Resource {
 }
 
 func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -107,6 +163,8 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 	serial := d.Get("serial").(string)
 	data.Serial = serial
 
+	data.Airflow = d.Get("airflow").(string)
+
 	status := d.Get("status").(string)
 	data.Status = status
 
@@ -146,6 +204,45 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 		data.Site = &siteID
 	}
 
+	rackIDValue, ok := d.GetOk("rack_id")
+	if ok {
+		rackID := int64(rackIDValue.(int))
+		data.Rack = &rackID
+	}
+
+	rackPositionValue, ok := d.GetOk("rack_position")
+	if ok {
+		data.Position = float64ToPtr(rackPositionValue.(float64))
+	}
+
+	rackFaceValue, ok := d.GetOk("rack_face")
+	if ok {
+		data.Face = strToPtr(rackFaceValue.(string))
+	}
+
+	assetTagValue, ok := d.GetOk("asset_tag")
+	if ok {
+		data.AssetTag = strToPtr(assetTagValue.(string))
+	}
+
+	virtualChassisIDValue, ok := d.GetOk("virtual_chassis_id")
+	if ok {
+		virtualChassisID := int64(virtualChassisIDValue.(int))
+		data.VirtualChassis = &virtualChassisID
+	}
+
+	virtualChassisPositionValue, ok := d.GetOk("virtual_chassis_position")
+	if ok {
+		data.VcPosition = int64ToPtr(int64(virtualChassisPositionValue.(int)))
+	}
+
+	virtualChassisPriorityValue, ok := d.GetOk("virtual_chassis_priority")
+	if ok {
+		data.VcPriority = int64ToPtr(int64(virtualChassisPriorityValue.(int)))
+	}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
 	params := dcim.NewDcimDevicesCreateParams().WithData(&data)
@@ -157,11 +254,18 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	if _, ok := d.GetOk("oob_ip_address_id"); ok {
+		err = setDeviceOOBIP(api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetboxDeviceRead(ctx, d, m)
 }
 
 func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -169,7 +273,7 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 
 	params := dcim.NewDcimDevicesReadParams().WithID(id)
 
-	res, err := api.Dcim.DcimDevicesRead(params, nil)
+	res, err := api.Dcim.DcimDevicesRead(params, nil, withIncludeConfigContext)
 	if err != nil {
 		errorcode := err.(*dcim.DcimDevicesReadDefault).Code()
 		if errorcode == 404 {
@@ -182,6 +286,12 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 
 	device := res.GetPayload()
 
+	configContext, err := getConfigContextJSON(device.ConfigContext)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("config_context", configContext)
+
 	d.Set("name", device.Name)
 
 	if device.DeviceType != nil {
@@ -240,14 +350,80 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 
 	d.Set("serial", device.Serial)
 
+	if device.Airflow != nil {
+		d.Set("airflow", device.Airflow.Value)
+	} else {
+		d.Set("airflow", nil)
+	}
+
 	d.Set("status", device.Status.Value)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(device.Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, device.Tags))
+
+	if device.AssetTag != nil {
+		d.Set("asset_tag", device.AssetTag)
+	} else {
+		d.Set("asset_tag", nil)
+	}
+
+	if device.Rack != nil {
+		d.Set("rack_id", device.Rack.ID)
+	} else {
+		d.Set("rack_id", nil)
+	}
+
+	if device.Position != nil {
+		d.Set("rack_position", device.Position)
+	} else {
+		d.Set("rack_position", nil)
+	}
+
+	if device.Face != nil {
+		d.Set("rack_face", device.Face.Value)
+	} else {
+		d.Set("rack_face", nil)
+	}
+
+	if device.VirtualChassis != nil {
+		d.Set("virtual_chassis_id", device.VirtualChassis.ID)
+	} else {
+		d.Set("virtual_chassis_id", nil)
+	}
+
+	if device.VcPosition != nil {
+		d.Set("virtual_chassis_position", device.VcPosition)
+	} else {
+		d.Set("virtual_chassis_position", nil)
+	}
+
+	if device.VcPriority != nil {
+		d.Set("virtual_chassis_priority", device.VcPriority)
+	} else {
+		d.Set("virtual_chassis_priority", nil)
+	}
+
+	cf := getManagedCustomFields(api, d, device.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	if api.hasNetboxVersion(deviceOOBIPMinimumNetboxVersion) {
+		genericDevice, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/devices/%d/", id), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if oobIPID, ok := getGenericNestedObjectID(genericDevice, "oob_ip"); ok {
+			d.Set("oob_ip_address_id", oobIPID)
+		} else {
+			d.Set("oob_ip_address_id", nil)
+		}
+	}
+
 	return diags
 }
 
 func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableDeviceWithConfigContext{}
@@ -321,6 +497,45 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 		data.PrimaryIp6 = &primaryIP6
 	}
 
+	rackIDValue, ok := d.GetOk("rack_id")
+	if ok {
+		rackID := int64(rackIDValue.(int))
+		data.Rack = &rackID
+	}
+
+	rackPositionValue, ok := d.GetOk("rack_position")
+	if ok {
+		data.Position = float64ToPtr(rackPositionValue.(float64))
+	}
+
+	rackFaceValue, ok := d.GetOk("rack_face")
+	if ok {
+		data.Face = strToPtr(rackFaceValue.(string))
+	}
+
+	assetTagValue, ok := d.GetOk("asset_tag")
+	if ok {
+		data.AssetTag = strToPtr(assetTagValue.(string))
+	}
+
+	virtualChassisIDValue, ok := d.GetOk("virtual_chassis_id")
+	if ok {
+		virtualChassisID := int64(virtualChassisIDValue.(int))
+		data.VirtualChassis = &virtualChassisID
+	}
+
+	virtualChassisPositionValue, ok := d.GetOk("virtual_chassis_position")
+	if ok {
+		data.VcPosition = int64ToPtr(int64(virtualChassisPositionValue.(int)))
+	}
+
+	virtualChassisPriorityValue, ok := d.GetOk("virtual_chassis_priority")
+	if ok {
+		data.VcPriority = int64ToPtr(int64(virtualChassisPriorityValue.(int)))
+	}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
 	if d.HasChanges("comments") {
@@ -349,6 +564,8 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 		data.Serial = serial
 	}
 
+	data.Airflow = d.Get("airflow").(string)
+
 	params := dcim.NewDcimDevicesUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimDevicesUpdate(params, nil)
@@ -356,11 +573,25 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/devices/%d/", id), map[string]string{
+		"airflow": "airflow",
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("oob_ip_address_id") {
+		err = setDeviceOOBIP(api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetboxDeviceRead(ctx, d, m)
 }
 
 func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -373,3 +604,42 @@ func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m i
 	}
 	return diags
 }
+
+// setDeviceOOBIP sets the out-of-band IP of the device to the configured IP address, or unsets it if there is none.
+func setDeviceOOBIP(api *providerState, d *schema.ResourceData) error {
+	if !api.hasNetboxVersion(deviceOOBIPMinimumNetboxVersion) {
+		return fmt.Errorf("oob_ip_address_id requires Netbox %s or later, but the Netbox version is %s", deviceOOBIPMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	deviceID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var oobIPID *int64
+	if oobIPValue, ok := d.GetOk("oob_ip_address_id"); ok {
+		oobIPID = int64ToPtr(int64(oobIPValue.(int)))
+		err := validateDeviceIPAssignment(api, deviceID, *oobIPID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/dcim/devices/%d/", deviceID), map[string]interface{}{"oob_ip": oobIPID})
+	return err
+}
+
+// validateDeviceIPAssignment checks that the given IP address is assigned to an interface of the given device.
+// Netbox rejects primary and out-of-band IPs that are not, but its error message does not tell which IP is affected.
+func validateDeviceIPAssignment(api *providerState, deviceID int64, IPAddressID int64) error {
+	ipAddress, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/ip-addresses/%d/", IPAddressID), nil)
+	if err != nil {
+		return err
+	}
+
+	assignedObjectType, _ := ipAddress["assigned_object_type"].(string)
+	assignedObject, _ := ipAddress["assigned_object"].(map[string]interface{})
+	if assignedObjectType == "dcim.interface" && assignedObject != nil {
+		if assignedDeviceID, ok := getGenericNestedObjectID(assignedObject, "device"); ok && assignedDeviceID == deviceID {
+			return nil
+		}
+	}
+	return fmt.Errorf("IP address %d is not assigned to an interface of device %d", IPAddressID, deviceID)
+}
@@ -0,0 +1,172 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDeviceBay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceBayCreate,
+		Read:   resourceNetboxDeviceBayRead,
+		Update: resourceNetboxDeviceBayUpdate,
+		Delete: resourceNetboxDeviceBayDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/devicebay/):
+
+> Device bays represent a space or slot within a parent device in which a child device may be installed. For example, a 2U parent chassis might house four individual blade servers. The chassis would appear in the rack elevation as a 2U device with four device bays, and each server within it would be defined as a 1U device installed in one of the device bays.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"installed_device_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the child device installed in this device bay. The device type of the child device must have the subdevice role `child`.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			adoptExistingKey: adoptExistingSchema,
+			tagsKey:          tagsSchema,
+			customFieldsKey:  customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceBayCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/device-bays/")
+	if err != nil {
+		return err
+	}
+	if adopted {
+		return resourceNetboxDeviceBayUpdate(d, m)
+	}
+
+	data := getWritableDeviceBayFromResourceData(api, d)
+
+	params := dcim.NewDcimDeviceBaysCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimDeviceBaysCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceBayRead(d, m)
+}
+
+func resourceNetboxDeviceBayRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBaysReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDeviceBaysRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimDeviceBaysReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	bay := res.GetPayload()
+
+	d.Set("device_id", bay.Device.ID)
+	d.Set("name", bay.Name)
+	if bay.InstalledDevice != nil {
+		d.Set("installed_device_id", bay.InstalledDevice.ID)
+	} else {
+		d.Set("installed_device_id", nil)
+	}
+	d.Set("label", bay.Label)
+	d.Set("description", bay.Description)
+	d.Set(tagsKey, getManagedTagList(api, d, bay.Tags))
+
+	cf := getManagedCustomFields(api, d, bay.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDeviceBayUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDeviceBayFromResourceData(api, d)
+
+	params := dcim.NewDcimDeviceBaysPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimDeviceBaysPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/device-bays/%d/", id), map[string]string{
+		"installed_device_id": "installed_device",
+		"label":               "label",
+		"description":         "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceBayRead(d, m)
+}
+
+func resourceNetboxDeviceBayDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBaysDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimDeviceBaysDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDeviceBayFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableDeviceBay {
+	data := models.WritableDeviceBay{
+		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:        strToPtr(d.Get("name").(string)),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if installedDeviceID, ok := d.GetOk("installed_device_id"); ok {
+		data.InstalledDevice = int64ToPtr(int64(installedDeviceID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
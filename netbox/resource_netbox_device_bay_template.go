@@ -0,0 +1,138 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDeviceBayTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceBayTemplateCreate,
+		Read:   resourceNetboxDeviceBayTemplateRead,
+		Update: resourceNetboxDeviceBayTemplateUpdate,
+		Delete: resourceNetboxDeviceBayTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/devicebaytemplate/):
+
+> A template for a device bay that will be created on all instantiations of the parent device type. The parent device type must have the subdevice role ` + "`parent`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instantiated component.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceBayTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableDeviceBayTemplateFromResourceData(d)
+
+	params := dcim.NewDcimDeviceBayTemplatesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimDeviceBayTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceBayTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceBayTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBayTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDeviceBayTemplatesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimDeviceBayTemplatesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+
+	d.Set("device_type_id", template.DeviceType.ID)
+	d.Set("name", template.Name)
+	d.Set("label", template.Label)
+	d.Set("description", template.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceBayTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDeviceBayTemplateFromResourceData(d)
+
+	params := dcim.NewDcimDeviceBayTemplatesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimDeviceBayTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/device-bay-templates/%d/", id), map[string]string{
+		"label":       "label",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceBayTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceBayTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBayTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimDeviceBayTemplatesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDeviceBayTemplateFromResourceData(d *schema.ResourceData) *models.WritableDeviceBayTemplate {
+	data := models.WritableDeviceBayTemplate{
+		DeviceType:  int64ToPtr(int64(d.Get("device_type_id").(int))),
+		Name:        strToPtr(d.Get("name").(string)),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	return &data
+}
@@ -0,0 +1,47 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceBayTemplate_basic(t *testing.T) {
+
+	testSlug := "device_bay_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+  label          = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_bay_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_bay_template.test", "label", testName),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_bay_template.test", "label", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_bay_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxDeviceBayFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "parent" {
+  model = "%[1]s_parent"
+  manufacturer_id = netbox_manufacturer.test.id
+  subdevice_role = "parent"
+}
+
+resource "netbox_device_type" "child" {
+  model = "%[1]s_child"
+  manufacturer_id = netbox_manufacturer.test.id
+  subdevice_role = "child"
+  u_height = 0
+}
+
+resource "netbox_device" "parent" {
+  name = "%[1]s_parent"
+  device_type_id = netbox_device_type.parent.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device" "child" {
+  name = "%[1]s_child"
+  device_type_id = netbox_device_type.child.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}`, testName)
+}
+
+func TestAccNetboxDeviceBay_basic(t *testing.T) {
+
+	testSlug := "device_bay_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceBayFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay" "test" {
+  device_id           = netbox_device.parent.id
+  name                = "%[1]s"
+  label               = "%[1]s"
+  description         = "%[1]s"
+  installed_device_id = netbox_device.child.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_bay.test", "device_id", "netbox_device.parent", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_bay.test", "installed_device_id", "netbox_device.child", "id"),
+					resource.TestCheckResourceAttr("netbox_device_bay.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_bay.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_bay.test", "description", testName),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceBayFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay" "test" {
+  device_id = netbox_device.parent.id
+  name      = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_bay.test", "installed_device_id", "0"),
+					resource.TestCheckResourceAttr("netbox_device_bay.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_device_bay.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_bay.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_bay", &resource.Sweeper{
+		Name:         "netbox_device_bay",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimDeviceBaysListParams()
+			res, err := api.Dcim.DcimDeviceBaysList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, bay := range res.GetPayload().Results {
+				if strings.HasPrefix(*bay.Name, testPrefix) {
+					deleteParams := dcim.NewDcimDeviceBaysDeleteParams().WithID(bay.ID)
+					_, err := api.Dcim.DcimDeviceBaysDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a device bay")
+				}
+			}
+			return nil
+		},
+	})
+}
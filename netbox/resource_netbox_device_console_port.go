@@ -0,0 +1,198 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDeviceConsolePort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceConsolePortCreate,
+		Read:   resourceNetboxDeviceConsolePortRead,
+		Update: resourceNetboxDeviceConsolePortUpdate,
+		Delete: resourceNetboxDeviceConsolePortDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/consoleport/):
+
+> A console port provides connectivity to the physical console of a device. These are typically used for temporary access by someone who is physically near the device, or for remote out-of-band access provided via a networked console server.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(consolePortTypes, false),
+			},
+			"speed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(consolePortSpeeds),
+				Description:  "The speed of the port in bps.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Treat the port as if a cable is connected.",
+			},
+			adoptExistingKey: adoptExistingSchema,
+			tagsKey:          tagsSchema,
+			customFieldsKey:  customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceConsolePortCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/console-ports/")
+	if err != nil {
+		return err
+	}
+	if adopted {
+		return resourceNetboxDeviceConsolePortUpdate(d, m)
+	}
+
+	data := getWritableDeviceConsolePortFromResourceData(api, d)
+
+	params := dcim.NewDcimConsolePortsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimConsolePortsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceConsolePortRead(d, m)
+}
+
+func resourceNetboxDeviceConsolePortRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimConsolePortsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimConsolePortsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimConsolePortsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	port := res.GetPayload()
+
+	d.Set("device_id", port.Device.ID)
+	d.Set("name", port.Name)
+	if port.Type != nil {
+		d.Set("type", port.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+	if port.Speed != nil {
+		d.Set("speed", port.Speed.Value)
+	} else {
+		d.Set("speed", nil)
+	}
+	d.Set("label", port.Label)
+	d.Set("description", port.Description)
+	d.Set("mark_connected", port.MarkConnected)
+	d.Set(tagsKey, getManagedTagList(api, d, port.Tags))
+
+	cf := getManagedCustomFields(api, d, port.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDeviceConsolePortUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDeviceConsolePortFromResourceData(api, d)
+
+	params := dcim.NewDcimConsolePortsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimConsolePortsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/console-ports/%d/", id), map[string]string{
+		"type":           "type",
+		"speed":          "speed",
+		"label":          "label",
+		"description":    "description",
+		"mark_connected": "mark_connected",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceConsolePortRead(d, m)
+}
+
+func resourceNetboxDeviceConsolePortDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimConsolePortsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimConsolePortsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDeviceConsolePortFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableConsolePort {
+	data := models.WritableConsolePort{
+		Device:        int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:          strToPtr(d.Get("name").(string)),
+		Type:          d.Get("type").(string),
+		Label:         d.Get("label").(string),
+		Description:   d.Get("description").(string),
+		MarkConnected: d.Get("mark_connected").(bool),
+	}
+
+	if speed, ok := d.GetOk("speed"); ok {
+		data.Speed = int64ToPtr(int64(speed.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
+
+var consolePortTypes = []string{"de-9", "db-25", "rj-11", "rj-12", "rj-45", "mini-din-8", "usb-a", "usb-b", "usb-c", "usb-mini-a", "usb-mini-b", "usb-micro-a", "usb-micro-b", "usb-micro-ab", "other"}
+
+var consolePortSpeeds = []int{1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200}
@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxDeviceConsolePortFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}`, testName)
+}
+
+func TestAccNetboxDeviceConsolePort_basic(t *testing.T) {
+
+	testSlug := "device_console_port_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_console_port" "test" {
+  device_id      = netbox_device.test.id
+  name           = "%[1]s"
+  type           = "rj-45"
+  speed          = 9600
+  label          = "%[1]s"
+  description    = "%[1]s"
+  mark_connected = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_console_port.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "type", "rj-45"),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "speed", "9600"),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "mark_connected", "true"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_console_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "speed", "0"),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_device_console_port.test", "mark_connected", "false"),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_console_port.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_console_port", &resource.Sweeper{
+		Name:         "netbox_device_console_port",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimConsolePortsListParams()
+			res, err := api.Dcim.DcimConsolePortsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, port := range res.GetPayload().Results {
+				if strings.HasPrefix(*port.Name, testPrefix) {
+					deleteParams := dcim.NewDcimConsolePortsDeleteParams().WithID(port.ID)
+					_, err := api.Dcim.DcimConsolePortsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a console port")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceConsoleServerPort_basic(t *testing.T) {
+
+	testSlug := "device_console_server_port_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_console_server_port" "test" {
+  device_id   = netbox_device.test.id
+  name        = "%[1]s"
+  type        = "rj-45"
+  speed       = 115200
+  label       = "%[1]s"
+  description = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_console_server_port.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_console_server_port.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_console_server_port.test", "type", "rj-45"),
+					resource.TestCheckResourceAttr("netbox_device_console_server_port.test", "speed", "115200"),
+					resource.TestCheckResourceAttr("netbox_device_console_server_port.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_console_server_port.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_device_console_server_port.test", "mark_connected", "false"),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_console_server_port.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDeviceConsoleServerPort_cable(t *testing.T) {
+
+	testSlug := "device_console_server_port_cable"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_console_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s_console"
+}
+
+resource "netbox_device_console_server_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s_server"
+}
+
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.consoleport"
+    object_id   = netbox_device_console_port.test.id
+  }
+  b_termination {
+    object_type = "dcim.consoleserverport"
+    object_id   = netbox_device_console_server_port.test.id
+  }
+  label = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cable.test", "a_termination.#", "1"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "b_termination.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_console_server_port", &resource.Sweeper{
+		Name:         "netbox_device_console_server_port",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimConsoleServerPortsListParams()
+			res, err := api.Dcim.DcimConsoleServerPortsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, port := range res.GetPayload().Results {
+				if strings.HasPrefix(*port.Name, testPrefix) {
+					deleteParams := dcim.NewDcimConsoleServerPortsDeleteParams().WithID(port.ID)
+					_, err := api.Dcim.DcimConsoleServerPortsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a console server port")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,196 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDeviceFrontPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceFrontPortCreate,
+		Read:   resourceNetboxDeviceFrontPortRead,
+		Update: resourceNetboxDeviceFrontPortUpdate,
+		Delete: resourceNetboxDeviceFrontPortDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/frontport/):
+
+> Front ports are pass-through ports which represent physical cable connections that comprise part of a longer path. For example, the ports on the front face of a UTP patch panel would be modeled in NetBox as front ports. Each port is assigned a physical type, and must be mapped to a specific rear port on the same device. A single rear port may be mapped to multiple front ports, using numeric positions to annotate the specific alignment of each.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(passThroughPortTypes, false),
+				Description:  "The connector type of the port, e.g. `8p8c` or `lc`.",
+			},
+			"color_hex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rear_port_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rear_port_position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1024),
+				Description:  "The position of the rear port this front port is mapped to.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Treat the port as if a cable is connected.",
+			},
+			adoptExistingKey: adoptExistingSchema,
+			tagsKey:          tagsSchema,
+			customFieldsKey:  customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceFrontPortCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/front-ports/")
+	if err != nil {
+		return err
+	}
+	if adopted {
+		return resourceNetboxDeviceFrontPortUpdate(d, m)
+	}
+
+	data := getWritableDeviceFrontPortFromResourceData(api, d)
+
+	params := dcim.NewDcimFrontPortsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimFrontPortsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceFrontPortRead(d, m)
+}
+
+func resourceNetboxDeviceFrontPortRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimFrontPortsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimFrontPortsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimFrontPortsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	port := res.GetPayload()
+
+	d.Set("device_id", port.Device.ID)
+	d.Set("name", port.Name)
+	d.Set("type", port.Type.Value)
+	d.Set("color_hex", port.Color)
+	d.Set("rear_port_id", port.RearPort.ID)
+	d.Set("rear_port_position", port.RearPortPosition)
+	d.Set("label", port.Label)
+	d.Set("description", port.Description)
+	d.Set("mark_connected", port.MarkConnected)
+	d.Set(tagsKey, getManagedTagList(api, d, port.Tags))
+
+	cf := getManagedCustomFields(api, d, port.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDeviceFrontPortUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDeviceFrontPortFromResourceData(api, d)
+
+	params := dcim.NewDcimFrontPortsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimFrontPortsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/front-ports/%d/", id), map[string]string{
+		"color_hex":      "color",
+		"label":          "label",
+		"description":    "description",
+		"mark_connected": "mark_connected",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceFrontPortRead(d, m)
+}
+
+func resourceNetboxDeviceFrontPortDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimFrontPortsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimFrontPortsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDeviceFrontPortFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableFrontPort {
+	data := models.WritableFrontPort{
+		Device:           int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:             strToPtr(d.Get("name").(string)),
+		Type:             strToPtr(d.Get("type").(string)),
+		RearPort:         int64ToPtr(int64(d.Get("rear_port_id").(int))),
+		RearPortPosition: int64(d.Get("rear_port_position").(int)),
+		Color:            d.Get("color_hex").(string),
+		Label:            d.Get("label").(string),
+		Description:      d.Get("description").(string),
+		MarkConnected:    d.Get("mark_connected").(bool),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
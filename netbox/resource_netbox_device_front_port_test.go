@@ -0,0 +1,123 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxDeviceFrontPortFullDependencies(testName string) string {
+	return testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_rear_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s_rear"
+  type      = "mpo"
+  positions = 2
+}
+
+resource "netbox_device_interface" "test" {
+  name      = "%[1]s"
+  device_id = netbox_device.test.id
+  type      = "10gbase-x-sfpp"
+}`, testName)
+}
+
+func TestAccNetboxDeviceFrontPort_basic(t *testing.T) {
+
+	testSlug := "device_front_port_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceFrontPortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_front_port" "test" {
+  device_id          = netbox_device.test.id
+  name               = "%[1]s"
+  type               = "lc"
+  rear_port_id       = netbox_device_rear_port.test.id
+  rear_port_position = 2
+  label              = "%[1]s"
+  description        = "%[1]s"
+}
+
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.frontport"
+    object_id   = netbox_device_front_port.test.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id   = netbox_device_interface.test.id
+  }
+  label = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_front_port.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_front_port.test", "rear_port_id", "netbox_device_rear_port.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "type", "lc"),
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "rear_port_position", "2"),
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "description", testName),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceFrontPortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_front_port" "test" {
+  device_id    = netbox_device.test.id
+  name         = "%[1]s"
+  type         = "lc"
+  rear_port_id = netbox_device_rear_port.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "rear_port_position", "1"),
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_device_front_port.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_front_port.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_front_port", &resource.Sweeper{
+		Name:         "netbox_device_front_port",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimFrontPortsListParams()
+			res, err := api.Dcim.DcimFrontPortsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, port := range res.GetPayload().Results {
+				if strings.HasPrefix(*port.Name, testPrefix) {
+					deleteParams := dcim.NewDcimFrontPortsDeleteParams().WithID(port.ID)
+					_, err := api.Dcim.DcimFrontPortsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a front port")
+				}
+			}
+			return nil
+		},
+	})
+}
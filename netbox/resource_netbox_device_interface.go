@@ -2,9 +2,10 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,10 +21,15 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 		ReadContext:   resourceNetboxDeviceInterfaceRead,
 		UpdateContext: resourceNetboxDeviceInterfaceUpdate,
 		DeleteContext: resourceNetboxDeviceInterfaceDelete,
+		CustomizeDiff: resourceNetboxDeviceInterfaceCustomizeDiff,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device/#interface):
 
-> Interfaces in NetBox represent network interfaces used to exchange data with connected devices. On modern networks, these are most commonly Ethernet, but other types are supported as well. IP addresses and VLANs can be assigned to interfaces.`,
+> Interfaces in NetBox represent network interfaces used to exchange data with connected devices. On modern networks, these are most commonly Ethernet, but other types are supported as well. IP addresses and VLANs can be assigned to interfaces.
+
+Interfaces can be imported by their ID or by ` + "`<device_id>:<name>`" + `.
+
+The LAG, parent and bridge interfaces are validated during plan. They have to belong to the same device or to another member of its virtual chassis, and the LAG interface has to be of type ` + "`lag`" + `.`,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -46,16 +52,28 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.IsMACAddress,
+				StateFunc:    normalizeMACAddress,
 				ForceNew:     true,
 			},
 			"mgmtonly": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"primary_mac_address_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the primary MAC address of the interface. Only available on Netbox 4.2 and later. Use the `is_primary` attribute of `netbox_mac_address` to set it.",
+			},
+			"vlan_translation_policy_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the VLAN translation policy of the interface. Requires Netbox 4.2 or later.",
+			},
 			"mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(validModes, false),
+				Description:  "The 802.1Q mode of the interface. One of `access`, `tagged` or `tagged-all`.",
 			},
 			"mtu": {
 				Type:         schema.TypeInt,
@@ -66,27 +84,73 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 			"tagged_vlans": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				Description: "The IDs of the tagged VLANs. Only valid if `mode` is `tagged`.",
 			},
 			"untagged_vlan": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the untagged VLAN. Requires `mode` to be set.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"speed": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The speed of the interface in Kbps.",
+			},
+			"duplex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"half", "full", "auto"}, false),
+				Description:  "One of `half`, `full` or `auto`.",
+			},
+			"vrf_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"lag_device_interface_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the LAG interface this interface is a member of. The LAG interface has to belong to the same device or to another member of its virtual chassis.",
+			},
+			"parent_device_interface_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent interface, e.g. the physical interface of a subinterface. The parent interface has to belong to the same device or to another member of its virtual chassis.",
+			},
+			"bridge_device_interface_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the bridge interface this interface belongs to. The bridge interface has to belong to the same device or to another member of its virtual chassis.",
+			},
+			adoptExistingKey: adoptExistingSchema,
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNetboxDeviceInterfaceImport,
 		},
 	}
 }
 
 func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/interfaces/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if adopted {
+		return resourceNetboxDeviceInterfaceUpdate(ctx, d, m)
+	}
 
 	var diags diag.Diagnostics
 
@@ -114,6 +178,7 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
 		WirelessLans: []int64{},
+		Label:        d.Get("label").(string),
 	}
 	if macAddress := d.Get("mac_address").(string); macAddress != "" {
 		data.MacAddress = &macAddress
@@ -124,6 +189,26 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 	if untaggedVlan, ok := d.Get("untagged_vlan").(int); ok && untaggedVlan != 0 {
 		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan))
 	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	if speed, ok := d.GetOk("speed"); ok {
+		data.Speed = int64ToPtr(int64(speed.(int)))
+	}
+	if duplex, ok := d.GetOk("duplex"); ok {
+		data.Duplex = strToPtr(duplex.(string))
+	}
+	if vrfID, ok := d.GetOk("vrf_id"); ok {
+		data.Vrf = int64ToPtr(int64(vrfID.(int)))
+	}
+	if lagID, ok := d.GetOk("lag_device_interface_id"); ok {
+		data.Lag = int64ToPtr(int64(lagID.(int)))
+	}
+	if parentID, ok := d.GetOk("parent_device_interface_id"); ok {
+		data.Parent = int64ToPtr(int64(parentID.(int)))
+	}
+	if bridgeID, ok := d.GetOk("bridge_device_interface_id"); ok {
+		data.Bridge = int64ToPtr(int64(bridgeID.(int)))
+	}
 
 	params := dcim.NewDcimInterfacesCreateParams().WithData(&data)
 
@@ -134,11 +219,17 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return diags
+	// The VLAN translation policy is not part of the generated client
+	err = setInterfaceVlanTranslationPolicy(api, d, fmt.Sprintf("/dcim/interfaces/%d/", res.GetPayload().ID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(diags, resourceNetboxDeviceInterfaceRead(ctx, d, m)...)
 }
 
 func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	var diags diag.Diagnostics
@@ -165,22 +256,70 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("mgmtonly", iface.MgmtOnly)
 	d.Set("mac_address", iface.MacAddress)
 	d.Set("mtu", iface.Mtu)
-	d.Set(tagsKey, getTagListFromNestedTagList(iface.Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, iface.Tags))
+
+	cf := getManagedCustomFields(api, d, iface.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	d.Set("tagged_vlans", getIDsFromNestedVLANDevice(iface.TaggedVlans))
 	d.Set("device_id", iface.Device.ID)
 
 	if iface.Mode != nil {
 		d.Set("mode", iface.Mode.Value)
+	} else {
+		d.Set("mode", nil)
 	}
 	if iface.UntaggedVlan != nil {
 		d.Set("untagged_vlan", iface.UntaggedVlan.ID)
+	} else {
+		d.Set("untagged_vlan", nil)
+	}
+
+	d.Set("label", iface.Label)
+	d.Set("speed", iface.Speed)
+	if iface.Duplex != nil {
+		d.Set("duplex", iface.Duplex.Value)
+	} else {
+		d.Set("duplex", nil)
+	}
+	if iface.Vrf != nil {
+		d.Set("vrf_id", iface.Vrf.ID)
+	} else {
+		d.Set("vrf_id", nil)
+	}
+	if iface.Lag != nil {
+		d.Set("lag_device_interface_id", iface.Lag.ID)
+	} else {
+		d.Set("lag_device_interface_id", nil)
+	}
+	if iface.Parent != nil {
+		d.Set("parent_device_interface_id", iface.Parent.ID)
+	} else {
+		d.Set("parent_device_interface_id", nil)
+	}
+	if iface.Bridge != nil {
+		d.Set("bridge_device_interface_id", iface.Bridge.ID)
+	} else {
+		d.Set("bridge_device_interface_id", nil)
+	}
+
+	// The primary MAC address is not part of the generated client
+	err = readPrimaryMACAddressID(api, d, fmt.Sprintf("/dcim/interfaces/%d/", id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = readInterfaceVlanTranslationPolicy(api, d, fmt.Sprintf("/dcim/interfaces/%d/", id))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
 }
 
 func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -210,6 +349,7 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
 		WirelessLans: []int64{},
+		Label:        d.Get("label").(string),
 	}
 
 	if d.HasChange("mac_address") {
@@ -220,10 +360,29 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		mtu := int64(d.Get("mtu").(int))
 		data.Mtu = &mtu
 	}
-	if d.HasChange("untagged_vlan") {
-		untaggedvlan := int64(d.Get("untagged_vlan").(int))
-		data.UntaggedVlan = &untaggedvlan
+	if untaggedVlan, ok := d.GetOk("untagged_vlan"); ok {
+		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan.(int)))
+	}
+
+	if speed, ok := d.GetOk("speed"); ok {
+		data.Speed = int64ToPtr(int64(speed.(int)))
+	}
+	if duplex, ok := d.GetOk("duplex"); ok {
+		data.Duplex = strToPtr(duplex.(string))
+	}
+	if vrfID, ok := d.GetOk("vrf_id"); ok {
+		data.Vrf = int64ToPtr(int64(vrfID.(int)))
+	}
+	if lagID, ok := d.GetOk("lag_device_interface_id"); ok {
+		data.Lag = int64ToPtr(int64(lagID.(int)))
 	}
+	if parentID, ok := d.GetOk("parent_device_interface_id"); ok {
+		data.Parent = int64ToPtr(int64(parentID.(int)))
+	}
+	if bridgeID, ok := d.GetOk("bridge_device_interface_id"); ok {
+		data.Bridge = int64ToPtr(int64(bridgeID.(int)))
+	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := dcim.NewDcimInterfacesPartialUpdateParams().WithID(id).WithData(&data)
 	_, err := api.Dcim.DcimInterfacesPartialUpdate(params, nil)
@@ -231,11 +390,31 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	return diags
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/interfaces/%d/", id), map[string]string{
+		"mode":                       "mode",
+		"untagged_vlan":              "untagged_vlan",
+		"label":                      "label",
+		"speed":                      "speed",
+		"duplex":                     "duplex",
+		"vrf_id":                     "vrf",
+		"lag_device_interface_id":    "lag",
+		"parent_device_interface_id": "parent",
+		"bridge_device_interface_id": "bridge",
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setInterfaceVlanTranslationPolicy(api, d, fmt.Sprintf("/dcim/interfaces/%d/", id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(diags, resourceNetboxDeviceInterfaceRead(ctx, d, m)...)
 }
 
 func resourceNetboxDeviceInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimInterfacesDeleteParams().WithID(id)
@@ -247,6 +426,122 @@ func resourceNetboxDeviceInterfaceDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
+// deviceInterfaceRelations maps the attributes that reference other interfaces to the name used in error messages.
+var deviceInterfaceRelations = []struct {
+	attribute string
+	name      string
+}{
+	{"lag_device_interface_id", "LAG"},
+	{"parent_device_interface_id", "parent"},
+	{"bridge_device_interface_id", "bridge"},
+}
+
+// resourceNetboxDeviceInterfaceCustomizeDiff validates the 802.1Q mode and the LAG, parent and bridge interfaces during plan, so that an
+// interface of another device is reported before any change is applied. Interfaces that are not known yet, e.g.
+// because they are created in the same apply, are validated by Netbox instead.
+func resourceNetboxDeviceInterfaceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	err := validateInterfaceMode(d)
+	if err != nil {
+		return err
+	}
+
+	err = validateInterfaceVlanTranslationPolicy(d, m)
+	if err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("device_id") {
+		return nil
+	}
+	deviceID := int64(d.Get("device_id").(int))
+
+	for _, relation := range deviceInterfaceRelations {
+		if !d.NewValueKnown(relation.attribute) || (!d.HasChange(relation.attribute) && !d.HasChange("device_id")) {
+			continue
+		}
+		relatedID := int64(d.Get(relation.attribute).(int))
+		if relatedID == 0 {
+			continue
+		}
+		if d.Id() == strconv.FormatInt(relatedID, 10) {
+			return fmt.Errorf("%s: an interface cannot be its own %s interface", relation.attribute, relation.name)
+		}
+		err := validateDeviceInterfaceRelation(m.(*providerState), deviceID, relatedID, relation.name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", relation.attribute, err)
+		}
+	}
+	return nil
+}
+
+// validateDeviceInterfaceRelation checks that the related interface belongs to the given device or to another member
+// of its virtual chassis. LAG interfaces also have to be of type lag.
+func validateDeviceInterfaceRelation(api *providerState, deviceID int64, relatedID int64, relationName string) error {
+	related, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/interfaces/%d/", relatedID), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			return fmt.Errorf("%s interface %d does not exist", relationName, relatedID)
+		}
+		return err
+	}
+
+	if relationName == "LAG" {
+		interfaceType, _ := related["type"].(map[string]interface{})
+		if interfaceType["value"] != "lag" {
+			return fmt.Errorf("%s interface %d is not of type lag", relationName, relatedID)
+		}
+	}
+
+	relatedDeviceID, ok := getGenericNestedObjectID(related, "device")
+	if !ok {
+		return fmt.Errorf("%s interface %d does not belong to a device", relationName, relatedID)
+	}
+	if relatedDeviceID == deviceID {
+		return nil
+	}
+
+	virtualChassisIDs := make([]int64, 0, 2)
+	for _, id := range []int64{deviceID, relatedDeviceID} {
+		device, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/devices/%d/", id), nil)
+		if err != nil {
+			return err
+		}
+		virtualChassisID, _ := getGenericNestedObjectID(device, "virtual_chassis")
+		virtualChassisIDs = append(virtualChassisIDs, virtualChassisID)
+	}
+	if virtualChassisIDs[0] == 0 || virtualChassisIDs[0] != virtualChassisIDs[1] {
+		return fmt.Errorf("%s interface %d belongs to device %d, which is neither device %d nor a member of its virtual chassis", relationName, relatedID, relatedDeviceID, deviceID)
+	}
+	return nil
+}
+
+// resourceNetboxDeviceInterfaceImport allows importing interfaces by ID or by <device_id>:<name>.
+func resourceNetboxDeviceInterfaceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	api := m.(*providerState)
+
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	params := dcim.NewDcimInterfacesListParams()
+	params.DeviceID = &parts[0]
+	params.Name = &parts[1]
+
+	res, err := api.Dcim.DcimInterfacesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if *res.GetPayload().Count != int64(1) {
+		return nil, fmt.Errorf("expected exactly one interface named %s on device %s, found %d", parts[1], parts[0], *res.GetPayload().Count)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().Results[0].ID, 10))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func getIDsFromNestedVLANDevice(nestedvlans []*models.NestedVLAN) []int64 {
 	var vlans []int64
 	for _, vlan := range nestedvlans {
@@ -0,0 +1,198 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDeviceInterfaceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceInterfaceTemplateCreate,
+		Read:   resourceNetboxDeviceInterfaceTemplateRead,
+		Update: resourceNetboxDeviceInterfaceTemplateUpdate,
+		Delete: resourceNetboxDeviceInterfaceTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/interfacetemplate/):
+
+> A template for a network interface that will be created on all instantiations of the parent device type or module type.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instantiated component. On module types, `{module}` is replaced with the position of the module bay.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mgmt_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"poe_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pd", "pse"}, false),
+				Description:  "One of `pd` or `pse`.",
+			},
+			"poe_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceInterfaceTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableDeviceInterfaceTemplateFromResourceData(d)
+
+	params := dcim.NewDcimInterfaceTemplatesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimInterfaceTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceInterfaceTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceInterfaceTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInterfaceTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimInterfaceTemplatesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimInterfaceTemplatesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+
+	if template.DeviceType != nil {
+		d.Set("device_type_id", template.DeviceType.ID)
+	} else {
+		d.Set("device_type_id", nil)
+	}
+	if template.ModuleType != nil {
+		d.Set("module_type_id", template.ModuleType.ID)
+	} else {
+		d.Set("module_type_id", nil)
+	}
+	d.Set("name", template.Name)
+	d.Set("type", template.Type.Value)
+	d.Set("mgmt_only", template.MgmtOnly)
+	if template.PoeMode != nil {
+		d.Set("poe_mode", template.PoeMode.Value)
+	} else {
+		d.Set("poe_mode", nil)
+	}
+	if template.PoeType != nil {
+		d.Set("poe_type", template.PoeType.Value)
+	} else {
+		d.Set("poe_type", nil)
+	}
+	d.Set("label", template.Label)
+	d.Set("description", template.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceInterfaceTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDeviceInterfaceTemplateFromResourceData(d)
+
+	params := dcim.NewDcimInterfaceTemplatesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimInterfaceTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/interface-templates/%d/", id), map[string]string{
+		"mgmt_only":   "mgmt_only",
+		"poe_mode":    "poe_mode",
+		"poe_type":    "poe_type",
+		"label":       "label",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceInterfaceTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceInterfaceTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInterfaceTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimInterfaceTemplatesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDeviceInterfaceTemplateFromResourceData(d *schema.ResourceData) *models.WritableInterfaceTemplate {
+	data := models.WritableInterfaceTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Type:        strToPtr(d.Get("type").(string)),
+		MgmtOnly:    d.Get("mgmt_only").(bool),
+		PoeMode:     d.Get("poe_mode").(string),
+		PoeType:     d.Get("poe_type").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if deviceTypeID, ok := d.GetOk("device_type_id"); ok {
+		data.DeviceType = int64ToPtr(int64(deviceTypeID.(int)))
+	}
+	if moduleTypeID, ok := d.GetOk("module_type_id"); ok {
+		data.ModuleType = int64ToPtr(int64(moduleTypeID.(int)))
+	}
+
+	return &data
+}
@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxComponentTemplateFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  subdevice_role = "parent"
+}
+
+resource "netbox_module_type" "test" {
+  manufacturer_id = netbox_manufacturer.test.id
+  model = "%[1]s"
+}`, testName)
+}
+
+func TestAccNetboxDeviceInterfaceTemplate_basic(t *testing.T) {
+
+	testSlug := "device_interface_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_interface_template" "test" {
+  module_type_id = netbox_module_type.test.id
+  name           = "%[1]s_{module}"
+  type           = "1000base-t"
+  mgmt_only      = true
+  poe_mode       = "pse"
+  poe_type       = "type2-ieee802.3at"
+  label          = "%[1]s"
+  description    = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "name", testName+"_{module}"),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "type", "1000base-t"),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "mgmt_only", "true"),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "poe_mode", "pse"),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "poe_type", "type2-ieee802.3at"),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "description", testName),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_interface_template" "test" {
+  module_type_id = netbox_module_type.test.id
+  name           = "%[1]s_{module}"
+  type           = "1000base-t"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "mgmt_only", "false"),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "poe_mode", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "poe_type", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface_template.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_interface_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
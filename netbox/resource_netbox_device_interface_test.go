@@ -2,6 +2,8 @@ package netbox
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxDeviceInterfaceFullDependencies(testName string) string {
@@ -105,7 +108,7 @@ resource "netbox_device_interface" "test2" {
 resource "netbox_device_interface" "test3" {
   name = "%[1]s_3"
   mode = "tagged-all"
-  tagged_vlans = [netbox_vlan.test1.id, netbox_vlan.test2.id]
+  untagged_vlan = netbox_vlan.test1.id
   device_id = netbox_device.test.id
   type = "1000base-t"
 }`, testName)
@@ -131,9 +134,10 @@ func TestAccNetboxDeviceInterface_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "netbox_device_interface.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_device_interface.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
 			},
 		},
 	})
@@ -163,9 +167,15 @@ func TestAccNetboxDeviceInterface_opts(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "netbox_device_interface.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				// The same MAC address in another format must not cause a diff
+				Config:   setUp + testAccNetboxDeviceInterface_opts(testName, "00-01-02-03-04-05"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "netbox_device_interface.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
 			},
 		},
 	})
@@ -189,23 +199,211 @@ func TestAccNetboxDeviceInterface_vlans(t *testing.T) {
 					resource.TestCheckResourceAttrPair("netbox_device_interface.test1", "untagged_vlan", "netbox_vlan.test1", "id"),
 					resource.TestCheckResourceAttrPair("netbox_device_interface.test2", "untagged_vlan", "netbox_vlan.test1", "id"),
 					resource.TestCheckResourceAttrPair("netbox_device_interface.test2", "tagged_vlans.0", "netbox_vlan.test2", "id"),
-					resource.TestCheckResourceAttr("netbox_device_interface.test3", "tagged_vlans.#", "2"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.test3", "untagged_vlan", "netbox_vlan.test1", "id"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test3", "tagged_vlans.#", "0"),
 				),
 			},
 			{
-				ResourceName:      "netbox_device_interface.test1",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_device_interface.test1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+			{
+				ResourceName:            "netbox_device_interface.test2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+			{
+				ResourceName:            "netbox_device_interface.test3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDeviceInterface_relationships(t *testing.T) {
+	testSlug := "iface_rel"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxDeviceInterfaceFullDependencies(testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_interface" "lag" {
+  name      = "%[1]s_lag"
+  device_id = netbox_device.test.id
+  type      = "lag"
+}
+
+resource "netbox_device_interface" "member" {
+  name                    = "%[1]s_member"
+  device_id               = netbox_device.test.id
+  type                    = "10gbase-x-sfpp"
+  label                   = "uplink"
+  speed                   = 10000000
+  duplex                  = "full"
+  lag_device_interface_id = netbox_device_interface.lag.id
+}
+
+resource "netbox_device_interface" "sub" {
+  name                       = "%[1]s_lag.100"
+  device_id                  = netbox_device.test.id
+  type                       = "virtual"
+  parent_device_interface_id = netbox_device_interface.lag.id
+  vrf_id                     = netbox_vrf.test.id
+}
+
+resource "netbox_device_interface" "bridge" {
+  name      = "%[1]s_br0"
+  device_id = netbox_device.test.id
+  type      = "bridge"
+}
+
+resource "netbox_device_interface" "bridged" {
+  name                       = "%[1]s_bridged"
+  device_id                  = netbox_device.test.id
+  type                       = "virtual"
+  bridge_device_interface_id = netbox_device_interface.bridge.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_interface.member", "lag_device_interface_id", "netbox_device_interface.lag", "id"),
+					resource.TestCheckResourceAttr("netbox_device_interface.member", "label", "uplink"),
+					resource.TestCheckResourceAttr("netbox_device_interface.member", "speed", "10000000"),
+					resource.TestCheckResourceAttr("netbox_device_interface.member", "duplex", "full"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.sub", "parent_device_interface_id", "netbox_device_interface.lag", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.sub", "vrf_id", "netbox_vrf.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.bridged", "bridge_device_interface_id", "netbox_device_interface.bridge", "id"),
+				),
 			},
 			{
-				ResourceName:      "netbox_device_interface.test2",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_device_interface.member",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["netbox_device_interface.member"]
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["device_id"], rs.Primary.Attributes["name"]), nil
+				},
 			},
+		},
+	})
+}
+
+func TestValidateDeviceInterfaceRelation(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/dcim/interfaces/1/":
+			w.Write([]byte(`{"id": 1, "type": {"value": "lag"}, "device": {"id": 10}}`))
+		case "/api/dcim/interfaces/2/":
+			w.Write([]byte(`{"id": 2, "type": {"value": "lag"}, "device": {"id": 11}}`))
+		case "/api/dcim/interfaces/3/":
+			w.Write([]byte(`{"id": 3, "type": {"value": "lag"}, "device": {"id": 12}}`))
+		case "/api/dcim/interfaces/4/":
+			w.Write([]byte(`{"id": 4, "type": {"value": "1000base-t"}, "device": {"id": 10}}`))
+		case "/api/dcim/devices/10/", "/api/dcim/devices/11/":
+			w.Write([]byte(`{"id": 10, "virtual_chassis": {"id": 100}}`))
+		case "/api/dcim/devices/12/":
+			w.Write([]byte(`{"id": 12, "virtual_chassis": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	// Same device
+	assert.NoError(t, validateDeviceInterfaceRelation(api, 10, 1, "LAG"))
+	// Other member of the same virtual chassis
+	assert.NoError(t, validateDeviceInterfaceRelation(api, 10, 2, "LAG"))
+	// Device without virtual chassis
+	assert.Error(t, validateDeviceInterfaceRelation(api, 10, 3, "LAG"))
+	// Interface that is not a LAG
+	assert.Error(t, validateDeviceInterfaceRelation(api, 10, 4, "LAG"))
+	assert.NoError(t, validateDeviceInterfaceRelation(api, 10, 4, "parent"))
+	// Missing interface
+	assert.Error(t, validateDeviceInterfaceRelation(api, 10, 5, "bridge"))
+}
+
+func TestAccNetboxDeviceInterface_adoptExisting(t *testing.T) {
+	testSlug := "iface_adopt"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceInterfaceDestroy,
+		Steps: []resource.TestStep{
 			{
-				ResourceName:      "netbox_device_interface.test3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_interface_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name = "eth0"
+  type = "1000base-t"
+  label = "%[1]s"
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+
+  depends_on = [netbox_device_interface_template.test]
+}
+
+resource "netbox_device_interface" "test" {
+  device_id = netbox_device.test.id
+  name = "eth0"
+  type = "10gbase-t"
+  description = "%[1]s"
+  adopt_existing = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "name", "eth0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "type", "10gbase-t"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "label", ""),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.test", "device_id", "netbox_device.test", "id"),
+				),
 			},
 		},
 	})
@@ -213,7 +411,7 @@ func TestAccNetboxDeviceInterface_vlans(t *testing.T) {
 
 func testAccCheckDeviceInterfaceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each interface
 	// is destroyed
@@ -0,0 +1,494 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// maxInterfaceNamePatternNames limits the number of interfaces a single name pattern can expand to.
+const maxInterfaceNamePatternNames = 1024
+
+// deviceInterfacesLookupChunkSize is the number of interface names that are looked up in a single request,
+// to keep the length of the request URL in check.
+const deviceInterfacesLookupChunkSize = 100
+
+var interfaceNamePatternRangeRegexp = regexp.MustCompile(`^(\d+)\.\.(\d+)$`)
+
+// bulkInterfacesEndpoint describes the interfaces that a bulk interfaces resource manages.
+type bulkInterfacesEndpoint struct {
+	// path is the list endpoint of the interfaces.
+	path string
+	// parentField is the field of an interface that references its device or virtual machine.
+	parentField string
+	// parentAttribute is the attribute of the resource with the ID of the device or virtual machine.
+	parentAttribute string
+}
+
+var deviceInterfacesEndpoint = bulkInterfacesEndpoint{
+	path:            "/dcim/interfaces/",
+	parentField:     "device",
+	parentAttribute: "device_id",
+}
+
+func resourceNetboxDeviceInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceInterfacesCreate,
+		Read:   resourceNetboxDeviceInterfacesRead,
+		Update: resourceNetboxDeviceInterfacesUpdate,
+		Delete: resourceNetboxDeviceInterfacesDelete,
+
+		CustomizeDiff: customizeDiffBulkInterfaces,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This resource manages a set of interfaces of a device that share the same attributes, e.g. the front ports of a switch. The interfaces are created, updated and deleted with bulk API requests, which is considerably faster than managing each interface with a separate ` + "`netbox_device_interface`" + ` resource.
+
+The names of the interfaces are given as a pattern. Ranges such as ` + "`{1..48}`" + ` (zero-padded if the start is, e.g. ` + "`{01..48}`" + `) and lists such as ` + "`{a,b}`" + ` are expanded, e.g. ` + "`Ethernet{1..2}/{1..24}`" + ` yields ` + "`Ethernet1/1`" + ` to ` + "`Ethernet2/24`" + `. Existing interfaces of the device with matching names, e.g. interfaces that Netbox created from the templates of the device type, are adopted.
+
+This resource can be imported by ` + "`<device_id>:<name_pattern>`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateInterfaceNamePattern,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mgmtonly": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65536),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+			"interface_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "A map of the interface names to their IDs.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetboxDeviceInterfacesImport,
+		},
+	}
+}
+
+func resourceNetboxDeviceInterfacesCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d:%s", d.Get("device_id").(int), d.Get("name_pattern").(string)))
+
+	err = syncBulkInterfaces(api, d, deviceInterfacesEndpoint, getDeviceInterfacesRequestData(api, d), names, true)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceInterfacesRead(d, m)
+}
+
+func resourceNetboxDeviceInterfacesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	interfaces, err := lookupBulkInterfaces(api, deviceInterfacesEndpoint, int64(d.Get("device_id").(int)), names)
+	if err != nil {
+		return err
+	}
+	if len(interfaces) == 0 {
+		// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+		d.SetId("")
+		return nil
+	}
+
+	interfaceIDs := map[string]interface{}{}
+	for name, iface := range interfaces {
+		id, _ := getGenericObjectID(iface)
+		interfaceIDs[name] = id
+	}
+	d.Set("interface_ids", interfaceIDs)
+
+	// The attributes are shared by all interfaces. If any interface deviates from the configuration, its value is
+	// set instead, so that the deviation shows up as a diff and all interfaces are updated.
+	configured := map[string]interface{}{
+		"type":        d.Get("type").(string),
+		"enabled":     d.Get("enabled").(bool),
+		"mgmtonly":    d.Get("mgmtonly").(bool),
+		"mtu":         d.Get("mtu").(int),
+		"description": d.Get("description").(string),
+	}
+	attributes := map[string]interface{}{}
+	for attribute, value := range configured {
+		attributes[attribute] = value
+	}
+	for _, name := range sortedInterfaceNames(interfaces) {
+		for attribute, value := range getDeviceInterfacesAttributes(interfaces[name]) {
+			if configured[attribute] != value {
+				attributes[attribute] = value
+			}
+		}
+	}
+	for attribute, value := range attributes {
+		d.Set(attribute, value)
+	}
+
+	first := interfaces[sortedInterfaceNames(interfaces)[0]]
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(first)))
+
+	return nil
+}
+
+func resourceNetboxDeviceInterfacesUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	err = syncBulkInterfaces(api, d, deviceInterfacesEndpoint, getDeviceInterfacesRequestData(api, d), names, d.HasChanges("type", "enabled", "mgmtonly", "mtu", "description", tagsKey))
+	if err != nil {
+		return err
+	}
+
+	// The ID contains the name pattern, which can be changed in place
+	d.SetId(fmt.Sprintf("%d:%s", d.Get("device_id").(int), d.Get("name_pattern").(string)))
+
+	return resourceNetboxDeviceInterfacesRead(d, m)
+}
+
+func resourceNetboxDeviceInterfacesDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	interfaces, err := lookupBulkInterfaces(api, deviceInterfacesEndpoint, int64(d.Get("device_id").(int)), names)
+	if err != nil {
+		return err
+	}
+
+	return deleteBulkInterfaces(api, deviceInterfacesEndpoint, interfaces)
+}
+
+// customizeDiffBulkInterfaces marks the interface IDs of a bulk interfaces resource as changing if the name pattern
+// changed or interfaces are missing.
+func customizeDiffBulkInterfaces(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("name_pattern") {
+		return nil
+	}
+
+	// Interfaces that were deleted out of band are missing in the state and have to be recreated
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+	interfaceIDs := d.Get("interface_ids").(map[string]interface{})
+	if d.HasChange("name_pattern") || len(interfaceIDs) != len(names) {
+		return d.SetNewComputed("interface_ids")
+	}
+	for _, name := range names {
+		if _, ok := interfaceIDs[name]; !ok {
+			return d.SetNewComputed("interface_ids")
+		}
+	}
+	return nil
+}
+
+func resourceNetboxDeviceInterfacesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <device_id>:<name_pattern>", d.Id())
+	}
+	deviceID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%s), device_id must be a number", d.Id())
+	}
+
+	d.Set("device_id", deviceID)
+	d.Set("name_pattern", parts[1])
+	d.Set("enabled", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncBulkInterfaces makes the interfaces of the device or virtual machine match the given names. Missing interfaces
+// are created with the given attributes and interfaces that no longer match the name pattern are deleted. If
+// updateExisting is true, the attributes of the remaining interfaces are updated as well.
+func syncBulkInterfaces(api *providerState, d *schema.ResourceData, endpoint bulkInterfacesEndpoint, attributes map[string]interface{}, names []string, updateExisting bool) error {
+	parentID := int64(d.Get(endpoint.parentAttribute).(int))
+
+	oldPattern, _ := d.GetChange("name_pattern")
+	lookupNames := names
+	if oldPattern.(string) != "" {
+		oldNames, err := expandInterfaceNamePattern(oldPattern.(string))
+		if err != nil {
+			return err
+		}
+		lookupNames = append(append([]string{}, names...), oldNames...)
+	}
+
+	existing, err := lookupBulkInterfaces(api, endpoint, parentID, lookupNames)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	removed := map[string]map[string]interface{}{}
+	for name, iface := range existing {
+		if !wanted[name] {
+			removed[name] = iface
+		}
+	}
+	err = deleteBulkInterfaces(api, endpoint, removed)
+	if err != nil {
+		return err
+	}
+
+	created := []map[string]interface{}{}
+	updated := []map[string]interface{}{}
+	for _, name := range names {
+		data := map[string]interface{}{}
+		for key, value := range attributes {
+			data[key] = value
+		}
+
+		if iface, ok := existing[name]; ok {
+			if !updateExisting {
+				continue
+			}
+			data["id"], _ = getGenericObjectID(iface)
+			updated = append(updated, data)
+		} else {
+			data[endpoint.parentField] = parentID
+			data["name"] = name
+			created = append(created, data)
+		}
+	}
+
+	if len(created) > 0 {
+		_, err = genericAPIRawRequest(api, "POST", endpoint.path, nil, created)
+		if err != nil {
+			return err
+		}
+	}
+	if len(updated) > 0 {
+		_, err = genericAPIRawRequest(api, "PATCH", endpoint.path, nil, updated)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDeviceInterfacesRequestData returns the attributes that are shared by all interfaces of the resource.
+func getDeviceInterfacesRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"type":        d.Get("type").(string),
+		"enabled":     d.Get("enabled").(bool),
+		"mgmt_only":   d.Get("mgmtonly").(bool),
+		"mtu":         nil,
+		"description": d.Get("description").(string),
+	}
+	if mtu, ok := d.GetOk("mtu"); ok {
+		data["mtu"] = mtu.(int)
+	}
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	return data
+}
+
+// getDeviceInterfacesAttributes returns the shared attributes of an interface decoded by genericAPIRequest.
+func getDeviceInterfacesAttributes(iface map[string]interface{}) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"type":        "",
+		"enabled":     iface["enabled"] == true,
+		"mgmtonly":    iface["mgmt_only"] == true,
+		"mtu":         0,
+		"description": "",
+	}
+	if interfaceType, ok := iface["type"].(map[string]interface{}); ok {
+		attributes["type"], _ = interfaceType["value"].(string)
+	}
+	if mtu, ok := iface["mtu"].(json.Number); ok {
+		mtuValue, _ := mtu.Int64()
+		attributes["mtu"] = int(mtuValue)
+	}
+	if description, ok := iface["description"].(string); ok {
+		attributes["description"] = description
+	}
+	return attributes
+}
+
+// lookupBulkInterfaces returns the interfaces of the device or virtual machine with the given names, keyed by name.
+func lookupBulkInterfaces(api *providerState, endpoint bulkInterfacesEndpoint, parentID int64, names []string) (map[string]map[string]interface{}, error) {
+	interfaces := map[string]map[string]interface{}{}
+	for start := 0; start < len(names); start += deviceInterfacesLookupChunkSize {
+		end := start + deviceInterfacesLookupChunkSize
+		if end > len(names) {
+			end = len(names)
+		}
+
+		query := url.Values{
+			endpoint.parentField + "_id": []string{strconv.FormatInt(parentID, 10)},
+			"name":                       names[start:end],
+			"limit":                      []string{strconv.Itoa(end - start)},
+		}
+		res, err := genericAPIRequestWithQuery(api, "GET", endpoint.path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		results, _ := res["results"].([]interface{})
+		for _, result := range results {
+			iface, ok := result.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, ok := iface["name"].(string); ok {
+				interfaces[name] = iface
+			}
+		}
+	}
+	return interfaces, nil
+}
+
+// deleteBulkInterfaces deletes the given interfaces with a single bulk request.
+func deleteBulkInterfaces(api *providerState, endpoint bulkInterfacesEndpoint, interfaces map[string]map[string]interface{}) error {
+	if len(interfaces) == 0 {
+		return nil
+	}
+
+	data := []map[string]interface{}{}
+	for _, name := range sortedInterfaceNames(interfaces) {
+		id, err := getGenericObjectID(interfaces[name])
+		if err != nil {
+			return err
+		}
+		data = append(data, map[string]interface{}{"id": id})
+	}
+
+	_, err := genericAPIRawRequest(api, "DELETE", endpoint.path, nil, data)
+	return err
+}
+
+func sortedInterfaceNames(interfaces map[string]map[string]interface{}) []string {
+	names := make([]string, 0, len(interfaces))
+	for name := range interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func validateInterfaceNamePattern(i interface{}, k string) ([]string, []error) {
+	if _, err := expandInterfaceNamePattern(i.(string)); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// expandInterfaceNamePattern expands the ranges (e.g. {1..48}) and lists (e.g. {a,b}) in the given interface name
+// pattern. With multiple expressions, the first one varies slowest.
+func expandInterfaceNamePattern(pattern string) ([]string, error) {
+	start := strings.Index(pattern, "{")
+	if start < 0 {
+		if strings.Contains(pattern, "}") {
+			return nil, fmt.Errorf("unexpected } in %q", pattern)
+		}
+		return []string{pattern}, nil
+	}
+	end := strings.Index(pattern[start:], "}")
+	if end < 0 {
+		return nil, fmt.Errorf("missing } in %q", pattern)
+	}
+	end += start
+
+	prefix := pattern[:start]
+	if strings.Contains(prefix, "}") {
+		return nil, fmt.Errorf("unexpected } in %q", pattern)
+	}
+	expression := pattern[start+1 : end]
+
+	var values []string
+	if match := interfaceNamePatternRangeRegexp.FindStringSubmatch(expression); match != nil {
+		first, _ := strconv.Atoi(match[1])
+		last, _ := strconv.Atoi(match[2])
+		if first > last {
+			return nil, fmt.Errorf("invalid range {%s} in %q, the start must not be greater than the end", expression, pattern)
+		}
+		if last-first >= maxInterfaceNamePatternNames {
+			return nil, fmt.Errorf("the range {%s} in %q expands to more than %d names", expression, pattern, maxInterfaceNamePatternNames)
+		}
+		width := 0
+		if len(match[1]) > 1 && strings.HasPrefix(match[1], "0") {
+			width = len(match[1])
+		}
+		for i := first; i <= last; i++ {
+			values = append(values, fmt.Sprintf("%0*d", width, i))
+		}
+	} else if strings.Contains(expression, ",") {
+		values = strings.Split(expression, ",")
+	} else {
+		return nil, fmt.Errorf("invalid expression {%s} in %q, expected a range such as {1..48} or a list such as {a,b}", expression, pattern)
+	}
+
+	suffixes, err := expandInterfaceNamePattern(pattern[end+1:])
+	if err != nil {
+		return nil, err
+	}
+	if len(values)*len(suffixes) > maxInterfaceNamePatternNames {
+		return nil, fmt.Errorf("%q expands to more than %d names", pattern, maxInterfaceNamePatternNames)
+	}
+
+	names := make([]string, 0, len(values)*len(suffixes))
+	for _, value := range values {
+		for _, suffix := range suffixes {
+			names = append(names, prefix+value+suffix)
+		}
+	}
+	return names, nil
+}
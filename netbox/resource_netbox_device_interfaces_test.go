@@ -0,0 +1,132 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxDeviceInterfaces_basic(t *testing.T) {
+	testSlug := "dev_ifaces"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_interfaces" "test" {
+  device_id    = netbox_device.test.id
+  name_pattern = "%[1]s-{1..2}/{01..24}"
+  type         = "1000base-t"
+  mtu          = 9000
+  description  = "%[1]s"
+  tags         = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface_ids.%", "48"),
+					resource.TestCheckResourceAttrSet("netbox_device_interfaces.test", fmt.Sprintf("interface_ids.%s-2/24", testName)),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "type", "1000base-t"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "mtu", "9000"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_interfaces" "test" {
+  device_id    = netbox_device.test.id
+  name_pattern = "%[1]s-1/{01..24}"
+  type         = "10gbase-t"
+  enabled      = false
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface_ids.%", "24"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "type", "10gbase-t"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "mtu", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "tags.#", "0"),
+					testAccCheckNetboxDeviceInterfacesID("netbox_device_interfaces.test", testName+"-1/{01..24}"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_interfaces.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandInterfaceNamePattern(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		pattern  string
+		expected []string
+	}{
+		{
+			name:     "Plain",
+			pattern:  "eth0",
+			expected: []string{"eth0"},
+		},
+		{
+			name:     "Range",
+			pattern:  "Ethernet1/{1..3}",
+			expected: []string{"Ethernet1/1", "Ethernet1/2", "Ethernet1/3"},
+		},
+		{
+			name:     "ZeroPadded",
+			pattern:  "ge-0/0/{08..10}",
+			expected: []string{"ge-0/0/08", "ge-0/0/09", "ge-0/0/10"},
+		},
+		{
+			name:     "List",
+			pattern:  "mgmt{a,b}",
+			expected: []string{"mgmta", "mgmtb"},
+		},
+		{
+			name:     "Product",
+			pattern:  "Ethernet{1..2}/{1..2}",
+			expected: []string{"Ethernet1/1", "Ethernet1/2", "Ethernet2/1", "Ethernet2/2"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			names, err := expandInterfaceNamePattern(tt.pattern)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+
+	for _, pattern := range []string{"Ethernet{1..", "Ethernet}1", "Ethernet{5..1}", "Ethernet{x}", "Ethernet{1..2000}", "{1..100}/{1..100}"} {
+		_, err := expandInterfaceNamePattern(pattern)
+		assert.Error(t, err, pattern)
+	}
+}
+
+// testAccCheckNetboxDeviceInterfacesID checks that the ID of the resource matches its device and name pattern.
+func testAccCheckNetboxDeviceInterfacesID(n string, namePattern string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+		expected := fmt.Sprintf("%s:%s", rs.Primary.Attributes["device_id"], namePattern)
+		if rs.Primary.ID != expected {
+			return fmt.Errorf("expected ID %s, but got %s", expected, rs.Primary.ID)
+		}
+		return nil
+	}
+}
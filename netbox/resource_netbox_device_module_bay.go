@@ -0,0 +1,165 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDeviceModuleBay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceModuleBayCreate,
+		Read:   resourceNetboxDeviceModuleBayRead,
+		Update: resourceNetboxDeviceModuleBayUpdate,
+		Delete: resourceNetboxDeviceModuleBayDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/modulebay/):
+
+> Module bays represent a space or slot within a device in which a field-replaceable module may be installed. A common example is that of a chassis-based switch such as the Cisco Nexus 9000 or Juniper EX9200. Modules in turn hold additional components that become available to the parent device.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"position": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The position of the module bay within the device. Used to resolve the `{module}` placeholder in the names of module components.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			adoptExistingKey: adoptExistingSchema,
+			tagsKey:          tagsSchema,
+			customFieldsKey:  customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceModuleBayCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/module-bays/")
+	if err != nil {
+		return err
+	}
+	if adopted {
+		return resourceNetboxDeviceModuleBayUpdate(d, m)
+	}
+
+	data := getWritableDeviceModuleBayFromResourceData(api, d)
+
+	params := dcim.NewDcimModuleBaysCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimModuleBaysCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceModuleBayRead(d, m)
+}
+
+func resourceNetboxDeviceModuleBayRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModuleBaysReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimModuleBaysRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimModuleBaysReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	bay := res.GetPayload()
+
+	d.Set("device_id", bay.Device.ID)
+	d.Set("name", bay.Name)
+	d.Set("position", bay.Position)
+	d.Set("label", bay.Label)
+	d.Set("description", bay.Description)
+	d.Set(tagsKey, getManagedTagList(api, d, bay.Tags))
+
+	cf := getManagedCustomFields(api, d, bay.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDeviceModuleBayUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDeviceModuleBayFromResourceData(api, d)
+
+	params := dcim.NewDcimModuleBaysPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimModuleBaysPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/module-bays/%d/", id), map[string]string{
+		"position":    "position",
+		"label":       "label",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceModuleBayRead(d, m)
+}
+
+func resourceNetboxDeviceModuleBayDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModuleBaysDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimModuleBaysDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDeviceModuleBayFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableModuleBay {
+	data := models.WritableModuleBay{
+		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:        strToPtr(d.Get("name").(string)),
+		Position:    d.Get("position").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
@@ -0,0 +1,89 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceModuleBay_basic(t *testing.T) {
+
+	testSlug := "device_module_bay_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_module_bay" "test" {
+  device_id   = netbox_device.test.id
+  name        = "%[1]s"
+  position    = "1"
+  label       = "%[1]s"
+  description = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_module_bay.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_module_bay.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_module_bay.test", "position", "1"),
+					resource.TestCheckResourceAttr("netbox_device_module_bay.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_module_bay.test", "description", testName),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_module_bay" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_module_bay.test", "position", ""),
+					resource.TestCheckResourceAttr("netbox_device_module_bay.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_device_module_bay.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_module_bay.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_module_bay", &resource.Sweeper{
+		Name:         "netbox_device_module_bay",
+		Dependencies: []string{"netbox_module"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimModuleBaysListParams()
+			res, err := api.Dcim.DcimModuleBaysList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, bay := range res.GetPayload().Results {
+				if strings.HasPrefix(*bay.Name, testPrefix) {
+					deleteParams := dcim.NewDcimModuleBaysDeleteParams().WithID(bay.ID)
+					_, err := api.Dcim.DcimModuleBaysDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a module bay")
+				}
+			}
+			return nil
+		},
+	})
+}
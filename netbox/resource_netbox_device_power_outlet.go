@@ -0,0 +1,206 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDevicePowerOutlet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDevicePowerOutletCreate,
+		Read:   resourceNetboxDevicePowerOutletRead,
+		Update: resourceNetboxDevicePowerOutletUpdate,
+		Delete: resourceNetboxDevicePowerOutletDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/poweroutlet/):
+
+> Power outlets represent the outlets on a power distribution unit (PDU) or other device that supplies power to dependent devices. Each power port may be assigned a physical type, and may be associated with a specific feed leg (where three-phase power is used) and/or a specific upstream power port. This association can be used to model the distribution of power within a device.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the power outlet, e.g. `iec-60320-c13`. See the Netbox documentation for possible values.",
+			},
+			"power_port_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the power port of the same device that feeds this outlet.",
+			},
+			"feed_leg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"A", "B", "C"}, false),
+				Description:  "The phase of a three-phase feed that supplies this outlet. One of `A`, `B` or `C`.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Treat the port as if a cable is connected.",
+			},
+			adoptExistingKey: adoptExistingSchema,
+			tagsKey:          tagsSchema,
+			customFieldsKey:  customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDevicePowerOutletCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/power-outlets/")
+	if err != nil {
+		return err
+	}
+	if adopted {
+		return resourceNetboxDevicePowerOutletUpdate(d, m)
+	}
+
+	data := getWritableDevicePowerOutletFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerOutletsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimPowerOutletsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDevicePowerOutletRead(d, m)
+}
+
+func resourceNetboxDevicePowerOutletRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerOutletsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerOutletsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimPowerOutletsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	outlet := res.GetPayload()
+
+	d.Set("device_id", outlet.Device.ID)
+	d.Set("name", outlet.Name)
+	if outlet.Type != nil {
+		d.Set("type", outlet.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+	if outlet.PowerPort != nil {
+		d.Set("power_port_id", outlet.PowerPort.ID)
+	} else {
+		d.Set("power_port_id", nil)
+	}
+	if outlet.FeedLeg != nil {
+		d.Set("feed_leg", outlet.FeedLeg.Value)
+	} else {
+		d.Set("feed_leg", nil)
+	}
+	d.Set("label", outlet.Label)
+	d.Set("description", outlet.Description)
+	d.Set("mark_connected", outlet.MarkConnected)
+	d.Set(tagsKey, getManagedTagList(api, d, outlet.Tags))
+
+	cf := getManagedCustomFields(api, d, outlet.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDevicePowerOutletUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDevicePowerOutletFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerOutletsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimPowerOutletsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/power-outlets/%d/", id), map[string]string{
+		"type":           "type",
+		"power_port_id":  "power_port",
+		"feed_leg":       "feed_leg",
+		"label":          "label",
+		"description":    "description",
+		"mark_connected": "mark_connected",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDevicePowerOutletRead(d, m)
+}
+
+func resourceNetboxDevicePowerOutletDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerOutletsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerOutletsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDevicePowerOutletFromResourceData(api *providerState, d *schema.ResourceData) *models.WritablePowerOutlet {
+	data := models.WritablePowerOutlet{
+		Device:        int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:          strToPtr(d.Get("name").(string)),
+		Type:          d.Get("type").(string),
+		Label:         d.Get("label").(string),
+		Description:   d.Get("description").(string),
+		FeedLeg:       d.Get("feed_leg").(string),
+		MarkConnected: d.Get("mark_connected").(bool),
+	}
+
+	if powerPortID, ok := d.GetOk("power_port_id"); ok {
+		data.PowerPort = int64ToPtr(int64(powerPortID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
@@ -0,0 +1,104 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDevicePowerOutlet_basic(t *testing.T) {
+
+	testSlug := "device_power_outlet_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_power_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s_inlet"
+}
+
+resource "netbox_device_power_outlet" "test" {
+  device_id     = netbox_device.test.id
+  name          = "%[1]s"
+  type          = "iec-60320-c13"
+  power_port_id = netbox_device_power_port.test.id
+  feed_leg      = "A"
+  label         = "%[1]s"
+  description   = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_power_outlet.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_power_outlet.test", "power_port_id", "netbox_device_power_port.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "type", "iec-60320-c13"),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "feed_leg", "A"),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "description", testName),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_power_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s_inlet"
+}
+
+resource "netbox_device_power_outlet" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "power_port_id", "0"),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "feed_leg", ""),
+					resource.TestCheckResourceAttr("netbox_device_power_outlet.test", "label", ""),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_power_outlet.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_power_outlet", &resource.Sweeper{
+		Name:         "netbox_device_power_outlet",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimPowerOutletsListParams()
+			res, err := api.Dcim.DcimPowerOutletsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, outlet := range res.GetPayload().Results {
+				if strings.HasPrefix(*outlet.Name, testPrefix) {
+					deleteParams := dcim.NewDcimPowerOutletsDeleteParams().WithID(outlet.ID)
+					_, err := api.Dcim.DcimPowerOutletsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a power outlet")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,201 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDevicePowerPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDevicePowerPortCreate,
+		Read:   resourceNetboxDevicePowerPortRead,
+		Update: resourceNetboxDevicePowerPortUpdate,
+		Delete: resourceNetboxDevicePowerPortDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerport/):
+
+> A power port is a device component which draws power from some external source (e.g. an upstream power outlet), and generally represents a power supply internal to a device.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the power port, e.g. `iec-60320-c14`. See the Netbox documentation for possible values.",
+			},
+			"maximum_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+				Description:  "The maximum power draw in watts.",
+			},
+			"allocated_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+				Description:  "The allocated power draw in watts.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Treat the port as if a cable is connected.",
+			},
+			adoptExistingKey: adoptExistingSchema,
+			tagsKey:          tagsSchema,
+			customFieldsKey:  customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDevicePowerPortCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/power-ports/")
+	if err != nil {
+		return err
+	}
+	if adopted {
+		return resourceNetboxDevicePowerPortUpdate(d, m)
+	}
+
+	data := getWritableDevicePowerPortFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerPortsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimPowerPortsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDevicePowerPortRead(d, m)
+}
+
+func resourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPortsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerPortsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimPowerPortsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	port := res.GetPayload()
+
+	d.Set("device_id", port.Device.ID)
+	d.Set("name", port.Name)
+	if port.Type != nil {
+		d.Set("type", port.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+	d.Set("maximum_draw", port.MaximumDraw)
+	d.Set("allocated_draw", port.AllocatedDraw)
+	d.Set("label", port.Label)
+	d.Set("description", port.Description)
+	d.Set("mark_connected", port.MarkConnected)
+	d.Set(tagsKey, getManagedTagList(api, d, port.Tags))
+
+	cf := getManagedCustomFields(api, d, port.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDevicePowerPortUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDevicePowerPortFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerPortsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimPowerPortsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/power-ports/%d/", id), map[string]string{
+		"type":           "type",
+		"maximum_draw":   "maximum_draw",
+		"allocated_draw": "allocated_draw",
+		"label":          "label",
+		"description":    "description",
+		"mark_connected": "mark_connected",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDevicePowerPortRead(d, m)
+}
+
+func resourceNetboxDevicePowerPortDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPortsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerPortsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDevicePowerPortFromResourceData(api *providerState, d *schema.ResourceData) *models.WritablePowerPort {
+	data := models.WritablePowerPort{
+		Device:        int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:          strToPtr(d.Get("name").(string)),
+		Type:          d.Get("type").(string),
+		Label:         d.Get("label").(string),
+		Description:   d.Get("description").(string),
+		MarkConnected: d.Get("mark_connected").(bool),
+	}
+
+	if maximumDraw, ok := d.GetOk("maximum_draw"); ok {
+		data.MaximumDraw = int64ToPtr(int64(maximumDraw.(int)))
+	}
+	if allocatedDraw, ok := d.GetOk("allocated_draw"); ok {
+		data.AllocatedDraw = int64ToPtr(int64(allocatedDraw.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
@@ -0,0 +1,96 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDevicePowerPort_basic(t *testing.T) {
+
+	testSlug := "device_power_port_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_power_port" "test" {
+  device_id      = netbox_device.test.id
+  name           = "%[1]s"
+  type           = "iec-60320-c14"
+  maximum_draw   = 500
+  allocated_draw = 250
+  label          = "%[1]s"
+  description    = "%[1]s"
+  mark_connected = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_power_port.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "type", "iec-60320-c14"),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "maximum_draw", "500"),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "allocated_draw", "250"),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "mark_connected", "true"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_power_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "maximum_draw", "0"),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "allocated_draw", "0"),
+					resource.TestCheckResourceAttr("netbox_device_power_port.test", "mark_connected", "false"),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_power_port.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_power_port", &resource.Sweeper{
+		Name:         "netbox_device_power_port",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimPowerPortsListParams()
+			res, err := api.Dcim.DcimPowerPortsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, port := range res.GetPayload().Results {
+				if strings.HasPrefix(*port.Name, testPrefix) {
+					deleteParams := dcim.NewDcimPowerPortsDeleteParams().WithID(port.ID)
+					_, err := api.Dcim.DcimPowerPortsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a power port")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,122 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDevicePrimaryIP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDevicePrimaryIPCreate,
+		Read:   resourceNetboxDevicePrimaryIPRead,
+		Update: resourceNetboxDevicePrimaryIPUpdate,
+		Delete: resourceNetboxDevicePrimaryIPDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This resource is used to define the primary IP for a given device. The primary IP is reflected in the device Netbox UI, which identifies the Primary IPv4 and IPv6 addresses.
+
+Netbox only accepts IP addresses that are assigned to an interface of the device as primary IP. Managing the primary IP in a separate resource allows creating the device, its interface, the IP address and the primary IP in a single apply.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ip_address_version": {
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+				Optional:     true,
+				Default:      4,
+				ForceNew:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDevicePrimaryIPCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(strconv.Itoa(d.Get("device_id").(int)))
+
+	return resourceNetboxDevicePrimaryIPUpdate(d, m)
+}
+
+func resourceNetboxDevicePrimaryIPRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDevicesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDevicesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimDevicesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	device := res.GetPayload()
+
+	IPAddressVersion := d.Get("ip_address_version").(int)
+	d.Set("ip_address_version", IPAddressVersion)
+
+	if IPAddressVersion == 4 && device.PrimaryIp4 != nil {
+		d.Set("ip_address_id", device.PrimaryIp4.ID)
+	} else if IPAddressVersion == 6 && device.PrimaryIp6 != nil {
+		d.Set("ip_address_id", device.PrimaryIp6.ID)
+	} else {
+		// if the device exists, but has no primary ip, consider this element deleted
+		d.SetId("")
+		return nil
+	}
+	d.Set("device_id", device.ID)
+	return nil
+}
+
+func resourceNetboxDevicePrimaryIPUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	deviceID := int64(d.Get("device_id").(int))
+	IPAddressID := int64(d.Get("ip_address_id").(int))
+
+	err := validateDeviceIPAssignment(api, deviceID, IPAddressID)
+	if err != nil {
+		return err
+	}
+
+	err = setDevicePrimaryIP(api, deviceID, d.Get("ip_address_version").(int), &IPAddressID)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDevicePrimaryIPRead(d, m)
+}
+
+func resourceNetboxDevicePrimaryIPDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := setDevicePrimaryIP(api, int64(d.Get("device_id").(int)), d.Get("ip_address_version").(int), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// setDevicePrimaryIP sets the primary IP of the given version of a device. A nil ID unsets it.
+// Only the primary IP field is patched, so the other attributes of the device are left untouched.
+func setDevicePrimaryIP(api *providerState, deviceID int64, IPAddressVersion int, IPAddressID *int64) error {
+	field := fmt.Sprintf("primary_ip%d", IPAddressVersion)
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/dcim/devices/%d/", deviceID), map[string]interface{}{field: IPAddressID})
+	return err
+}
@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxDevicePrimaryIPFullDependencies(testName string) string {
+	return testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_interface" "test" {
+  device_id = netbox_device.test.id
+  name = "%[1]s"
+  type = "1000base-t"
+}
+
+resource "netbox_ip_address" "test_v4" {
+  ip_address = "1.1.1.2/32"
+  status = "active"
+  interface_id = netbox_device_interface.test.id
+  object_type = "dcim.interface"
+}
+
+resource "netbox_ip_address" "test_v6" {
+  ip_address = "2000::2/128"
+  status = "active"
+  interface_id = netbox_device_interface.test.id
+  object_type = "dcim.interface"
+}`, testName)
+}
+
+func TestAccNetboxDevicePrimaryIP_basic(t *testing.T) {
+	testSlug := "dev_pr_ip_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDevicePrimaryIPFullDependencies(testName) + `
+resource "netbox_device_primary_ip" "test_v4" {
+  device_id = netbox_device.test.id
+  ip_address_id = netbox_ip_address.test_v4.id
+}
+
+resource "netbox_device_primary_ip" "test_v6" {
+  device_id = netbox_device.test.id
+  ip_address_id = netbox_ip_address.test_v6.id
+  ip_address_version = 6
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_primary_ip.test_v4", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_primary_ip.test_v4", "ip_address_id", "netbox_ip_address.test_v4", "id"),
+					resource.TestCheckResourceAttr("netbox_device_primary_ip.test_v4", "ip_address_version", "4"),
+					resource.TestCheckResourceAttrPair("netbox_device_primary_ip.test_v6", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_primary_ip.test_v6", "ip_address_id", "netbox_ip_address.test_v6", "id"),
+					resource.TestCheckResourceAttr("netbox_device_primary_ip.test_v6", "ip_address_version", "6"),
+					resource.TestCheckResourceAttr("netbox_ip_address.test_v4", "object_type", "dcim.interface"),
+				),
+			},
+		},
+	})
+}
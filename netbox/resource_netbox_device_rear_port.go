@@ -0,0 +1,192 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDeviceRearPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceRearPortCreate,
+		Read:   resourceNetboxDeviceRearPortRead,
+		Update: resourceNetboxDeviceRearPortUpdate,
+		Delete: resourceNetboxDeviceRearPortDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/rearport/):
+
+> Like front ports, rear ports are pass-through ports which represent the continuation of a path from one cable to the next. Each rear port is defined with its physical type and a number of positions: Rear ports with more than one position can be mapped to multiple front ports. This can be useful for modeling instances where multiple paths share a common cable (for example, six discrete two-strand fiber connections sharing a 12-strand MPO cable).`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(passThroughPortTypes, false),
+				Description:  "The connector type of the port, e.g. `8p8c` or `lc`.",
+			},
+			"color_hex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"positions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1024),
+				Description:  "The number of front ports which may be mapped to this rear port.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Treat the port as if a cable is connected.",
+			},
+			adoptExistingKey: adoptExistingSchema,
+			tagsKey:          tagsSchema,
+			customFieldsKey:  customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceRearPortCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	adopted, err := adoptDeviceComponent(api, d, "/dcim/rear-ports/")
+	if err != nil {
+		return err
+	}
+	if adopted {
+		return resourceNetboxDeviceRearPortUpdate(d, m)
+	}
+
+	data := getWritableDeviceRearPortFromResourceData(api, d)
+
+	params := dcim.NewDcimRearPortsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimRearPortsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceRearPortRead(d, m)
+}
+
+func resourceNetboxDeviceRearPortRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimRearPortsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimRearPortsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimRearPortsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	port := res.GetPayload()
+
+	d.Set("device_id", port.Device.ID)
+	d.Set("name", port.Name)
+	d.Set("type", port.Type.Value)
+	d.Set("color_hex", port.Color)
+	d.Set("positions", port.Positions)
+	d.Set("label", port.Label)
+	d.Set("description", port.Description)
+	d.Set("mark_connected", port.MarkConnected)
+	d.Set(tagsKey, getManagedTagList(api, d, port.Tags))
+
+	cf := getManagedCustomFields(api, d, port.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDeviceRearPortUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableDeviceRearPortFromResourceData(api, d)
+
+	params := dcim.NewDcimRearPortsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimRearPortsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/rear-ports/%d/", id), map[string]string{
+		"color_hex":      "color",
+		"label":          "label",
+		"description":    "description",
+		"mark_connected": "mark_connected",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceRearPortRead(d, m)
+}
+
+func resourceNetboxDeviceRearPortDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimRearPortsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimRearPortsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableDeviceRearPortFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableRearPort {
+	data := models.WritableRearPort{
+		Device:        int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:          strToPtr(d.Get("name").(string)),
+		Type:          strToPtr(d.Get("type").(string)),
+		Positions:     int64(d.Get("positions").(int)),
+		Color:         d.Get("color_hex").(string),
+		Label:         d.Get("label").(string),
+		Description:   d.Get("description").(string),
+		MarkConnected: d.Get("mark_connected").(bool),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
+
+var passThroughPortTypes = []string{"8p8c", "8p6c", "8p4c", "8p2c", "6p6c", "6p4c", "6p2c", "4p4c", "4p2c", "gg45", "tera-4p", "tera-2p", "tera-1p", "110-punch", "bnc", "f", "n", "mrj21", "fc", "lc", "lc-pc", "lc-upc", "lc-apc", "lsh", "lsh-pc", "lsh-upc", "lsh-apc", "mpo", "mtrj", "sc", "sc-pc", "sc-upc", "sc-apc", "st", "cs", "sn", "sma-905", "sma-906", "urm-p2", "urm-p4", "urm-p8", "splice", "other"}
@@ -0,0 +1,98 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceRearPort_basic(t *testing.T) {
+
+	testSlug := "device_rear_port_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_rear_port" "test" {
+  device_id      = netbox_device.test.id
+  name           = "%[1]s"
+  type           = "mpo"
+  positions      = 6
+  color_hex      = "aa1409"
+  label          = "%[1]s"
+  description    = "%[1]s"
+  mark_connected = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_rear_port.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "type", "mpo"),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "positions", "6"),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "color_hex", "aa1409"),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "mark_connected", "true"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_rear_port" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s"
+  type      = "lc"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "type", "lc"),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "positions", "1"),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "color_hex", ""),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_device_rear_port.test", "mark_connected", "false"),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_rear_port.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_device_rear_port", &resource.Sweeper{
+		Name:         "netbox_device_rear_port",
+		Dependencies: []string{"netbox_device_front_port"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimRearPortsListParams()
+			res, err := api.Dcim.DcimRearPortsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, port := range res.GetPayload().Results {
+				if strings.HasPrefix(*port.Name, testPrefix) {
+					deleteParams := dcim.NewDcimRearPortsDeleteParams().WithID(port.ID)
+					_, err := api.Dcim.DcimRearPortsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a rear port")
+				}
+			}
+			return nil
+		},
+	})
+}
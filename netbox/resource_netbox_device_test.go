@@ -3,6 +3,8 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxDeviceFullDependencies(testName string) string {
@@ -90,6 +93,7 @@ resource "netbox_device" "test" {
   location_id = netbox_location.test.id
   status = "staged"
   serial = "ABCDEF"
+  airflow = "front-to-rear"
 }`, testName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("netbox_device.test", "name", testName),
@@ -101,7 +105,9 @@ resource "netbox_device" "test" {
 					resource.TestCheckResourceAttrPair("netbox_device.test", "cluster_id", "netbox_cluster.test", "id"),
 					resource.TestCheckResourceAttr("netbox_device.test", "comments", "thisisacomment"),
 					resource.TestCheckResourceAttr("netbox_device.test", "status", "staged"),
+					resource.TestCheckResourceAttrSet("netbox_device.test", "config_context"),
 					resource.TestCheckResourceAttr("netbox_device.test", "serial", "ABCDEF"),
+					resource.TestCheckResourceAttr("netbox_device.test", "airflow", "front-to-rear"),
 					resource.TestCheckResourceAttr("netbox_device.test", "tags.#", "1"),
 					resource.TestCheckResourceAttr("netbox_device.test", "tags.0", testName+"a"),
 				),
@@ -115,9 +121,83 @@ resource "netbox_device" "test" {
 	})
 }
 
+func TestAccNetboxDevice_assetTagAndCustomFields(t *testing.T) {
+
+	testSlug := "device_cf"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[2]s"
+  type          = "text"
+  content_types = ["dcim.device"]
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  role_id        = netbox_device_role.test.id
+  device_type_id = netbox_device_type.test.id
+  site_id        = netbox_site.test.id
+  asset_tag      = "%[1]s"
+  status         = "decommissioning"
+  custom_fields  = {"${netbox_custom_field.test.name}" = "76"}
+}`, testName, strings.ReplaceAll(testName, "-", "_")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "asset_tag", testName),
+					resource.TestCheckResourceAttr("netbox_device.test", "status", "decommissioning"),
+					resource.TestCheckResourceAttr("netbox_device.test", "custom_fields."+strings.ReplaceAll(testName, "-", "_"), "76"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateDeviceIPAssignment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/ipam/ip-addresses/1/":
+			w.Write([]byte(`{"id": 1, "assigned_object_type": "dcim.interface", "assigned_object": {"id": 10, "device": {"id": 42}}}`))
+		case "/api/ipam/ip-addresses/2/":
+			w.Write([]byte(`{"id": 2, "assigned_object_type": "virtualization.vminterface", "assigned_object": {"id": 10, "virtual_machine": {"id": 42}}}`))
+		case "/api/ipam/ip-addresses/3/":
+			w.Write([]byte(`{"id": 3, "assigned_object_type": null, "assigned_object": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	assert.NoError(t, validateDeviceIPAssignment(api, 42, 1))
+	assert.Error(t, validateDeviceIPAssignment(api, 43, 1))
+	assert.Error(t, validateDeviceIPAssignment(api, 42, 2))
+	assert.Error(t, validateDeviceIPAssignment(api, 42, 3))
+	assert.True(t, isGenericAPINotFound(validateDeviceIPAssignment(api, 42, 4)))
+}
+
 func testAccCheckDeviceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each device
 	// is destroyed
@@ -1,9 +1,10 @@
 package netbox
 
 import (
+	"encoding/json"
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,11 +42,45 @@ func resourceNetboxDeviceType() *schema.Resource {
 				Optional: true,
 			},
 			"u_height": {
-				Type:     schema.TypeFloat,
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Default:     "1.0",
+				Description: "The height of the device type in rack units. Half units such as `0.5` are allowed. Use `0` for devices that do not occupy rack space.",
+			},
+			"is_full_depth": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  "1.0",
+				Default:  true,
+			},
+			"airflow": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"front-to-rear", "rear-to-front", "left-to-right", "right-to-left", "side-to-rear", "passive", "mixed"}, false),
+				Description:  "One of `front-to-rear`, `rear-to-front`, `left-to-right`, `right-to-left`, `side-to-rear`, `passive` or `mixed`.",
+			},
+			"weight": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				RequiredWith:     []string{"weight_unit"},
+				ValidateFunc:     validation.FloatAtLeast(0),
+				DiffSuppressFunc: suppressFloatRoundingDiff(2),
+				Description:      "Netbox stores the weight with two decimal places, further decimal places are rounded.",
+			},
+			"weight_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"kg", "g", "lb", "oz"}, false),
+				RequiredWith: []string{"weight"},
+				Description:  "One of `kg`, `g`, `lb` or `oz`.",
+			},
+			"subdevice_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"parent", "child"}, false),
+				Description:  "One of `parent` or `child`. Devices of a `parent` device type can house devices of a `child` device type in their device bays.",
 			},
-			tagsKey: tagsSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -54,7 +89,7 @@ func resourceNetboxDeviceType() *schema.Resource {
 }
 
 func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableDeviceType{}
 
@@ -78,12 +113,13 @@ func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error
 		data.PartNumber = partNo.(string)
 	}
 
-	if uHeightValue, ok := d.GetOk("u_height"); ok {
-		data.UHeight = float64ToPtr(float64(uHeightValue.(float64)))
-	}
+	// Always send the height, as 0U is a valid height
+	data.UHeight = float64ToPtr(d.Get("u_height").(float64))
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := dcim.NewDcimDeviceTypesCreateParams().WithData(&data)
 
 	res, err := api.Dcim.DcimDeviceTypesCreate(params, nil)
@@ -93,11 +129,16 @@ func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	err = updateDeviceTypePhysicalAttributes(api, d, res.GetPayload().ID)
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxDeviceTypeRead(d, m)
 }
 
 func resourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimDeviceTypesReadParams().WithID(id)
 
@@ -119,13 +160,46 @@ func resourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("manufacturer_id", device_type.Manufacturer.ID)
 	d.Set("part_number", device_type.PartNumber)
 	d.Set("u_height", device_type.UHeight)
-	d.Set(tagsKey, getTagListFromNestedTagList(device_type.Tags))
+	d.Set("is_full_depth", device_type.IsFullDepth)
+	if device_type.Airflow != nil {
+		d.Set("airflow", device_type.Airflow.Value)
+	} else {
+		d.Set("airflow", nil)
+	}
+	if device_type.SubdeviceRole != nil {
+		d.Set("subdevice_role", device_type.SubdeviceRole.Value)
+	} else {
+		d.Set("subdevice_role", nil)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, device_type.Tags))
+
+	cf := getManagedCustomFields(api, d, device_type.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	// The weight is not part of the generated client
+	physical, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/device-types/%d/", id), nil)
+	if err != nil {
+		return err
+	}
+	if weight, ok := physical["weight"].(json.Number); ok {
+		weightValue, _ := weight.Float64()
+		d.Set("weight", weightValue)
+	} else {
+		d.Set("weight", nil)
+	}
+	if weightUnit, ok := physical["weight_unit"].(map[string]interface{}); ok {
+		d.Set("weight_unit", weightUnit["value"])
+	} else {
+		d.Set("weight_unit", nil)
+	}
 
 	return nil
 }
 
 func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableDeviceType{}
@@ -150,12 +224,13 @@ func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error
 		data.PartNumber = partNo.(string)
 	}
 
-	if uHeightValue, ok := d.GetOk("u_height"); ok {
-		data.UHeight = float64ToPtr(float64(uHeightValue.(float64)))
-	}
+	// Always send the height, as 0U is a valid height
+	data.UHeight = float64ToPtr(d.Get("u_height").(float64))
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := dcim.NewDcimDeviceTypesPartialUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimDeviceTypesPartialUpdate(params, nil)
@@ -163,11 +238,16 @@ func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
+	err = updateDeviceTypePhysicalAttributes(api, d, id)
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxDeviceTypeRead(d, m)
 }
 
 func resourceNetboxDeviceTypeDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimDeviceTypesDeleteParams().WithID(id)
@@ -178,3 +258,21 @@ func resourceNetboxDeviceTypeDelete(d *schema.ResourceData, m interface{}) error
 	}
 	return nil
 }
+
+// updateDeviceTypePhysicalAttributes writes the attributes of a device type that cannot be written with the
+// generated client, which omits false booleans and empty strings and does not know about the weight.
+func updateDeviceTypePhysicalAttributes(api *providerState, d *schema.ResourceData, id int64) error {
+	data := map[string]interface{}{
+		"is_full_depth":  d.Get("is_full_depth").(bool),
+		"airflow":        d.Get("airflow").(string),
+		"subdevice_role": d.Get("subdevice_role").(string),
+		"weight":         nil,
+		"weight_unit":    d.Get("weight_unit").(string),
+	}
+	if weight, ok := d.GetOk("weight"); ok {
+		data["weight"] = roundFloat(weight.(float64), 2)
+	}
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/dcim/device-types/%d/", id), data)
+	return err
+}
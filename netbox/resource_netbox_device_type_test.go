@@ -50,6 +50,67 @@ resource "netbox_device_type" "test" {
 	})
 }
 
+func TestAccNetboxDeviceType_physicalAttributes(t *testing.T) {
+
+	testSlug := "device_type_phys"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  u_height        = 0
+  is_full_depth   = false
+  airflow         = "front-to-rear"
+  weight          = 2.5
+  weight_unit     = "kg"
+  subdevice_role  = "parent"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type.test", "u_height", "0"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "is_full_depth", "false"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "airflow", "front-to-rear"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight", "2.5"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight_unit", "kg"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "subdevice_role", "parent"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type.test", "u_height", "1"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "is_full_depth", "true"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "airflow", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight", "0"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight_unit", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "subdevice_role", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_device_type", &resource.Sweeper{
 		Name:         "netbox_device_type",
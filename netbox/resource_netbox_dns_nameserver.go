@@ -0,0 +1,141 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDNSNameserver() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxDNSNameserverCreate,
+		Read:          resourceNetboxDNSNameserverRead,
+		Update:        resourceNetboxDNSNameserverUpdate,
+		Delete:        resourceNetboxDNSNameserverDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxDNS, "netbox_dns_nameserver"),
+
+		Description: `:meta:subcategory:Plugins:A nameserver of the netbox-dns plugin is a DNS server that is authoritative for zones. Nameservers are referenced by the NS records of zones and by the primary nameserver in the SOA record of zones.
+
+This resource requires the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "The fully qualified domain name of the nameserver, e.g. `ns1.example.com`.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDNSNameserverCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxDNSAPIPath+"nameservers/", getDNSNameserverRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxDNSNameserverRead(d, m)
+}
+
+func resourceNetboxDNSNameserverRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	nameserver, err := genericAPIRequest(api, "GET", fmt.Sprintf("%snameservers/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", nameserver["name"])
+	d.Set("description", nameserver["description"])
+
+	if tenantID, ok := getGenericNestedObjectID(nameserver, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(nameserver)))
+
+	cf := getManagedCustomFields(api, d, nameserver[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDNSNameserverUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%snameservers/%d/", netboxDNSAPIPath, id), getDNSNameserverRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDNSNameserverRead(d, m)
+}
+
+func resourceNetboxDNSNameserverDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%snameservers/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getDNSNameserverRequestData returns the request body for creating or updating a DNS nameserver. Unset references
+// are sent as null, so removing them from the configuration clears them.
+func getDNSNameserverRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"tenant":      nil,
+	}
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
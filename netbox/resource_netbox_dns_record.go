@@ -0,0 +1,209 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDNSRecordCreate,
+		Read:   resourceNetboxDNSRecordRead,
+		Update: resourceNetboxDNSRecordUpdate,
+		Delete: resourceNetboxDNSRecordDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxDNS, "netbox_dns_record",
+			customizeDiffStatusChoices(netboxDNSAPIPath+"records/"),
+			func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+				return validateChoiceAttribute(d, m, netboxDNSAPIPath+"records/", "type", "type")
+			},
+		),
+
+		Description: `:meta:subcategory:Plugins:A record of the netbox-dns plugin is a resource record in a zone. The SOA and NS records of a zone are maintained by the plugin and cannot be managed with this resource. Unless disabled, the plugin also maintains the PTR records for A and AAAA records in the matching reverse zones.
+
+This resource requires the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of the record, e.g. `A`, `AAAA`, `CNAME`, `MX` or `TXT`. The type is validated against the types of the plugin during plan.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "The name of the record relative to the zone, e.g. `www`, or `@` for the zone itself.",
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 65535),
+				Description:  "The value of the record in zone file format, e.g. `192.0.2.1` or `10 mail.example.com.`.",
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The TTL of the record in seconds. Defaults to the default TTL of the zone.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("active", "inactive"),
+			},
+			"disable_ptr": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the plugin does not maintain a PTR record for this A or AAAA record.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fully qualified domain name of the record.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDNSRecordCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxDNSAPIPath+"records/", getDNSRecordRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxDNSRecordRead(d, m)
+}
+
+func resourceNetboxDNSRecordRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	record, err := genericAPIRequest(api, "GET", fmt.Sprintf("%srecords/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if zoneID, ok := getGenericNestedObjectID(record, "zone"); ok {
+		d.Set("zone_id", zoneID)
+	}
+	d.Set("type", record["type"])
+	d.Set("name", record["name"])
+	d.Set("value", record["value"])
+	if ttl, ok := getGenericInt(record, "ttl"); ok {
+		d.Set("ttl", ttl)
+	} else {
+		d.Set("ttl", nil)
+	}
+	if status, ok := record["status"].(map[string]interface{}); ok {
+		d.Set("status", status["value"])
+	}
+	d.Set("disable_ptr", record["disable_ptr"])
+	d.Set("fqdn", record["fqdn"])
+	d.Set("description", record["description"])
+
+	if tenantID, ok := getGenericNestedObjectID(record, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(record)))
+
+	cf := getManagedCustomFields(api, d, record[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%srecords/%d/", netboxDNSAPIPath, id), getDNSRecordRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDNSRecordRead(d, m)
+}
+
+func resourceNetboxDNSRecordDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%srecords/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getDNSRecordRequestData returns the request body for creating or updating a DNS record. Unset values are sent as
+// null, so removing them from the configuration clears them.
+func getDNSRecordRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"zone":        d.Get("zone_id").(int),
+		"type":        d.Get("type").(string),
+		"name":        d.Get("name").(string),
+		"value":       d.Get("value").(string),
+		"ttl":         nil,
+		"status":      d.Get("status").(string),
+		"disable_ptr": d.Get("disable_ptr").(bool),
+		"description": d.Get("description").(string),
+		"tenant":      nil,
+	}
+
+	if ttl, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = ttl.(int)
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
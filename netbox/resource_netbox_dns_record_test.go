@@ -0,0 +1,33 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDNSRecordRequestData(t *testing.T) {
+	d := resourceNetboxDNSRecord().TestResourceData()
+	d.Set("zone_id", 3)
+	d.Set("type", "A")
+	d.Set("name", "www")
+	d.Set("value", "192.0.2.1")
+	d.Set("status", "active")
+	d.Set("disable_ptr", true)
+
+	data := getDNSRecordRequestData(&providerState{}, d)
+	assert.Equal(t, 3, data["zone"])
+	assert.Equal(t, "A", data["type"])
+	assert.Equal(t, "www", data["name"])
+	assert.Equal(t, "192.0.2.1", data["value"])
+	assert.Equal(t, true, data["disable_ptr"])
+
+	// Unset values are cleared, so the record falls back to the default TTL of the zone
+	assert.Contains(t, data, "ttl")
+	assert.Nil(t, data["ttl"])
+	assert.Nil(t, data["tenant"])
+
+	d.Set("ttl", 300)
+	data = getDNSRecordRequestData(&providerState{}, d)
+	assert.Equal(t, 300, data["ttl"])
+}
@@ -0,0 +1,151 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// netboxDNSAPIPath is the path of the API of the netbox-dns plugin.
+const netboxDNSAPIPath = "/plugins/netbox-dns/"
+
+func resourceNetboxDNSView() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxDNSViewCreate,
+		Read:          resourceNetboxDNSViewRead,
+		Update:        resourceNetboxDNSViewUpdate,
+		Delete:        resourceNetboxDNSViewDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxDNS, "netbox_dns_view"),
+
+		Description: `:meta:subcategory:Plugins:From the [official documentation](https://github.com/peteeckel/netbox-plugin-dns/wiki/Views):
+
+> Views are used to implement split horizon DNS. Every zone is assigned to exactly one view, and zones with the same name can exist in different views.
+
+This resource requires the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"default_view": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if this is the view that zones are assigned to if no view is given.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDNSViewCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxDNSAPIPath+"views/", getDNSViewRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxDNSViewRead(d, m)
+}
+
+func resourceNetboxDNSViewRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	view, err := genericAPIRequest(api, "GET", fmt.Sprintf("%sviews/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", view["name"])
+	d.Set("description", view["description"])
+	d.Set("default_view", view["default_view"])
+
+	if tenantID, ok := getGenericNestedObjectID(view, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(view)))
+
+	cf := getManagedCustomFields(api, d, view[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDNSViewUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%sviews/%d/", netboxDNSAPIPath, id), getDNSViewRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDNSViewRead(d, m)
+}
+
+func resourceNetboxDNSViewDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%sviews/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getDNSViewRequestData returns the request body for creating or updating a DNS view. Unset references are sent as
+// null, so removing them from the configuration clears them.
+func getDNSViewRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"tenant":      nil,
+	}
+
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
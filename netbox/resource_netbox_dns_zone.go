@@ -0,0 +1,263 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dnsZoneSOATimers are the timers of the SOA record of a zone. Unless given, they default to the settings of the
+// netbox-dns plugin.
+var dnsZoneSOATimers = []struct {
+	attribute   string
+	description string
+}{
+	{"soa_ttl", "The TTL of the SOA record of the zone in seconds."},
+	{"soa_refresh", "The time in seconds after which secondary nameservers check the zone for changes."},
+	{"soa_retry", "The time in seconds after which secondary nameservers retry a failed check for changes."},
+	{"soa_expire", "The time in seconds after which secondary nameservers stop answering for the zone if the checks for changes keep failing."},
+	{"soa_minimum", "The TTL of negative answers for the zone in seconds."},
+}
+
+func resourceNetboxDNSZone() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 255),
+			Description:  "The name of the zone, e.g. `example.com`.",
+		},
+		"view_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the view of the zone. Defaults to the default view.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "active",
+			Description: statusDescription("active", "reserved", "deprecated", "parked", "dynamic"),
+		},
+		"nameserver_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+			Description: "The IDs of the nameservers of the zone, which are published in the NS records of the zone.",
+		},
+		"default_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The TTL of the records of the zone that have no TTL of their own. Defaults to the settings of the plugin.",
+		},
+		"soa_mname_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the primary nameserver of the zone in its SOA record. Defaults to the settings of the plugin.",
+		},
+		"soa_rname": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The mailbox of the person responsible for the zone in its SOA record, e.g. `hostmaster.example.com`. Defaults to the settings of the plugin.",
+		},
+		"soa_serial_auto": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "If true, the serial of the SOA record is generated by the plugin whenever the zone or its records change.",
+		},
+		"soa_serial": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The serial of the SOA record of the zone. Only used if `soa_serial_auto` is false.",
+		},
+		"description": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 200),
+		},
+		"tenant_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		tagsKey:         tagsSchema,
+		customFieldsKey: customFieldsSchema,
+	}
+	for _, timer := range dnsZoneSOATimers {
+		s[timer.attribute] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  timer.description + " Defaults to the settings of the plugin.",
+		}
+	}
+
+	return &schema.Resource{
+		Create:        resourceNetboxDNSZoneCreate,
+		Read:          resourceNetboxDNSZoneRead,
+		Update:        resourceNetboxDNSZoneUpdate,
+		Delete:        resourceNetboxDNSZoneDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxDNS, "netbox_dns_zone", customizeDiffStatusChoices(netboxDNSAPIPath+"zones/")),
+
+		Description: `:meta:subcategory:Plugins:A zone of the netbox-dns plugin is a DNS zone with its SOA and NS records, which are maintained by the plugin. The records of the zone are managed with the ` + "`netbox_dns_record`" + ` resource.
+
+This resource requires the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: s,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDNSZoneCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxDNSAPIPath+"zones/", getDNSZoneRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxDNSZoneRead(d, m)
+}
+
+func resourceNetboxDNSZoneRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	zone, err := genericAPIRequest(api, "GET", fmt.Sprintf("%szones/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", zone["name"])
+	d.Set("description", zone["description"])
+	if status, ok := zone["status"].(map[string]interface{}); ok {
+		d.Set("status", status["value"])
+	}
+	if viewID, ok := getGenericNestedObjectID(zone, "view"); ok {
+		d.Set("view_id", viewID)
+	}
+	d.Set("nameserver_ids", getGenericNestedObjectIDList(zone, "nameservers"))
+
+	if defaultTTL, ok := getGenericInt(zone, "default_ttl"); ok {
+		d.Set("default_ttl", defaultTTL)
+	}
+	if soaMnameID, ok := getGenericNestedObjectID(zone, "soa_mname"); ok {
+		d.Set("soa_mname_id", soaMnameID)
+	}
+	d.Set("soa_rname", zone["soa_rname"])
+	d.Set("soa_serial_auto", zone["soa_serial_auto"])
+	if soaSerial, ok := getGenericInt(zone, "soa_serial"); ok {
+		d.Set("soa_serial", soaSerial)
+	}
+	for _, timer := range dnsZoneSOATimers {
+		if value, ok := getGenericInt(zone, timer.attribute); ok {
+			d.Set(timer.attribute, value)
+		}
+	}
+
+	if tenantID, ok := getGenericNestedObjectID(zone, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(zone)))
+
+	cf := getManagedCustomFields(api, d, zone[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxDNSZoneUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%szones/%d/", netboxDNSAPIPath, id), getDNSZoneRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDNSZoneRead(d, m)
+}
+
+func resourceNetboxDNSZoneDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%szones/%d/", netboxDNSAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getDNSZoneRequestData returns the request body for creating or updating a DNS zone. Values that default to the
+// settings of the plugin are only sent if they are given, the serial of the SOA record only if it is not generated.
+func getDNSZoneRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"status":          d.Get("status").(string),
+		"nameservers":     toInt64List(d.Get("nameserver_ids")),
+		"soa_serial_auto": d.Get("soa_serial_auto").(bool),
+		"description":     d.Get("description").(string),
+		"tenant":          nil,
+	}
+
+	if viewID, ok := d.GetOk("view_id"); ok {
+		data["view"] = viewID.(int)
+	}
+	if defaultTTL, ok := d.GetOk("default_ttl"); ok {
+		data["default_ttl"] = defaultTTL.(int)
+	}
+	if soaMnameID, ok := d.GetOk("soa_mname_id"); ok {
+		data["soa_mname"] = soaMnameID.(int)
+	}
+	if soaRname, ok := d.GetOk("soa_rname"); ok {
+		data["soa_rname"] = soaRname.(string)
+	}
+	if soaSerial, ok := d.GetOk("soa_serial"); ok && !d.Get("soa_serial_auto").(bool) {
+		data["soa_serial"] = soaSerial.(int)
+	}
+	for _, timer := range dnsZoneSOATimers {
+		if value, ok := d.GetOk(timer.attribute); ok {
+			data[timer.attribute] = value.(int)
+		}
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
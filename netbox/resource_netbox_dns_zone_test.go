@@ -0,0 +1,39 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDNSZoneRequestData(t *testing.T) {
+	d := resourceNetboxDNSZone().TestResourceData()
+	d.Set("name", "example.com")
+	d.Set("status", "active")
+	d.Set("nameserver_ids", []interface{}{1, 2})
+	d.Set("soa_mname_id", 1)
+	d.Set("soa_serial_auto", true)
+	d.Set("soa_serial", 2024010101)
+	d.Set("soa_refresh", 3600)
+
+	data := getDNSZoneRequestData(&providerState{}, d)
+	assert.Equal(t, "example.com", data["name"])
+	assert.ElementsMatch(t, []int64{1, 2}, data["nameservers"])
+	assert.Equal(t, 1, data["soa_mname"])
+	assert.Equal(t, 3600, data["soa_refresh"])
+	assert.Nil(t, data["tenant"])
+
+	// Values that default to the plugin settings are left to the plugin unless given
+	assert.NotContains(t, data, "view")
+	assert.NotContains(t, data, "default_ttl")
+	assert.NotContains(t, data, "soa_rname")
+	assert.NotContains(t, data, "soa_retry")
+
+	// The serial is generated by the plugin
+	assert.NotContains(t, data, "soa_serial")
+
+	d.Set("soa_serial_auto", false)
+	data = getDNSZoneRequestData(&providerState{}, d)
+	assert.Equal(t, false, data["soa_serial_auto"])
+	assert.Equal(t, 2024010101, data["soa_serial"])
+}
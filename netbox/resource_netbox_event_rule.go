@@ -0,0 +1,304 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+// eventRuleMinimumNetboxVersion is the first Netbox version with event rules. Before, the triggers were part of the
+// webhooks.
+const eventRuleMinimumNetboxVersion = "3.7.0"
+
+// eventRuleEventTypesNetboxVersion is the first Netbox version with the event_types field. Before, every event type
+// had its own boolean field.
+const eventRuleEventTypesNetboxVersion = "4.3.0"
+
+var eventRuleEventTypes = []string{"object_created", "object_updated", "object_deleted", "job_started", "job_completed", "job_failed", "job_errored"}
+
+type eventRuleLegacyEventTypeField struct {
+	eventType string
+	field     string
+}
+
+// eventRuleLegacyEventTypeFields maps the event types to their boolean fields before Netbox 4.3.
+var eventRuleLegacyEventTypeFields = []eventRuleLegacyEventTypeField{
+	{"object_created", "type_create"},
+	{"object_updated", "type_update"},
+	{"object_deleted", "type_delete"},
+	{"job_started", "type_job_start"},
+	{"job_completed", "type_job_end"},
+}
+
+func resourceNetboxEventRule() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxEventRuleCreate,
+		Read:          resourceNetboxEventRuleRead,
+		Update:        resourceNetboxEventRuleUpdate,
+		Delete:        resourceNetboxEventRuleDelete,
+		CustomizeDiff: resourceNetboxEventRuleCustomizeDiff,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/features/event-rules/):
+
+> NetBox can be configured via Event Rules to transmit outgoing webhooks to remote systems in response to internal object changes. The receiver can act on the data in these webhook messages to perform related tasks.
+>
+> Event rules can also be used to run custom scripts in response to events.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 150),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"object_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The object types the event rule applies to, e.g. `dcim.device`.",
+			},
+			"event_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(eventRuleEventTypes, false),
+				},
+				Description: "The events that trigger the event rule. One of `object_created`, `object_updated`, `object_deleted`, `job_started`, `job_completed`, `job_failed` or `job_errored`. `job_failed` and `job_errored` require Netbox 4.3 or later.",
+			},
+			"conditions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The conditions as JSON that an object must match for the event rule to trigger, e.g. `jsonencode({ attr = \"status.value\", value = \"active\" })`. See the [Netbox documentation](https://docs.netbox.dev/en/stable/reference/conditions/) for the syntax.",
+			},
+			"action_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"webhook", "script", "notification"}, false),
+				Description:  "One of `webhook`, `script` or `notification`. `notification` requires Netbox 4.1 or later.",
+			},
+			"action_object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The object type of the object that is run by the event rule, e.g. `extras.webhook`.",
+			},
+			"action_object_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the object that is run by the event rule, e.g. the ID of a webhook.",
+			},
+			"action_data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "Parameters as JSON that are passed to the action, e.g. the data of a script.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(eventRuleMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_event_rule requires Netbox %s or later, but the Netbox version is %s", eventRuleMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	data, err := getEventRuleRequestData(api, d)
+	if err != nil {
+		return err
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/extras/event-rules/", data)
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxEventRuleRead(d, m)
+}
+
+func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	rule, err := genericAPIRequest(api, "GET", fmt.Sprintf("/extras/event-rules/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", rule["name"])
+	d.Set("description", rule["description"])
+	d.Set("enabled", rule["enabled"])
+	if actionType, ok := rule["action_type"].(map[string]interface{}); ok {
+		d.Set("action_type", actionType["value"])
+	}
+	d.Set("action_object_type", rule["action_object_type"])
+	if actionObjectID, ok := getGenericInt(rule, "action_object_id"); ok {
+		d.Set("action_object_id", actionObjectID)
+	}
+
+	d.Set("object_types", rule[getObjectTypesField(api)])
+	d.Set("event_types", getEventRuleEventTypes(api, rule))
+
+	conditions, err := formatOptionalJSON(rule["conditions"])
+	if err != nil {
+		return err
+	}
+	d.Set("conditions", conditions)
+
+	actionData, err := formatOptionalJSON(rule["action_data"])
+	if err != nil {
+		return err
+	}
+	d.Set("action_data", actionData)
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(rule)))
+
+	cf := getManagedCustomFields(api, d, rule[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := getEventRuleRequestData(api, d)
+	if err != nil {
+		return err
+	}
+
+	_, err = genericAPIRequest(api, "PATCH", fmt.Sprintf("/extras/event-rules/%d/", id), data)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxEventRuleRead(d, m)
+}
+
+func resourceNetboxEventRuleDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/extras/event-rules/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxEventRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(eventRuleMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_event_rule requires Netbox %s or later, but the Netbox version is %s", eventRuleMinimumNetboxVersion, api.netboxVersion)
+	}
+	if api.hasNetboxVersion(eventRuleEventTypesNetboxVersion) || !d.NewValueKnown("event_types") {
+		return nil
+	}
+	for _, eventType := range d.Get("event_types").(*schema.Set).List() {
+		if !slices.ContainsFunc(eventRuleLegacyEventTypeFields, func(f eventRuleLegacyEventTypeField) bool { return f.eventType == eventType }) {
+			return fmt.Errorf("event type %s requires Netbox %s or later, but the Netbox version is %s", eventType, eventRuleEventTypesNetboxVersion, api.netboxVersion)
+		}
+	}
+	return nil
+}
+
+// getEventRuleRequestData returns the request body for creating or updating an event rule. The object types and event
+// types are sent in the fields of the Netbox version.
+func getEventRuleRequestData(api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"name":               d.Get("name").(string),
+		"description":        d.Get("description").(string),
+		"enabled":            d.Get("enabled").(bool),
+		"action_type":        d.Get("action_type").(string),
+		"action_object_type": d.Get("action_object_type").(string),
+		"action_object_id":   d.Get("action_object_id").(int),
+	}
+
+	data[getObjectTypesField(api)] = d.Get("object_types").(*schema.Set).List()
+
+	eventTypes := d.Get("event_types").(*schema.Set)
+	if api.hasNetboxVersion(eventRuleEventTypesNetboxVersion) {
+		data["event_types"] = eventTypes.List()
+	} else {
+		for _, f := range eventRuleLegacyEventTypeFields {
+			data[f.field] = eventTypes.Contains(f.eventType)
+		}
+	}
+
+	conditions, err := getOptionalJSON(d, "conditions")
+	if err != nil {
+		return nil, err
+	}
+	data["conditions"] = conditions
+
+	actionData, err := getOptionalJSON(d, "action_data")
+	if err != nil {
+		return nil, err
+	}
+	data["action_data"] = actionData
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data, nil
+}
+
+// getEventRuleEventTypes returns the event types of an event rule read from Netbox.
+func getEventRuleEventTypes(api *providerState, rule map[string]interface{}) []interface{} {
+	if api.hasNetboxVersion(eventRuleEventTypesNetboxVersion) {
+		eventTypes, _ := rule["event_types"].([]interface{})
+		return eventTypes
+	}
+	eventTypes := []interface{}{}
+	for _, f := range eventRuleLegacyEventTypeFields {
+		if enabled, _ := rule[f.field].(bool); enabled {
+			eventTypes = append(eventTypes, f.eventType)
+		}
+	}
+	return eventTypes
+}
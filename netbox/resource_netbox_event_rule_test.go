@@ -0,0 +1,79 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxEventRuleRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	err := resourceNetboxEventRuleCustomizeDiff(context.Background(), nil, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
+
+func TestNetboxEventRuleCustomizeDiffEventTypes(t *testing.T) {
+	r := resourceNetboxEventRule()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":               "notify",
+		"object_types":       []interface{}{"dcim.device"},
+		"event_types":        []interface{}{"object_created", "job_failed"},
+		"action_type":        "webhook",
+		"action_object_type": "extras.webhook",
+		"action_object_id":   1,
+	})
+
+	// job_failed has no boolean field before Netbox 4.3
+	_, err := r.Diff(context.Background(), nil, config, &providerState{netboxVersion: "4.2.0"})
+	assert.ErrorContains(t, err, "event type job_failed requires Netbox 4.3.0 or later")
+
+	_, err = r.Diff(context.Background(), nil, config, &providerState{netboxVersion: "4.3.0"})
+	assert.NoError(t, err)
+}
+
+func TestGetEventRuleRequestData(t *testing.T) {
+	d := resourceNetboxEventRule().TestResourceData()
+	d.Set("name", "notify")
+	d.Set("enabled", true)
+	d.Set("object_types", []interface{}{"dcim.device"})
+	d.Set("event_types", []interface{}{"object_created", "object_deleted"})
+	d.Set("conditions", `{"attr": "status.value", "value": "active"}`)
+	d.Set("action_type", "webhook")
+	d.Set("action_object_type", "extras.webhook")
+	d.Set("action_object_id", 1)
+
+	data, err := getEventRuleRequestData(&providerState{netboxVersion: "4.3.0"}, d)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"dcim.device"}, data["object_types"])
+	assert.ElementsMatch(t, []interface{}{"object_created", "object_deleted"}, data["event_types"])
+	assert.Equal(t, map[string]interface{}{"attr": "status.value", "value": "active"}, data["conditions"])
+	assert.Equal(t, 1, data["action_object_id"])
+	// Unset JSON attributes are sent explicitly to clear them
+	assert.Contains(t, data, "action_data")
+	assert.Nil(t, data["action_data"])
+
+	// Older versions use content types and a boolean field per event type
+	data, err = getEventRuleRequestData(&providerState{netboxVersion: "3.7.0"}, d)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"dcim.device"}, data["content_types"])
+	assert.NotContains(t, data, "object_types")
+	assert.NotContains(t, data, "event_types")
+	assert.Equal(t, true, data["type_create"])
+	assert.Equal(t, false, data["type_update"])
+	assert.Equal(t, true, data["type_delete"])
+	assert.Equal(t, false, data["type_job_start"])
+	assert.Equal(t, false, data["type_job_end"])
+}
+
+func TestGetEventRuleEventTypes(t *testing.T) {
+	rule := map[string]interface{}{
+		"event_types":  []interface{}{"object_updated", "job_failed"},
+		"type_create":  false,
+		"type_update":  true,
+		"type_job_end": true,
+	}
+	assert.Equal(t, []interface{}{"object_updated", "job_failed"}, getEventRuleEventTypes(&providerState{netboxVersion: "4.3.0"}, rule))
+	assert.Equal(t, []interface{}{"object_updated", "job_completed"}, getEventRuleEventTypes(&providerState{netboxVersion: "4.2.0"}, rule))
+}
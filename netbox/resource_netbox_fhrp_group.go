@@ -0,0 +1,183 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// fhrpGroupProtocols are the redundancy protocols of an FHRP group.
+var fhrpGroupProtocols = []string{"vrrp2", "vrrp3", "carp", "clusterxl", "hsrp", "glbp", "other"}
+
+func resourceNetboxFhrpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxFhrpGroupCreate,
+		Read:   resourceNetboxFhrpGroupRead,
+		Update: resourceNetboxFhrpGroupUpdate,
+		Delete: resourceNetboxFhrpGroupDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroup/):
+
+> A first-hop redundancy protocol (FHRP) enables multiple physical interfaces to present a virtual IP address (VIP) in a redundant manner. Examples of such protocols include:
+>
+> * Hot Standby Router Protocol (HSRP)
+> * Virtual Router Redundancy Protocol (VRRP)
+> * Common Address Redundancy Protocol (CARP)
+> * Gateway Load Balancing Protocol (GLBP)
+>
+> NetBox models these redundancy groups by protocol and group ID. Each group may optionally be assigned an authentication type and key. (Note that the authentication key is stored as a plaintext value in NetBox.) Each group may be assigned one or more virtual IPv4 and/or IPv6 addresses.
+
+Assign the virtual IP addresses with a ` + "`netbox_ip_address`" + ` of ` + "`object_type`" + ` ` + "`ipam.fhrpgroup`" + ` and the member interfaces with ` + "`netbox_fhrp_group_assignment`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(fhrpGroupProtocols, false),
+				Description:  "One of `vrrp2`, `vrrp3`, `carp`, `clusterxl`, `hsrp`, `glbp` or `other`.",
+			},
+			"group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+				Description:  "The protocol specific group ID, e.g. the virtual router ID of VRRP.",
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"plaintext", "md5"}, false),
+				Description:  "One of `plaintext` or `md5`.",
+			},
+			"auth_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"ip_address_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the virtual IP addresses assigned to this group.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxFhrpGroupCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params := ipam.NewIpamFhrpGroupsCreateParams().WithData(getFhrpGroupData(api, d))
+	res, err := api.Ipam.IpamFhrpGroupsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFhrpGroupRead(d, m)
+}
+
+func resourceNetboxFhrpGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*ipam.IpamFhrpGroupsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	fhrpGroup := res.GetPayload()
+
+	d.Set("protocol", fhrpGroup.Protocol)
+	d.Set("group_id", fhrpGroup.GroupID)
+	d.Set("auth_type", fhrpGroup.AuthType)
+	d.Set("auth_key", fhrpGroup.AuthKey)
+	d.Set("description", fhrpGroup.Description)
+	d.Set(tagsKey, getManagedTagList(api, d, fhrpGroup.Tags))
+
+	cf := getManagedCustomFields(api, d, fhrpGroup.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	ipAddressIDs := []int64{}
+	for _, ipAddress := range fhrpGroup.IPAddresses {
+		ipAddressIDs = append(ipAddressIDs, ipAddress.ID)
+	}
+	d.Set("ip_address_ids", ipAddressIDs)
+
+	return nil
+}
+
+func resourceNetboxFhrpGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupsPartialUpdateParams().WithID(id).WithData(getFhrpGroupData(api, d))
+	_, err := api.Ipam.IpamFhrpGroupsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/ipam/fhrp-groups/%d/", id), map[string]string{
+		"auth_type":   "auth_type",
+		"auth_key":    "auth_key",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxFhrpGroupRead(d, m)
+}
+
+func resourceNetboxFhrpGroupDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getFhrpGroupData(api *providerState, d *schema.ResourceData) *models.FHRPGroup {
+	data := &models.FHRPGroup{
+		Protocol:    strToPtr(d.Get("protocol").(string)),
+		GroupID:     int64ToPtr(int64(d.Get("group_id").(int))),
+		AuthType:    d.Get("auth_type").(string),
+		AuthKey:     d.Get("auth_key").(string),
+		Description: d.Get("description").(string),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return data
+}
@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxFhrpGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxFhrpGroupAssignmentCreate,
+		Read:   resourceNetboxFhrpGroupAssignmentRead,
+		Update: resourceNetboxFhrpGroupAssignmentUpdate,
+		Delete: resourceNetboxFhrpGroupAssignmentDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroupassignment/):
+
+> This model is used to apply an FHRP group to a router interface.`,
+
+		Schema: map[string]*schema.Schema{
+			"fhrp_group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"interface_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"dcim.interface", "virtualization.vminterface"}, false),
+				Description:  "The type of the interface given in `interface_id`. One of `dcim.interface` or `virtualization.vminterface`.",
+			},
+			"interface_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+				Description:  "The priority of the interface in the FHRP group.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxFhrpGroupAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsCreateParams().WithData(getFhrpGroupAssignmentData(d))
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFhrpGroupAssignmentRead(d, m)
+}
+
+func resourceNetboxFhrpGroupAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupAssignmentsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*ipam.IpamFhrpGroupAssignmentsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	assignment := res.GetPayload()
+
+	if assignment.Group != nil {
+		d.Set("fhrp_group_id", assignment.Group.ID)
+	}
+	d.Set("interface_type", assignment.InterfaceType)
+	d.Set("interface_id", assignment.InterfaceID)
+	d.Set("priority", assignment.Priority)
+
+	return nil
+}
+
+func resourceNetboxFhrpGroupAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsUpdateParams().WithID(id).WithData(getFhrpGroupAssignmentData(d))
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxFhrpGroupAssignmentRead(d, m)
+}
+
+func resourceNetboxFhrpGroupAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupAssignmentsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getFhrpGroupAssignmentData(d *schema.ResourceData) *models.WritableFHRPGroupAssignment {
+	return &models.WritableFHRPGroupAssignment{
+		Group:         int64ToPtr(int64(d.Get("fhrp_group_id").(int))),
+		InterfaceType: strToPtr(d.Get("interface_type").(string)),
+		InterfaceID:   int64ToPtr(int64(d.Get("interface_id").(int))),
+		Priority:      int64ToPtr(int64(d.Get("priority").(int))),
+	}
+}
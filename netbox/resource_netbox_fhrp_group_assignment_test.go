@@ -0,0 +1,58 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFhrpGroupAssignment_basic(t *testing.T) {
+	testSlug := "fhrp_group_assignment"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxIPAddressFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_fhrp_group" "test" {
+  protocol    = "vrrp2"
+  group_id    = 20
+  description = "%[1]s"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+resource "netbox_fhrp_group_assignment" "test" {
+  fhrp_group_id  = netbox_fhrp_group.test.id
+  interface_type = "virtualization.vminterface"
+  interface_id   = netbox_interface.test.id
+  priority       = 100
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "fhrp_group_id", "netbox_fhrp_group.test", "id"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "interface_type", "virtualization.vminterface"),
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "interface_id", "netbox_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "priority", "100"),
+				),
+			},
+			{
+				Config: setUp + `
+resource "netbox_fhrp_group_assignment" "test" {
+  fhrp_group_id  = netbox_fhrp_group.test.id
+  interface_type = "virtualization.vminterface"
+  interface_id   = netbox_interface.test.id
+  priority       = 50
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "priority", "50"),
+				),
+			},
+			{
+				ResourceName:      "netbox_fhrp_group_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
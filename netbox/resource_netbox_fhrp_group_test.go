@@ -0,0 +1,112 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFhrpGroup_basic(t *testing.T) {
+	testSlug := "fhrp_group_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_fhrp_group" "test" {
+  protocol    = "vrrp3"
+  group_id    = 10
+  auth_type   = "md5"
+  auth_key    = "secret"
+  description = "%[1]s"
+  tags        = [netbox_tag.test.name]
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address   = "10.10.10.1/24"
+  status       = "active"
+  role         = "vrrp"
+  object_type  = "ipam.fhrpgroup"
+  interface_id = netbox_fhrp_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "protocol", "vrrp3"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "group_id", "10"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_type", "md5"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_key", "secret"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_ip_address.test", "object_type", "ipam.fhrpgroup"),
+					resource.TestCheckResourceAttrPair("netbox_ip_address.test", "interface_id", "netbox_fhrp_group.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_fhrp_group" "test" {
+  protocol    = "hsrp"
+  group_id    = 11
+  description = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "protocol", "hsrp"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "group_id", "11"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_type", ""),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_key", ""),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "ip_address_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_fhrp_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_fhrp_group", &resource.Sweeper{
+		Name:         "netbox_fhrp_group",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := ipam.NewIpamFhrpGroupsListParams()
+			res, err := api.Ipam.IpamFhrpGroupsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, fhrpGroup := range res.GetPayload().Results {
+				// FHRP groups have no name, the tests put it into the description instead
+				if strings.HasPrefix(fhrpGroup.Description, testPrefix) {
+					deleteParams := ipam.NewIpamFhrpGroupsDeleteParams().WithID(fhrpGroup.ID)
+					_, err := api.Ipam.IpamFhrpGroupsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted an fhrp group")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxFrontPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxFrontPortTemplateCreate,
+		Read:   resourceNetboxFrontPortTemplateRead,
+		Update: resourceNetboxFrontPortTemplateUpdate,
+		Delete: resourceNetboxFrontPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/frontporttemplate/):
+
+> A template for a front-facing pass-through port that will be created on all instantiations of the parent device type or module type.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instantiated component. On module types, `{module}` is replaced with the position of the module bay.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(passThroughPortTypes, false),
+			},
+			"color_hex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rear_port_template_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rear_port_position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1024),
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxFrontPortTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableFrontPortTemplateFromResourceData(d)
+
+	params := dcim.NewDcimFrontPortTemplatesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimFrontPortTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFrontPortTemplateRead(d, m)
+}
+
+func resourceNetboxFrontPortTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimFrontPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimFrontPortTemplatesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimFrontPortTemplatesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+
+	if template.DeviceType != nil {
+		d.Set("device_type_id", template.DeviceType.ID)
+	} else {
+		d.Set("device_type_id", nil)
+	}
+	if template.ModuleType != nil {
+		d.Set("module_type_id", template.ModuleType.ID)
+	} else {
+		d.Set("module_type_id", nil)
+	}
+	d.Set("name", template.Name)
+	d.Set("type", template.Type.Value)
+	d.Set("color_hex", template.Color)
+	d.Set("rear_port_template_id", template.RearPort.ID)
+	d.Set("rear_port_position", template.RearPortPosition)
+	d.Set("label", template.Label)
+	d.Set("description", template.Description)
+
+	return nil
+}
+
+func resourceNetboxFrontPortTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableFrontPortTemplateFromResourceData(d)
+
+	params := dcim.NewDcimFrontPortTemplatesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimFrontPortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/front-port-templates/%d/", id), map[string]string{
+		"color_hex":   "color",
+		"label":       "label",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxFrontPortTemplateRead(d, m)
+}
+
+func resourceNetboxFrontPortTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimFrontPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimFrontPortTemplatesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableFrontPortTemplateFromResourceData(d *schema.ResourceData) *models.WritableFrontPortTemplate {
+	data := models.WritableFrontPortTemplate{
+		Name:             strToPtr(d.Get("name").(string)),
+		Type:             strToPtr(d.Get("type").(string)),
+		Color:            d.Get("color_hex").(string),
+		RearPort:         int64ToPtr(int64(d.Get("rear_port_template_id").(int))),
+		RearPortPosition: int64(d.Get("rear_port_position").(int)),
+		Label:            d.Get("label").(string),
+		Description:      d.Get("description").(string),
+	}
+
+	if deviceTypeID, ok := d.GetOk("device_type_id"); ok {
+		data.DeviceType = int64ToPtr(int64(deviceTypeID.(int)))
+	}
+	if moduleTypeID, ok := d.GetOk("module_type_id"); ok {
+		data.ModuleType = int64ToPtr(int64(moduleTypeID.(int)))
+	}
+
+	return &data
+}
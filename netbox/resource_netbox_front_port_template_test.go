@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFrontPortTemplate_basic(t *testing.T) {
+
+	testSlug := "front_port_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+
+resource "netbox_rear_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s_rear"
+  type           = "mpo"
+  positions      = 2
+}
+resource "netbox_front_port_template" "test" {
+  device_type_id        = netbox_device_type.test.id
+  name                  = "%[1]s"
+  type                  = "lc"
+  rear_port_template_id = netbox_rear_port_template.test.id
+  rear_port_position    = 2
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "type", "lc"),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "rear_port_position", "2"),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+
+resource "netbox_rear_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s_rear"
+  type           = "mpo"
+  positions      = 2
+}
+resource "netbox_front_port_template" "test" {
+  device_type_id        = netbox_device_type.test.id
+  name                  = "%[1]s"
+  type                  = "lc"
+  rear_port_template_id = netbox_rear_port_template.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "rear_port_position", "1"),
+				),
+			},
+			{
+				ResourceName:      "netbox_front_port_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
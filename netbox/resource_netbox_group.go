@@ -0,0 +1,113 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxGroupCreate,
+		Read:   resourceNetboxGroupRead,
+		Update: resourceNetboxGroupUpdate,
+		Delete: resourceNetboxGroupDelete,
+
+		Description: `:meta:subcategory:Authentication:This resource is used to manage groups of users, which permissions can be assigned to.
+
+The members of a group are either managed with the ` + "`group_ids`" + ` attribute of the ` + "`netbox_user`" + ` resource or with the ` + "`netbox_user_group_assignment`" + ` resource, but never both for the same user.
+
+This resource is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 150),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "Requires Netbox 4.0 or later.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxGroupCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", "/users/groups/", getGroupRequestData(d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxGroupRead(d, m)
+}
+
+func resourceNetboxGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	group, err := genericAPIRequest(api, "GET", fmt.Sprintf("/users/groups/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", group["name"])
+	d.Set("description", group["description"])
+
+	return nil
+}
+
+func resourceNetboxGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/users/groups/%d/", id), getGroupRequestData(d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxGroupRead(d, m)
+}
+
+func resourceNetboxGroupDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/users/groups/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getGroupRequestData returns the request body for creating or updating a group. The description is only sent when it
+// changes, as older Netbox versions do not know it.
+func getGroupRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if d.HasChange("description") {
+		data["description"] = d.Get("description").(string)
+	}
+	return data
+}
@@ -0,0 +1,35 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxGroup_basic(t *testing.T) {
+	testSlug := "group_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_group" "test" {
+  name        = "%s"
+  description = "This is a test"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_group.test", "description", "This is a test"),
+				),
+			},
+			{
+				ResourceName:      "netbox_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxIkePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIkePolicyCreate,
+		Read:   resourceNetboxIkePolicyRead,
+		Update: resourceNetboxIkePolicyUpdate,
+		Delete: resourceNetboxIkePolicyDelete,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikepolicy/):
+
+> An IKE policy is a set of parameters which govern the formation of an IKE security association, including the IKE version, the mode and the proposals offered to the peer.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+				Description:  "The IKE version. One of `1` or `2`.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"aggressive", "main"}, false),
+				Description:  "The IKEv1 mode. One of `aggressive` or `main`.",
+			},
+			"proposal_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the IKE proposals offered by this policy.",
+			},
+			"preshared_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The pre-shared key of the policy. The key is only written to Netbox and never read back, so changes made outside of Terraform are not detected.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxIkePolicyCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_ike_policy requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/vpn/ike-policies/", getIkePolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxIkePolicyRead(d, m)
+}
+
+func resourceNetboxIkePolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	policy, err := genericAPIRequest(api, "GET", fmt.Sprintf("/vpn/ike-policies/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy["name"])
+	if version, ok := policy["version"].(map[string]interface{}); ok {
+		if versionNumber, ok := getGenericInt(version, "value"); ok {
+			d.Set("version", versionNumber)
+		}
+	}
+	if mode, ok := policy["mode"].(map[string]interface{}); ok {
+		d.Set("mode", mode["value"])
+	} else {
+		d.Set("mode", nil)
+	}
+	d.Set("proposal_ids", getGenericNestedObjectIDList(policy, "proposals"))
+	// The pre-shared key is write-only, so the configured value is kept in the state
+	d.Set("description", policy["description"])
+	d.Set("comments", policy["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(policy)))
+
+	cf := getManagedCustomFields(api, d, policy[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxIkePolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/vpn/ike-policies/%d/", id), getIkePolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIkePolicyRead(d, m)
+}
+
+func resourceNetboxIkePolicyDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/vpn/ike-policies/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getIkePolicyRequestData returns the request body for creating or updating an IKE policy.
+func getIkePolicyRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"version":       d.Get("version").(int),
+		"mode":          d.Get("mode").(string),
+		"proposals":     toInt64List(d.Get("proposal_ids")),
+		"preshared_key": d.Get("preshared_key").(string),
+		"description":   d.Get("description").(string),
+		"comments":      d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
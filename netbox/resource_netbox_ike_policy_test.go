@@ -0,0 +1,31 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxIkePolicyRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxIkePolicy().TestResourceData()
+	d.Set("name", "aws-phase1")
+
+	err := resourceNetboxIkePolicyCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
+
+func TestGetIkePolicyRequestData(t *testing.T) {
+	api := &providerState{}
+	d := resourceNetboxIkePolicy().TestResourceData()
+	d.Set("name", "aws-phase1")
+	d.Set("version", 2)
+	d.Set("proposal_ids", schema.NewSet(schema.HashInt, []interface{}{1, 2}))
+	d.Set("preshared_key", "secret")
+
+	data := getIkePolicyRequestData(api, d)
+	assert.Equal(t, 2, data["version"])
+	assert.ElementsMatch(t, []int64{1, 2}, data["proposals"])
+	assert.Equal(t, "secret", data["preshared_key"])
+}
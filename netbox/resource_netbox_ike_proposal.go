@@ -0,0 +1,203 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var ikeAuthenticationMethods = []string{"preshared-keys", "certificates", "rsa-signatures", "dsa-signatures"}
+
+var vpnEncryptionAlgorithms = []string{"aes-128-cbc", "aes-128-gcm", "aes-192-cbc", "aes-192-gcm", "aes-256-cbc", "aes-256-gcm", "3des-cbc", "des-cbc"}
+
+var vpnAuthenticationAlgorithms = []string{"hmac-sha1", "hmac-sha256", "hmac-sha384", "hmac-sha512", "hmac-md5"}
+
+var vpnDiffieHellmanGroups = []int{1, 2, 5, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34}
+
+func resourceNetboxIkeProposal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIkeProposalCreate,
+		Read:   resourceNetboxIkeProposalRead,
+		Update: resourceNetboxIkeProposalUpdate,
+		Delete: resourceNetboxIkeProposalDelete,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikeproposal/):
+
+> The Internet Key Exchange (IKE) protocol is used to establish secure communications between two peers. An IKE proposal defines the authentication and encryption parameters of the phase 1 security association.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"authentication_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ikeAuthenticationMethods, false),
+				Description:  "One of `preshared-keys`, `certificates`, `rsa-signatures` or `dsa-signatures`.",
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(vpnEncryptionAlgorithms, false),
+				Description:  "One of `aes-128-cbc`, `aes-128-gcm`, `aes-192-cbc`, `aes-192-gcm`, `aes-256-cbc`, `aes-256-gcm`, `3des-cbc` or `des-cbc`.",
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(vpnAuthenticationAlgorithms, false),
+				Description:  "One of `hmac-sha1`, `hmac-sha256`, `hmac-sha384`, `hmac-sha512` or `hmac-md5`. May be omitted for encryption algorithms with integrated authentication such as AES-GCM on Netbox 4.0 or later.",
+			},
+			"group": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(vpnDiffieHellmanGroups),
+				Description:  "The Diffie-Hellman group number, one of `1`, `2`, `5` or `14` to `34`.",
+			},
+			"sa_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The lifetime of the security association in seconds.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxIkeProposalCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_ike_proposal requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/vpn/ike-proposals/", getIkeProposalRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxIkeProposalRead(d, m)
+}
+
+func resourceNetboxIkeProposalRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	proposal, err := genericAPIRequest(api, "GET", fmt.Sprintf("/vpn/ike-proposals/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", proposal["name"])
+	if method, ok := proposal["authentication_method"].(map[string]interface{}); ok {
+		d.Set("authentication_method", method["value"])
+	}
+	if algorithm, ok := proposal["encryption_algorithm"].(map[string]interface{}); ok {
+		d.Set("encryption_algorithm", algorithm["value"])
+	}
+	if algorithm, ok := proposal["authentication_algorithm"].(map[string]interface{}); ok {
+		d.Set("authentication_algorithm", algorithm["value"])
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+	if group, ok := proposal["group"].(map[string]interface{}); ok {
+		if groupNumber, ok := getGenericInt(group, "value"); ok {
+			d.Set("group", groupNumber)
+		}
+	}
+	if lifetime, ok := getGenericInt(proposal, "sa_lifetime"); ok {
+		d.Set("sa_lifetime", lifetime)
+	} else {
+		d.Set("sa_lifetime", nil)
+	}
+	d.Set("description", proposal["description"])
+	d.Set("comments", proposal["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(proposal)))
+
+	cf := getManagedCustomFields(api, d, proposal[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxIkeProposalUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/vpn/ike-proposals/%d/", id), getIkeProposalRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIkeProposalRead(d, m)
+}
+
+func resourceNetboxIkeProposalDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/vpn/ike-proposals/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getIkeProposalRequestData returns the request body for creating or updating an IKE proposal. Unset optional fields
+// are sent as empty or null, so removing them from the configuration clears them.
+func getIkeProposalRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":                     d.Get("name").(string),
+		"authentication_method":    d.Get("authentication_method").(string),
+		"encryption_algorithm":     d.Get("encryption_algorithm").(string),
+		"authentication_algorithm": d.Get("authentication_algorithm").(string),
+		"group":                    d.Get("group").(int),
+		"sa_lifetime":              nil,
+		"description":              d.Get("description").(string),
+		"comments":                 d.Get("comments").(string),
+	}
+
+	if lifetime, ok := d.GetOk("sa_lifetime"); ok {
+		data["sa_lifetime"] = lifetime.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
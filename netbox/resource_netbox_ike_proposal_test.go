@@ -0,0 +1,36 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxIkeProposalRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxIkeProposal().TestResourceData()
+	d.Set("name", "aws-phase1")
+	d.Set("authentication_method", "preshared-keys")
+	d.Set("encryption_algorithm", "aes-256-cbc")
+	d.Set("group", 14)
+
+	err := resourceNetboxIkeProposalCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
+
+func TestGetIkeProposalRequestData(t *testing.T) {
+	api := &providerState{}
+	d := resourceNetboxIkeProposal().TestResourceData()
+	d.Set("name", "aws-phase1")
+	d.Set("authentication_method", "preshared-keys")
+	d.Set("encryption_algorithm", "aes-256-gcm")
+	d.Set("group", 14)
+
+	data := getIkeProposalRequestData(api, d)
+	assert.Equal(t, "aws-phase1", data["name"])
+	assert.Equal(t, 14, data["group"])
+	// AES-GCM does not need a separate authentication algorithm
+	assert.Equal(t, "", data["authentication_algorithm"])
+	assert.Contains(t, data, "sa_lifetime")
+	assert.Nil(t, data["sa_lifetime"])
+}
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,6 +20,7 @@ func resourceNetboxInterface() *schema.Resource {
 		ReadContext:   resourceNetboxInterfaceRead,
 		UpdateContext: resourceNetboxInterfaceUpdate,
 		DeleteContext: resourceNetboxInterfaceDelete,
+		CustomizeDiff: resourceNetboxInterfaceCustomizeDiff,
 
 		Description: `:meta:subcategory:Virtualization:From the [official documentation](https://docs.netbox.dev/en/stable/features/virtualization/#interfaces):
 
@@ -46,12 +47,24 @@ func resourceNetboxInterface() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.IsMACAddress,
+				StateFunc:    normalizeMACAddress,
 				ForceNew:     true,
 			},
+			"primary_mac_address_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the primary MAC address of the interface. Only available on Netbox 4.2 and later. Use the `is_primary` attribute of `netbox_mac_address` to set it.",
+			},
+			"vlan_translation_policy_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the VLAN translation policy of the interface. Requires Netbox 4.2 or later.",
+			},
 			"mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(validModes, false),
+				Description:  "The 802.1Q mode of the interface. One of `access`, `tagged` or `tagged-all`.",
 			},
 			"mtu": {
 				Type:         schema.TypeInt,
@@ -63,17 +76,20 @@ func resourceNetboxInterface() *schema.Resource {
 				Optional:   true,
 				Deprecated: "This attribute is not supported by netbox any longer. It will be removed in future versions of this provider.",
 			},
-			tagsKey: tagsSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 			"tagged_vlans": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				Description: "The IDs of the tagged VLANs. Only valid if `mode` is `tagged`.",
 			},
 			"untagged_vlan": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the untagged VLAN. Requires `mode` to be set.",
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -83,7 +99,7 @@ func resourceNetboxInterface() *schema.Resource {
 }
 
 func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -116,6 +132,7 @@ func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 	if untaggedVlan, ok := d.Get("untagged_vlan").(int); ok && untaggedVlan != 0 {
 		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan))
 	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 	params := virtualization.NewVirtualizationInterfacesCreateParams().WithData(&data)
 
 	res, err := api.Virtualization.VirtualizationInterfacesCreate(params, nil)
@@ -125,11 +142,17 @@ func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	// The VLAN translation policy is not part of the generated client
+	err = setInterfaceVlanTranslationPolicy(api, d, fmt.Sprintf("/virtualization/interfaces/%d/", res.GetPayload().ID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
 func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	var diags diag.Diagnostics
@@ -154,22 +177,42 @@ func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("enabled", iface.Enabled)
 	d.Set("mac_address", iface.MacAddress)
 	d.Set("mtu", iface.Mtu)
-	d.Set(tagsKey, getTagListFromNestedTagList(iface.Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, iface.Tags))
+
+	cf := getManagedCustomFields(api, d, iface.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	d.Set("tagged_vlans", getIDsFromNestedVLAN(iface.TaggedVlans))
 	d.Set("virtual_machine_id", iface.VirtualMachine.ID)
 
 	if iface.Mode != nil {
 		d.Set("mode", iface.Mode.Value)
+	} else {
+		d.Set("mode", nil)
 	}
 	if iface.UntaggedVlan != nil {
 		d.Set("untagged_vlan", iface.UntaggedVlan.ID)
+	} else {
+		d.Set("untagged_vlan", nil)
+	}
+
+	// The primary MAC address is not part of the generated client
+	err = readPrimaryMACAddressID(api, d, fmt.Sprintf("/virtualization/interfaces/%d/", id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = readInterfaceVlanTranslationPolicy(api, d, fmt.Sprintf("/virtualization/interfaces/%d/", id))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
 }
 
 func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -204,10 +247,10 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		mtu := int64(d.Get("mtu").(int))
 		data.Mtu = &mtu
 	}
-	if d.HasChange("untagged_vlan") {
-		untaggedvlan := int64(d.Get("untagged_vlan").(int))
-		data.UntaggedVlan = &untaggedvlan
+	if untaggedVlan, ok := d.GetOk("untagged_vlan"); ok {
+		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan.(int)))
 	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := virtualization.NewVirtualizationInterfacesPartialUpdateParams().WithID(id).WithData(&data)
 	_, err := api.Virtualization.VirtualizationInterfacesPartialUpdate(params, nil)
@@ -215,11 +258,24 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/virtualization/interfaces/%d/", id), map[string]string{
+		"mode":          "mode",
+		"untagged_vlan": "untagged_vlan",
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setInterfaceVlanTranslationPolicy(api, d, fmt.Sprintf("/virtualization/interfaces/%d/", id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
 func resourceNetboxInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationInterfacesDeleteParams().WithID(id)
@@ -231,6 +287,34 @@ func resourceNetboxInterfaceDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+func resourceNetboxInterfaceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	err := validateInterfaceMode(d)
+	if err != nil {
+		return err
+	}
+	return validateInterfaceVlanTranslationPolicy(d, m)
+}
+
+// validateInterfaceMode checks the VLANs of an interface against its 802.1Q mode during plan, as Netbox would reject
+// them only during apply. Tagged VLANs require the mode tagged and an untagged VLAN requires any mode. The raw config
+// is used, so VLANs that are created in the same apply are validated as well.
+func validateInterfaceMode(d *schema.ResourceDiff) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !d.NewValueKnown("mode") {
+		return nil
+	}
+	mode := d.Get("mode").(string)
+
+	taggedVlans := config.GetAttr("tagged_vlans")
+	if taggedVlans.IsKnown() && !taggedVlans.IsNull() && taggedVlans.LengthInt() > 0 && mode != "tagged" {
+		return fmt.Errorf("tagged_vlans can only be set if mode is tagged, got mode %q", mode)
+	}
+	if !config.GetAttr("untagged_vlan").IsNull() && mode == "" {
+		return fmt.Errorf("untagged_vlan can only be set if mode is set")
+	}
+	return nil
+}
+
 func getIDsFromNestedVLAN(nestedvlans []*models.NestedVLAN) []int64 {
 	var vlans []int64
 	for _, vlan := range nestedvlans {
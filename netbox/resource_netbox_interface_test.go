@@ -2,6 +2,7 @@ package netbox
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -88,7 +89,7 @@ resource "netbox_interface" "test2" {
 resource "netbox_interface" "test3" {
   name = "%[1]s_3"
   mode = "tagged-all"
-  tagged_vlans = [netbox_vlan.test1.id, netbox_vlan.test2.id]
+  untagged_vlan = netbox_vlan.test1.id
   virtual_machine_id = netbox_virtual_machine.test.id
 }`, testName)
 }
@@ -168,7 +169,8 @@ func TestAccNetboxInterface_vlans(t *testing.T) {
 					resource.TestCheckResourceAttrPair("netbox_interface.test1", "untagged_vlan", "netbox_vlan.test1", "id"),
 					resource.TestCheckResourceAttrPair("netbox_interface.test2", "untagged_vlan", "netbox_vlan.test1", "id"),
 					resource.TestCheckResourceAttrPair("netbox_interface.test2", "tagged_vlans.0", "netbox_vlan.test2", "id"),
-					resource.TestCheckResourceAttr("netbox_interface.test3", "tagged_vlans.#", "2"),
+					resource.TestCheckResourceAttrPair("netbox_interface.test3", "untagged_vlan", "netbox_vlan.test1", "id"),
+					resource.TestCheckResourceAttr("netbox_interface.test3", "tagged_vlans.#", "0"),
 				),
 			},
 			{
@@ -190,9 +192,42 @@ func TestAccNetboxInterface_vlans(t *testing.T) {
 	})
 }
 
+func TestAccNetboxInterface_modeValidation(t *testing.T) {
+	testSlug := "iface_mode"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxInterfaceFullDependencies(testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_interface" "test" {
+  name = "%[1]s"
+  mode = "access"
+  tagged_vlans = [netbox_vlan.test1.id]
+  virtual_machine_id = netbox_virtual_machine.test.id
+}`, testName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("tagged_vlans can only be set if mode is tagged"),
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_interface" "test" {
+  name = "%[1]s"
+  untagged_vlan = netbox_vlan.test1.id
+  virtual_machine_id = netbox_virtual_machine.test.id
+}`, testName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("untagged_vlan can only be set if mode is set"),
+			},
+		},
+	})
+}
+
 func testAccCheckInterfaceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each interface
 	// is destroyed
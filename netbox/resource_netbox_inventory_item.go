@@ -0,0 +1,266 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxInventoryItemComponentTypes = []string{
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+func resourceNetboxInventoryItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxInventoryItemCreate,
+		Read:   resourceNetboxInventoryItemRead,
+		Update: resourceNetboxInventoryItemUpdate,
+		Delete: resourceNetboxInventoryItemDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/inventoryitem/):
+
+> Inventory items represent hardware components installed within a device, such as a power supply or CPU or line card. They are intended to be used primarily for inventory purposes.
+>
+> Inventory items are hierarchical in nature, such that any individual item may be designated as the parent for other items. For example, an inventory item might be created to represent a line card which houses several SFP optics, each of which exists as a child item within the device. An inventory item may also be associated with a specific component within the same device. For example, you may wish to associate a transceiver with an interface.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent inventory item.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"part_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The manufacturer-assigned part identifier.",
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"discovered": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the item was discovered automatically.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"component_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxInventoryItemComponentTypes, false),
+				RequiredWith: []string{"component_id"},
+				Description:  "The content type of the device component this item is assigned to, e.g. `dcim.interface` for a transceiver.",
+			},
+			"component_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"component_type"},
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxInventoryItemCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableInventoryItemFromResourceData(api, d)
+
+	params := dcim.NewDcimInventoryItemsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimInventoryItemsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxInventoryItemRead(d, m)
+}
+
+func resourceNetboxInventoryItemRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInventoryItemsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimInventoryItemsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimInventoryItemsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	item := res.GetPayload()
+
+	d.Set("device_id", item.Device.ID)
+	d.Set("parent_id", item.Parent)
+	d.Set("name", item.Name)
+	d.Set("label", item.Label)
+	if item.Role != nil {
+		d.Set("role_id", item.Role.ID)
+	} else {
+		d.Set("role_id", nil)
+	}
+	if item.Manufacturer != nil {
+		d.Set("manufacturer_id", item.Manufacturer.ID)
+	} else {
+		d.Set("manufacturer_id", nil)
+	}
+	d.Set("part_id", item.PartID)
+	d.Set("serial", item.Serial)
+	d.Set("asset_tag", item.AssetTag)
+	d.Set("discovered", item.Discovered)
+	d.Set("description", item.Description)
+	d.Set("component_type", item.ComponentType)
+	d.Set("component_id", item.ComponentID)
+	d.Set(tagsKey, getManagedTagList(api, d, item.Tags))
+
+	cf := getManagedCustomFields(api, d, item.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxInventoryItemUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableInventoryItemFromResourceData(api, d)
+
+	params := dcim.NewDcimInventoryItemsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimInventoryItemsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/dcim/inventory-items/%d/", id)
+	err = clearRemovedFields(api, d, path, map[string]string{
+		"parent_id":       "parent",
+		"label":           "label",
+		"role_id":         "role",
+		"manufacturer_id": "manufacturer",
+		"part_id":         "part_id",
+		"serial":          "serial",
+		"discovered":      "discovered",
+		"description":     "description",
+		"component_type":  "component_type",
+		"component_id":    "component_id",
+	})
+	if err != nil {
+		return err
+	}
+
+	// Asset tags are unique, so a removed asset tag has to be cleared with null instead of an empty string
+	if _, ok := d.GetOk("asset_tag"); !ok && d.HasChange("asset_tag") {
+		_, err = genericAPIRequest(api, "PATCH", path, map[string]interface{}{"asset_tag": nil})
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxInventoryItemRead(d, m)
+}
+
+func resourceNetboxInventoryItemDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInventoryItemsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimInventoryItemsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableInventoryItemFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableInventoryItem {
+	data := models.WritableInventoryItem{
+		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:        strToPtr(d.Get("name").(string)),
+		Label:       d.Get("label").(string),
+		PartID:      d.Get("part_id").(string),
+		Serial:      d.Get("serial").(string),
+		Discovered:  d.Get("discovered").(bool),
+		Description: d.Get("description").(string),
+	}
+
+	if parentID, ok := d.GetOk("parent_id"); ok {
+		data.Parent = int64ToPtr(int64(parentID.(int)))
+	}
+	if roleID, ok := d.GetOk("role_id"); ok {
+		data.Role = int64ToPtr(int64(roleID.(int)))
+	}
+	if manufacturerID, ok := d.GetOk("manufacturer_id"); ok {
+		data.Manufacturer = int64ToPtr(int64(manufacturerID.(int)))
+	}
+	if assetTag, ok := d.GetOk("asset_tag"); ok {
+		data.AssetTag = strToPtr(assetTag.(string))
+	}
+	if componentType, ok := d.GetOk("component_type"); ok {
+		data.ComponentType = strToPtr(componentType.(string))
+	}
+	if componentID, ok := d.GetOk("component_id"); ok {
+		data.ComponentID = int64ToPtr(int64(componentID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
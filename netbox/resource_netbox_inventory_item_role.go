@@ -0,0 +1,169 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxInventoryItemRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxInventoryItemRoleCreate,
+		Read:   resourceNetboxInventoryItemRoleRead,
+		Update: resourceNetboxInventoryItemRoleUpdate,
+		Delete: resourceNetboxInventoryItemRoleDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/inventoryitemrole/):
+
+> Inventory items can be organized by functional roles, which are fully customizable by the user. For example, you might create roles for power supplies, fans, interface optics, etc.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"color_hex": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxInventoryItemRoleCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	name := d.Get("name").(string)
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data := &models.InventoryItemRole{
+		Name:        &name,
+		Slug:        &slug,
+		Color:       d.Get("color_hex").(string),
+		Description: d.Get("description").(string),
+		Tags:        tags,
+	}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	params := dcim.NewDcimInventoryItemRolesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimInventoryItemRolesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxInventoryItemRoleRead(d, m)
+}
+
+func resourceNetboxInventoryItemRoleRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInventoryItemRolesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimInventoryItemRolesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimInventoryItemRolesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", res.GetPayload().Name)
+	d.Set("slug", res.GetPayload().Slug)
+	d.Set("color_hex", res.GetPayload().Color)
+	d.Set("description", res.GetPayload().Description)
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	return nil
+}
+
+func resourceNetboxInventoryItemRoleUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.InventoryItemRole{}
+
+	name := d.Get("name").(string)
+
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	data.Slug = &slug
+	data.Name = &name
+	data.Color = d.Get("color_hex").(string)
+	data.Description = d.Get("description").(string)
+
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	params := dcim.NewDcimInventoryItemRolesPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimInventoryItemRolesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxInventoryItemRoleRead(d, m)
+}
+
+func resourceNetboxInventoryItemRoleDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInventoryItemRolesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimInventoryItemRolesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxInventoryItemRole_basic(t *testing.T) {
+
+	testSlug := "inv_item_role_basic"
+	testName := testAccGetTestName(testSlug)
+	randomSlug := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_inventory_item_role" "test" {
+  name = "%s"
+  slug = "%s"
+  color_hex = "111111"
+  description = "%[1]s"
+}`, testName, randomSlug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_inventory_item_role.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item_role.test", "slug", randomSlug),
+					resource.TestCheckResourceAttr("netbox_inventory_item_role.test", "color_hex", "111111"),
+					resource.TestCheckResourceAttr("netbox_inventory_item_role.test", "description", testName),
+				),
+			},
+			{
+				ResourceName:      "netbox_inventory_item_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxInventoryItemRole_defaultSlug(t *testing.T) {
+
+	testSlug := "inv_item_role_defSlug"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_inventory_item_role" "test" {
+  name = "%s"
+  color_hex = "111111"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_inventory_item_role.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item_role.test", "slug", getSlug(testName)),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_inventory_item_role", &resource.Sweeper{
+		Name:         "netbox_inventory_item_role",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimInventoryItemRolesListParams()
+			res, err := api.Dcim.DcimInventoryItemRolesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, role := range res.GetPayload().Results {
+				if strings.HasPrefix(*role.Name, testPrefix) {
+					deleteParams := dcim.NewDcimInventoryItemRolesDeleteParams().WithID(role.ID)
+					_, err := api.Dcim.DcimInventoryItemRolesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted an inventory item role")
+				}
+			}
+			return nil
+		},
+	})
+}
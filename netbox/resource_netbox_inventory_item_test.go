@@ -0,0 +1,127 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxInventoryItemFullDependencies(testName string) string {
+	return testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_inventory_item_role" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_interface" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+  type = "10gbase-x-sfpp"
+}
+
+resource "netbox_inventory_item" "parent" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s_linecard"
+}`, testName)
+}
+
+func TestAccNetboxInventoryItem_basic(t *testing.T) {
+
+	testSlug := "inventory_item_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxInventoryItemFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_inventory_item" "test" {
+  device_id       = netbox_device.test.id
+  parent_id       = netbox_inventory_item.parent.id
+  name            = "%[1]s"
+  label           = "%[1]s"
+  role_id         = netbox_inventory_item_role.test.id
+  manufacturer_id = netbox_manufacturer.test.id
+  part_id         = "SFP-10G-LR"
+  serial          = "%[1]s"
+  asset_tag       = "%[1]s"
+  discovered      = true
+  description     = "%[1]s"
+  component_type  = "dcim.interface"
+  component_id    = netbox_device_interface.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_inventory_item.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item.test", "parent_id", "netbox_inventory_item.parent", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item.test", "role_id", "netbox_inventory_item_role.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item.test", "component_id", "netbox_device_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "part_id", "SFP-10G-LR"),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "serial", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "asset_tag", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "discovered", "true"),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "component_type", "dcim.interface"),
+				),
+			},
+			{
+				Config: testAccNetboxInventoryItemFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_inventory_item" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "parent_id", "0"),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "role_id", "0"),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "manufacturer_id", "0"),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "part_id", ""),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "asset_tag", ""),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "discovered", "false"),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "component_type", ""),
+					resource.TestCheckResourceAttr("netbox_inventory_item.test", "component_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_inventory_item.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_inventory_item", &resource.Sweeper{
+		Name:         "netbox_inventory_item",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimInventoryItemsListParams()
+			res, err := api.Dcim.DcimInventoryItemsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, item := range res.GetPayload().Results {
+				if strings.HasPrefix(*item.Name, testPrefix) {
+					deleteParams := dcim.NewDcimInventoryItemsDeleteParams().WithID(item.ID)
+					_, err := api.Dcim.DcimInventoryItemsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted an inventory item")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,16 +11,19 @@ import (
 
 func resourceNetboxIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxIPAddressCreate,
-		Read:   resourceNetboxIPAddressRead,
-		Update: resourceNetboxIPAddressUpdate,
-		Delete: resourceNetboxIPAddressDelete,
+		Create:        resourceNetboxIPAddressCreate,
+		Read:          resourceNetboxIPAddressRead,
+		Update:        resourceNetboxIPAddressUpdate,
+		Delete:        resourceNetboxIPAddressDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/ipam/ip-addresses/"),
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#ip-addresses):
 
 > An IP address comprises a single host address (either IPv4 or IPv6) and its subnet mask. Its mask should match exactly how the IP address is configured on an interface in the real world.
 >
-> Like a prefix, an IP address can optionally be assigned to a VRF (otherwise, it will appear in the "global" table). IP addresses are automatically arranged under parent prefixes within their respective VRFs according to the IP hierarchy.`,
+> Like a prefix, an IP address can optionally be assigned to a VRF (otherwise, it will appear in the "global" table). IP addresses are automatically arranged under parent prefixes within their respective VRFs according to the IP hierarchy.
+
+IP addresses can be assigned to device interfaces or virtual machine interfaces by setting ` + "`interface_id`" + ` and the matching ` + "`object_type`" + `. Virtual IP addresses are assigned to an FHRP group with the ` + "`object_type`" + ` ` + "`ipam.fhrpgroup`" + `.`,
 
 		Schema: map[string]*schema.Schema{
 			"ip_address": {
@@ -30,8 +32,16 @@ func resourceNetboxIPAddress() *schema.Resource {
 				ValidateFunc: validation.IsCIDR,
 			},
 			"interface_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the interface or FHRP group this IP address is assigned to. The type of the object is given in `object_type`.",
+			},
+			"object_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "virtualization.vminterface",
+				ValidateFunc: validation.StringInSlice([]string{"virtualization.vminterface", "dcim.interface", "ipam.fhrpgroup"}, false),
+				Description:  "The type of the object given in `interface_id`. One of `virtualization.vminterface`, `dcim.interface` or `ipam.fhrpgroup`.",
 			},
 			"vrf_id": {
 				Type:     schema.TypeInt,
@@ -42,15 +52,16 @@ func resourceNetboxIPAddress() *schema.Resource {
 				Optional: true,
 			},
 			"status": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"active", "reserved", "deprecated", "dhcp"}, false),
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: statusDescription("active", "reserved", "deprecated", "dhcp", "slaac"),
 			},
 			"dns_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -59,6 +70,29 @@ func resourceNetboxIPAddress() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"loopback", "secondary", "anycast", "vip", "vrrp", "hsrp", "glbp", "carp"}, false),
+				Description:  "One of `loopback`, `secondary`, `anycast`, `vip`, `vrrp`, `hsrp`, `glbp` or `carp`.",
+			},
+			"nat_inside_address_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the inside IP address of the NAT relationship this IP address is the outside address of.",
+			},
+			"nat_outside_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The outside IP addresses of the NAT relationships this IP address is the inside address of. They are set with `nat_inside_address_id` of the outside IP addresses.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -68,7 +102,7 @@ func resourceNetboxIPAddress() *schema.Resource {
 }
 
 func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableIPAddress{}
 	ipAddress := d.Get("ip_address").(string)
@@ -83,6 +117,8 @@ func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamIPAddressesCreateParams().WithData(&data)
 
 	res, err := api.Ipam.IpamIPAddressesCreate(params, nil)
@@ -96,7 +132,7 @@ func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesReadParams().WithID(id)
@@ -114,6 +150,7 @@ func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
 
 	if res.GetPayload().AssignedObjectID != nil {
 		d.Set("interface_id", res.GetPayload().AssignedObjectID)
+		d.Set("object_type", res.GetPayload().AssignedObjectType)
 	} else {
 		d.Set("interface_id", nil)
 	}
@@ -140,16 +177,36 @@ func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("role", nil)
 	}
 
+	if res.GetPayload().NatInside != nil {
+		d.Set("nat_inside_address_id", res.GetPayload().NatInside.ID)
+	} else {
+		d.Set("nat_inside_address_id", nil)
+	}
+
+	var natOutsideAddresses []map[string]interface{}
+	for _, natOutside := range res.GetPayload().NatOutside {
+		natOutsideAddresses = append(natOutsideAddresses, map[string]interface{}{
+			"id":         natOutside.ID,
+			"ip_address": natOutside.Address,
+		})
+	}
+	d.Set("nat_outside_addresses", natOutsideAddresses)
+
 	d.Set("ip_address", res.GetPayload().Address)
 	d.Set("description", res.GetPayload().Description)
 	d.Set("status", res.GetPayload().Status.Value)
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	return nil
 }
 
 func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
 
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableIPAddress{}
@@ -179,8 +236,7 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	if interfaceID, ok := d.GetOk("interface_id"); ok {
-		// The other possible type is dcim.interface for devices
-		data.AssignedObjectType = strToPtr("virtualization.vminterface")
+		data.AssignedObjectType = strToPtr(d.Get("object_type").(string))
 		data.AssignedObjectID = int64ToPtr(int64(interfaceID.(int)))
 	}
 
@@ -196,8 +252,14 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 		data.Role = role.(string)
 	}
 
+	if natInsideID, ok := d.GetOk("nat_inside_address_id"); ok {
+		data.NatInside = int64ToPtr(int64(natInsideID.(int)))
+	}
+
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Ipam.IpamIPAddressesUpdate(params, nil)
@@ -209,7 +271,7 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxIPAddressDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
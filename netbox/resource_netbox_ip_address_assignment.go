@@ -0,0 +1,117 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxIPAddressAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIPAddressAssignmentCreate,
+		Read:   resourceNetboxIPAddressAssignmentRead,
+		Update: resourceNetboxIPAddressAssignmentUpdate,
+		Delete: resourceNetboxIPAddressAssignmentDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource assigns an existing IP address to a device or virtual machine interface. It allows managing the IP address, e.g. one allocated with ` + "`netbox_available_ip_address`" + `, independently of the interface, for example in separate Terraform states.
+
+Destroying this resource unassigns the IP address, but does not delete it. The ` + "`netbox_ip_address`" + ` or ` + "`netbox_available_ip_address`" + ` managing the IP address must not set ` + "`interface_id`" + ` itself and should ignore changes to ` + "`interface_id`" + ` and ` + "`object_type`" + ` with ` + "`lifecycle { ignore_changes = [interface_id, object_type] }`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"ip_address_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"interface_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"dcim.interface", "virtualization.vminterface"}, false),
+				Description:  "The type of the interface given in `interface_id`. One of `dcim.interface` or `virtualization.vminterface`.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxIPAddressAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id := int64(d.Get("ip_address_id").(int))
+
+	err := setIPAddressAssignment(api, id, d.Get("object_type"), d.Get("interface_id"))
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxIPAddressAssignmentRead(d, m)
+}
+
+func resourceNetboxIPAddressAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	ipAddress, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/ip-addresses/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	objectType, _ := ipAddress["assigned_object_type"].(string)
+	interfaceID, ok := getGenericInt(ipAddress, "assigned_object_id")
+	if objectType == "" || !ok {
+		// The IP address was unassigned out of band
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ip_address_id", id)
+	d.Set("object_type", objectType)
+	d.Set("interface_id", interfaceID)
+
+	return nil
+}
+
+func resourceNetboxIPAddressAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	err := setIPAddressAssignment(api, id, d.Get("object_type"), d.Get("interface_id"))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIPAddressAssignmentRead(d, m)
+}
+
+func resourceNetboxIPAddressAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	err := setIPAddressAssignment(api, id, nil, nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// setIPAddressAssignment assigns the IP address to the given interface. A nil interface unassigns it.
+func setIPAddressAssignment(api *providerState, ipAddressID int64, objectType interface{}, interfaceID interface{}) error {
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/ipam/ip-addresses/%d/", ipAddressID), map[string]interface{}{
+		"assigned_object_type": objectType,
+		"assigned_object_id":   interfaceID,
+	})
+	return err
+}
@@ -0,0 +1,119 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxIPAddressAssignment(t *testing.T) {
+	var patches []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/ipam/ip-addresses/1/" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+			return
+		}
+		if r.Method == "PATCH" {
+			var patch map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&patch)
+			patches = append(patches, patch)
+		}
+		w.Write([]byte(`{"id": 1, "assigned_object_type": "dcim.interface", "assigned_object_id": 5}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	d := resourceNetboxIPAddressAssignment().TestResourceData()
+	d.Set("ip_address_id", 1)
+	d.Set("object_type", "dcim.interface")
+	d.Set("interface_id", 5)
+
+	err = resourceNetboxIPAddressAssignmentCreate(d, api)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", d.Id())
+	assert.Equal(t, 5, d.Get("interface_id"))
+
+	err = resourceNetboxIPAddressAssignmentDelete(d, api)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{
+		{"assigned_object_type": "dcim.interface", "assigned_object_id": float64(5)},
+		{"assigned_object_type": nil, "assigned_object_id": nil},
+	}, patches)
+
+	// Deleting the assignment of an IP address that is already gone is not an error
+	d.SetId("2")
+	err = resourceNetboxIPAddressAssignmentDelete(d, api)
+	assert.NoError(t, err)
+}
+
+func TestAccNetboxIPAddressAssignment_basic(t *testing.T) {
+	testSlug := "ipaddress_assignment"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxIPAddressFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_interface" "test2" {
+  name = "%[1]s2"
+  virtual_machine_id = netbox_virtual_machine.test.id
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address = "1.1.1.4/32"
+  status = "active"
+
+  lifecycle {
+    ignore_changes = [interface_id, object_type]
+  }
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+resource "netbox_ip_address_assignment" "test" {
+  ip_address_id = netbox_ip_address.test.id
+  object_type = "virtualization.vminterface"
+  interface_id = netbox_interface.test.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_ip_address_assignment.test", "ip_address_id", "netbox_ip_address.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_ip_address_assignment.test", "interface_id", "netbox_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_ip_address_assignment.test", "object_type", "virtualization.vminterface"),
+				),
+			},
+			{
+				Config: setUp + `
+resource "netbox_ip_address_assignment" "test" {
+  ip_address_id = netbox_ip_address.test.id
+  object_type = "virtualization.vminterface"
+  interface_id = netbox_interface.test2.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_ip_address_assignment.test", "interface_id", "netbox_interface.test2", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ip_address_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
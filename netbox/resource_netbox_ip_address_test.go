@@ -157,6 +157,49 @@ resource "netbox_ip_address" "test" {
 	})
 }
 
+func TestAccNetboxIPAddress_nat(t *testing.T) {
+	testSlug := "ipaddress_nat"
+	testName := testAccGetTestName(testSlug)
+	config := testAccNetboxIPAddressFullDependencies(testName) + `
+resource "netbox_ip_address" "inside" {
+  ip_address = "10.0.0.1/32"
+  interface_id = netbox_interface.test.id
+  status = "active"
+}
+
+resource "netbox_ip_address" "outside" {
+  ip_address = "1.1.1.3/32"
+  status = "active"
+  nat_inside_address_id = netbox_ip_address.inside.id
+}`
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_ip_address.outside", "nat_inside_address_id", "netbox_ip_address.inside", "id"),
+				),
+			},
+			{
+				// The NAT outside addresses of the inside address are only known after a refresh
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_address.inside", "nat_outside_addresses.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_ip_address.inside", "nat_outside_addresses.0.id", "netbox_ip_address.outside", "id"),
+					resource.TestCheckResourceAttr("netbox_ip_address.inside", "nat_outside_addresses.0.ip_address", "1.1.1.3/32"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ip_address.inside",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_ip_address", &resource.Sweeper{
 		Name:         "netbox_ip_address",
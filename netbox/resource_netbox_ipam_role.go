@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,6 +40,7 @@ func resourceNetboxIpamRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -48,7 +48,7 @@ func resourceNetboxIpamRole() *schema.Resource {
 	}
 }
 func resourceNetboxIpamRoleCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.Role{}
 
 	name := d.Get("name").(string)
@@ -70,6 +70,8 @@ func resourceNetboxIpamRoleCreate(d *schema.ResourceData, m interface{}) error {
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamRolesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamRolesCreate(params, nil)
 	if err != nil {
@@ -81,7 +83,7 @@ func resourceNetboxIpamRoleCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxIpamRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRolesReadParams().WithID(id)
 
@@ -112,11 +114,16 @@ func resourceNetboxIpamRoleRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("description", res.GetPayload().Description)
 	}
 
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxIpamRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.Role{}
 
@@ -139,6 +146,8 @@ func resourceNetboxIpamRoleUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamRolesUpdateParams().WithID(id).WithData(&data)
 	_, err := api.Ipam.IpamRolesUpdate(params, nil)
 	if err != nil {
@@ -148,7 +157,7 @@ func resourceNetboxIpamRoleUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxIpamRoleDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRolesDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamRolesDelete(params, nil)
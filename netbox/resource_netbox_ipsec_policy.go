@@ -0,0 +1,162 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxIpsecPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIpsecPolicyCreate,
+		Read:   resourceNetboxIpsecPolicyRead,
+		Update: resourceNetboxIpsecPolicyUpdate,
+		Delete: resourceNetboxIpsecPolicyDelete,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecpolicy/):
+
+> An IPSec policy defines a set of proposals to be used in the formation of IPSec tunnels. A perfect forward secrecy (PFS) group may optionally also be defined.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"proposal_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the IPsec proposals offered by this policy.",
+			},
+			"pfs_group": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(vpnDiffieHellmanGroups),
+				Description:  "The Diffie-Hellman group for perfect forward secrecy, one of `1`, `2`, `5` or `14` to `34`.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxIpsecPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_ipsec_policy requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/vpn/ipsec-policies/", getIpsecPolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxIpsecPolicyRead(d, m)
+}
+
+func resourceNetboxIpsecPolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	policy, err := genericAPIRequest(api, "GET", fmt.Sprintf("/vpn/ipsec-policies/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy["name"])
+	d.Set("proposal_ids", getGenericNestedObjectIDList(policy, "proposals"))
+	if group, ok := policy["pfs_group"].(map[string]interface{}); ok {
+		if groupNumber, ok := getGenericInt(group, "value"); ok {
+			d.Set("pfs_group", groupNumber)
+		}
+	} else {
+		d.Set("pfs_group", nil)
+	}
+	d.Set("description", policy["description"])
+	d.Set("comments", policy["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(policy)))
+
+	cf := getManagedCustomFields(api, d, policy[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpsecPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/vpn/ipsec-policies/%d/", id), getIpsecPolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIpsecPolicyRead(d, m)
+}
+
+func resourceNetboxIpsecPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/vpn/ipsec-policies/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getIpsecPolicyRequestData returns the request body for creating or updating an IPsec policy.
+func getIpsecPolicyRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"proposals":   toInt64List(d.Get("proposal_ids")),
+		"pfs_group":   nil,
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+	}
+
+	if group, ok := d.GetOk("pfs_group"); ok {
+		data["pfs_group"] = group.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
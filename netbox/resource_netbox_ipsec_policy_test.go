@@ -0,0 +1,34 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxIpsecPolicyRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxIpsecPolicy().TestResourceData()
+	d.Set("name", "aws-phase2")
+
+	err := resourceNetboxIpsecPolicyCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
+
+func TestGetIpsecPolicyRequestData(t *testing.T) {
+	api := &providerState{}
+	d := resourceNetboxIpsecPolicy().TestResourceData()
+	d.Set("name", "aws-phase2")
+	d.Set("proposal_ids", schema.NewSet(schema.HashInt, []interface{}{3}))
+
+	data := getIpsecPolicyRequestData(api, d)
+	assert.Equal(t, []int64{3}, data["proposals"])
+	// Without a PFS group, null is sent to clear it
+	assert.Contains(t, data, "pfs_group")
+	assert.Nil(t, data["pfs_group"])
+
+	d.Set("pfs_group", 14)
+	data = getIpsecPolicyRequestData(api, d)
+	assert.Equal(t, 14, data["pfs_group"])
+}
@@ -0,0 +1,161 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxIpsecProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIpsecProfileCreate,
+		Read:   resourceNetboxIpsecProfileRead,
+		Update: resourceNetboxIpsecProfileUpdate,
+		Delete: resourceNetboxIpsecProfileDelete,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecprofile/):
+
+> An IPSec profile defines an IKE policy, IPSec policy, and IPSec mode used for establishing an IPSec tunnel.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider. A profile is assigned to a tunnel with the ` + "`ipsec_profile_id`" + ` attribute of ` + "`netbox_tunnel`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "esp",
+				ValidateFunc: validation.StringInSlice([]string{"esp", "ah"}, false),
+				Description:  "The IPsec protocol. One of `esp` or `ah`.",
+			},
+			"ike_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ipsec_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxIpsecProfileCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_ipsec_profile requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/vpn/ipsec-profiles/", getIpsecProfileRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxIpsecProfileRead(d, m)
+}
+
+func resourceNetboxIpsecProfileRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	profile, err := genericAPIRequest(api, "GET", fmt.Sprintf("/vpn/ipsec-profiles/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", profile["name"])
+	if mode, ok := profile["mode"].(map[string]interface{}); ok {
+		d.Set("mode", mode["value"])
+	}
+	if policyID, ok := getGenericNestedObjectID(profile, "ike_policy"); ok {
+		d.Set("ike_policy_id", policyID)
+	}
+	if policyID, ok := getGenericNestedObjectID(profile, "ipsec_policy"); ok {
+		d.Set("ipsec_policy_id", policyID)
+	}
+	d.Set("description", profile["description"])
+	d.Set("comments", profile["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(profile)))
+
+	cf := getManagedCustomFields(api, d, profile[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpsecProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/vpn/ipsec-profiles/%d/", id), getIpsecProfileRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIpsecProfileRead(d, m)
+}
+
+func resourceNetboxIpsecProfileDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/vpn/ipsec-profiles/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getIpsecProfileRequestData returns the request body for creating or updating an IPsec profile.
+func getIpsecProfileRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"mode":         d.Get("mode").(string),
+		"ike_policy":   d.Get("ike_policy_id").(int),
+		"ipsec_policy": d.Get("ipsec_policy_id").(int),
+		"description":  d.Get("description").(string),
+		"comments":     d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,18 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxIpsecProfileRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxIpsecProfile().TestResourceData()
+	d.Set("name", "aws")
+	d.Set("ike_policy_id", 1)
+	d.Set("ipsec_policy_id", 2)
+
+	err := resourceNetboxIpsecProfileCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
@@ -0,0 +1,190 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxIpsecProposal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIpsecProposalCreate,
+		Read:   resourceNetboxIpsecProposalRead,
+		Update: resourceNetboxIpsecProposalUpdate,
+		Delete: resourceNetboxIpsecProposalDelete,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecproposal/):
+
+> An IPSec proposal defines a set of parameters used in negotiating security associations for IPSec tunnels. IPSec proposals defined in NetBox can be referenced by IPSec policies, which are in turn employed by IPSec profiles.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(vpnEncryptionAlgorithms, false),
+				Description:  "One of `aes-128-cbc`, `aes-128-gcm`, `aes-192-cbc`, `aes-192-gcm`, `aes-256-cbc`, `aes-256-gcm`, `3des-cbc` or `des-cbc`. At least one of `encryption_algorithm` and `authentication_algorithm` is required.",
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(vpnAuthenticationAlgorithms, false),
+				Description:  "One of `hmac-sha1`, `hmac-sha256`, `hmac-sha384`, `hmac-sha512` or `hmac-md5`.",
+			},
+			"sa_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The lifetime of the security association in seconds.",
+			},
+			"sa_lifetime_data": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The lifetime of the security association in kilobytes.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxIpsecProposalCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_ipsec_proposal requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/vpn/ipsec-proposals/", getIpsecProposalRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxIpsecProposalRead(d, m)
+}
+
+func resourceNetboxIpsecProposalRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	proposal, err := genericAPIRequest(api, "GET", fmt.Sprintf("/vpn/ipsec-proposals/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", proposal["name"])
+	if algorithm, ok := proposal["encryption_algorithm"].(map[string]interface{}); ok {
+		d.Set("encryption_algorithm", algorithm["value"])
+	} else {
+		d.Set("encryption_algorithm", nil)
+	}
+	if algorithm, ok := proposal["authentication_algorithm"].(map[string]interface{}); ok {
+		d.Set("authentication_algorithm", algorithm["value"])
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+	if lifetime, ok := getGenericInt(proposal, "sa_lifetime_seconds"); ok {
+		d.Set("sa_lifetime_seconds", lifetime)
+	} else {
+		d.Set("sa_lifetime_seconds", nil)
+	}
+	if lifetime, ok := getGenericInt(proposal, "sa_lifetime_data"); ok {
+		d.Set("sa_lifetime_data", lifetime)
+	} else {
+		d.Set("sa_lifetime_data", nil)
+	}
+	d.Set("description", proposal["description"])
+	d.Set("comments", proposal["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(proposal)))
+
+	cf := getManagedCustomFields(api, d, proposal[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpsecProposalUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/vpn/ipsec-proposals/%d/", id), getIpsecProposalRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIpsecProposalRead(d, m)
+}
+
+func resourceNetboxIpsecProposalDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/vpn/ipsec-proposals/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getIpsecProposalRequestData returns the request body for creating or updating an IPsec proposal. Unset optional
+// fields are sent as empty or null, so removing them from the configuration clears them.
+func getIpsecProposalRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":                     d.Get("name").(string),
+		"encryption_algorithm":     d.Get("encryption_algorithm").(string),
+		"authentication_algorithm": d.Get("authentication_algorithm").(string),
+		"sa_lifetime_seconds":      nil,
+		"sa_lifetime_data":         nil,
+		"description":              d.Get("description").(string),
+		"comments":                 d.Get("comments").(string),
+	}
+
+	if lifetime, ok := d.GetOk("sa_lifetime_seconds"); ok {
+		data["sa_lifetime_seconds"] = lifetime.(int)
+	}
+	if lifetime, ok := d.GetOk("sa_lifetime_data"); ok {
+		data["sa_lifetime_data"] = lifetime.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
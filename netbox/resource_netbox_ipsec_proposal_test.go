@@ -0,0 +1,31 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxIpsecProposalRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxIpsecProposal().TestResourceData()
+	d.Set("name", "aws-phase2")
+	d.Set("encryption_algorithm", "aes-256-cbc")
+
+	err := resourceNetboxIpsecProposalCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
+
+func TestGetIpsecProposalRequestData(t *testing.T) {
+	api := &providerState{}
+	d := resourceNetboxIpsecProposal().TestResourceData()
+	d.Set("name", "aws-phase2")
+	d.Set("encryption_algorithm", "aes-256-cbc")
+	d.Set("sa_lifetime_seconds", 3600)
+
+	data := getIpsecProposalRequestData(api, d)
+	assert.Equal(t, "aes-256-cbc", data["encryption_algorithm"])
+	assert.Equal(t, 3600, data["sa_lifetime_seconds"])
+	assert.Contains(t, data, "sa_lifetime_data")
+	assert.Nil(t, data["sa_lifetime_data"])
+}
@@ -0,0 +1,217 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// l2vpnVPNAppNetboxVersion is the first Netbox version that serves L2VPNs below /vpn/ instead of /ipam/.
+const l2vpnVPNAppNetboxVersion = "3.7.0"
+
+// getL2VPNAPIPath returns the path of the given L2VPN endpoint for the Netbox version of the provider.
+func getL2VPNAPIPath(api *providerState, endpoint string) string {
+	if api.hasNetboxVersion(l2vpnVPNAppNetboxVersion) {
+		return "/vpn/" + endpoint
+	}
+	return "/ipam/" + endpoint
+}
+
+func resourceNetboxL2VPN() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxL2VPNCreate,
+		Read:          resourceNetboxL2VPNRead,
+		Update:        resourceNetboxL2VPNUpdate,
+		Delete:        resourceNetboxL2VPNDelete,
+		CustomizeDiff: resourceNetboxL2VPNCustomizeDiff,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpn/):
+
+> A L2VPN object is NetBox is a representation of a layer 2 bridge technology such as VXLAN, VPLS, or EPL. Each L2VPN can be identified by name as well as by an optional unique identifier (VNI would be an example). Once created, L2VPNs can be terminated to interfaces and VLANs.
+
+Netbox moved L2VPNs from the IPAM to the VPN API in version 3.7, so this resource uses the generic API of the provider to address the endpoint matching the Netbox version. The terminations of the L2VPN are managed with ` + "`netbox_l2vpn_termination`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The L2VPN technology, e.g. `vxlan`, `vxlan-evpn`, `mpls-evpn`, `vpls`, `vpws` or `epl`. The type is validated against the types of Netbox during plan.",
+			},
+			"identifier": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The numeric identifier of the L2VPN, e.g. a VNI or VC ID.",
+			},
+			"import_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the route targets imported by the L2VPN.",
+			},
+			"export_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the route targets exported by the L2VPN.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxL2VPNCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", getL2VPNAPIPath(api, "l2vpns/"), getL2VPNRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxL2VPNRead(d, m)
+}
+
+func resourceNetboxL2VPNRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	l2vpn, err := genericAPIRequest(api, "GET", fmt.Sprintf("%s%d/", getL2VPNAPIPath(api, "l2vpns/"), id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", l2vpn["name"])
+	d.Set("slug", l2vpn["slug"])
+	if l2vpnType, ok := l2vpn["type"].(map[string]interface{}); ok {
+		d.Set("type", l2vpnType["value"])
+	}
+	if identifier, ok := getGenericInt(l2vpn, "identifier"); ok {
+		d.Set("identifier", identifier)
+	} else {
+		d.Set("identifier", nil)
+	}
+	d.Set("import_target_ids", getGenericNestedObjectIDList(l2vpn, "import_targets"))
+	d.Set("export_target_ids", getGenericNestedObjectIDList(l2vpn, "export_targets"))
+	if tenantID, ok := getGenericNestedObjectID(l2vpn, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", l2vpn["description"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(l2vpn)))
+
+	cf := getManagedCustomFields(api, d, l2vpn[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxL2VPNUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%s%d/", getL2VPNAPIPath(api, "l2vpns/"), id), getL2VPNRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxL2VPNRead(d, m)
+}
+
+func resourceNetboxL2VPNDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%s%d/", getL2VPNAPIPath(api, "l2vpns/"), id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxL2VPNCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	api, ok := m.(*providerState)
+	if !ok || api == nil {
+		return nil
+	}
+	return validateChoiceAttribute(d, m, getL2VPNAPIPath(api, "l2vpns/"), "type", "type")
+}
+
+// getL2VPNRequestData returns the request body for creating or updating a L2VPN. Unset references are sent as null,
+// so removing them from the configuration clears them.
+func getL2VPNRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	name := d.Get("name").(string)
+	slug := getSlug(name)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := map[string]interface{}{
+		"name":           name,
+		"slug":           slug,
+		"type":           d.Get("type").(string),
+		"identifier":     nil,
+		"import_targets": toInt64List(d.Get("import_target_ids")),
+		"export_targets": toInt64List(d.Get("export_target_ids")),
+		"tenant":         nil,
+		"description":    d.Get("description").(string),
+	}
+
+	if identifier, ok := d.GetOk("identifier"); ok {
+		data["identifier"] = identifier.(int)
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
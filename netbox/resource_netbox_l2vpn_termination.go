@@ -0,0 +1,136 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxL2VPNTermination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxL2VPNTerminationCreate,
+		Read:   resourceNetboxL2VPNTerminationRead,
+		Update: resourceNetboxL2VPNTerminationUpdate,
+		Delete: resourceNetboxL2VPNTerminationDelete,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpntermination/):
+
+> A L2VPN termination is the attachment of a L2VPN to an interface or VLAN.
+
+Like ` + "`netbox_l2vpn`" + `, this resource uses the generic API of the provider to address the endpoint matching the Netbox version.`,
+
+		Schema: map[string]*schema.Schema{
+			"l2vpn_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ipam.vlan", "dcim.interface", "virtualization.vminterface"}, false),
+				Description:  "The type of the object given in `object_id`. One of `ipam.vlan`, `dcim.interface` or `virtualization.vminterface`.",
+			},
+			"object_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the VLAN or interface that terminates the L2VPN.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxL2VPNTerminationCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", getL2VPNAPIPath(api, "l2vpn-terminations/"), getL2VPNTerminationRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxL2VPNTerminationRead(d, m)
+}
+
+func resourceNetboxL2VPNTerminationRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	termination, err := genericAPIRequest(api, "GET", fmt.Sprintf("%s%d/", getL2VPNAPIPath(api, "l2vpn-terminations/"), id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if l2vpnID, ok := getGenericNestedObjectID(termination, "l2vpn"); ok {
+		d.Set("l2vpn_id", l2vpnID)
+	}
+	d.Set("object_type", termination["assigned_object_type"])
+	if objectID, ok := getGenericInt(termination, "assigned_object_id"); ok {
+		d.Set("object_id", objectID)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(termination)))
+
+	cf := getManagedCustomFields(api, d, termination[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxL2VPNTerminationUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%s%d/", getL2VPNAPIPath(api, "l2vpn-terminations/"), id), getL2VPNTerminationRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxL2VPNTerminationRead(d, m)
+}
+
+func resourceNetboxL2VPNTerminationDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%s%d/", getL2VPNAPIPath(api, "l2vpn-terminations/"), id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getL2VPNTerminationRequestData returns the request body for creating or updating a L2VPN termination.
+func getL2VPNTerminationRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"l2vpn":                d.Get("l2vpn_id").(int),
+		"assigned_object_type": d.Get("object_type").(string),
+		"assigned_object_id":   d.Get("object_id").(int),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
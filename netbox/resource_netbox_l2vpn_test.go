@@ -0,0 +1,114 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetL2VPNAPIPath(t *testing.T) {
+	assert.Equal(t, "/ipam/l2vpns/", getL2VPNAPIPath(&providerState{netboxVersion: "3.4.3"}, "l2vpns/"))
+	assert.Equal(t, "/vpn/l2vpns/", getL2VPNAPIPath(&providerState{netboxVersion: "3.7.0"}, "l2vpns/"))
+	assert.Equal(t, "/vpn/l2vpn-terminations/", getL2VPNAPIPath(&providerState{netboxVersion: "4.1.0"}, "l2vpn-terminations/"))
+}
+
+func TestAccNetboxL2VPN_basic(t *testing.T) {
+	testSlug := "l2vpn_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid  = 778
+}
+
+resource "netbox_l2vpn" "test" {
+  name        = "%[1]s"
+  type        = "vxlan"
+  identifier  = 10778
+  tenant_id   = netbox_tenant.test.id
+  description = "overlay"
+}
+
+resource "netbox_l2vpn_termination" "test" {
+  l2vpn_id    = netbox_l2vpn.test.id
+  object_type = "ipam.vlan"
+  object_id   = netbox_vlan.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vxlan"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "identifier", "10778"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "description", "overlay"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "l2vpn_id", "netbox_l2vpn.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "object_type", "ipam.vlan"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "object_id", "netbox_vlan.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_l2vpn" "test" {
+  name = "%[1]s"
+  type = "vxlan-evpn"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vxlan-evpn"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "identifier", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tenant_id", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_l2vpn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_l2vpn", &resource.Sweeper{
+		Name:         "netbox_l2vpn",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := ipam.NewIpamL2vpnsListParams()
+			res, err := api.Ipam.IpamL2vpnsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, l2vpn := range res.GetPayload().Results {
+				if strings.HasPrefix(*l2vpn.Name, testPrefix) {
+					deleteParams := ipam.NewIpamL2vpnsDeleteParams().WithID(l2vpn.ID)
+					_, err := api.Ipam.IpamL2vpnsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a l2vpn")
+				}
+			}
+			return nil
+		},
+	})
+}
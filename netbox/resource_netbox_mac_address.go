@@ -0,0 +1,257 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// macAddressMinimumNetboxVersion is the first Netbox version with MAC addresses as standalone objects.
+const macAddressMinimumNetboxVersion = "4.2.0"
+
+func resourceNetboxMACAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxMACAddressCreate,
+		Read:   resourceNetboxMACAddressRead,
+		Update: resourceNetboxMACAddressUpdate,
+		Delete: resourceNetboxMACAddressDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/macaddress/):
+
+> A MAC address object in NetBox comprises a single Ethernet link layer address, and represents a MAC address as reported by or assigned to a network interface. MAC addresses can be assigned to device and virtual machine interfaces. A MAC address can be specified as the primary MAC address for a given device or VM interface.
+
+This resource requires Netbox 4.2 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"mac_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsMACAddress,
+				StateFunc:    normalizeMACAddress,
+			},
+			"assigned_object_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"dcim.interface", "virtualization.vminterface"}, false),
+				RequiredWith: []string{"assigned_object_id"},
+				Description:  "One of `dcim.interface` or `virtualization.vminterface`.",
+			},
+			"assigned_object_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"assigned_object_type"},
+			},
+			"is_primary": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"assigned_object_id"},
+				Description:  "If true, this MAC address is set as the primary MAC address of the assigned interface. The primary MAC address is managed here rather than on the interface to avoid a dependency cycle between the interface and its MAC address.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxMACAddressCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(macAddressMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_mac_address requires Netbox %s or later, but the Netbox version is %s", macAddressMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/dcim/mac-addresses/", getMACAddressRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	if d.Get("is_primary").(bool) {
+		err = setPrimaryMACAddress(api, d.Get("assigned_object_type").(string), int64(d.Get("assigned_object_id").(int)), &id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxMACAddressRead(d, m)
+}
+
+func resourceNetboxMACAddressRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	macAddress, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/mac-addresses/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("mac_address", macAddress["mac_address"])
+	d.Set("description", macAddress["description"])
+	d.Set("comments", macAddress["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(macAddress)))
+
+	cf := getManagedCustomFields(api, d, macAddress[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	assignedObjectType, _ := macAddress["assigned_object_type"].(string)
+	assignedObjectIDValue, ok := macAddress["assigned_object_id"].(json.Number)
+	if assignedObjectType == "" || !ok {
+		d.Set("assigned_object_type", nil)
+		d.Set("assigned_object_id", nil)
+		d.Set("is_primary", false)
+		return nil
+	}
+	assignedObjectID, _ := assignedObjectIDValue.Int64()
+	d.Set("assigned_object_type", assignedObjectType)
+	d.Set("assigned_object_id", assignedObjectID)
+
+	primaryID, err := getPrimaryMACAddressID(api, assignedObjectType, assignedObjectID)
+	if err != nil {
+		return err
+	}
+	d.Set("is_primary", primaryID == id)
+
+	return nil
+}
+
+func resourceNetboxMACAddressUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	// The primary MAC address has to be unset before the MAC address can be moved to another interface
+	if d.HasChanges("assigned_object_type", "assigned_object_id", "is_primary") {
+		oldType, _ := d.GetChange("assigned_object_type")
+		oldID, _ := d.GetChange("assigned_object_id")
+		if oldType.(string) != "" {
+			primaryID, err := getPrimaryMACAddressID(api, oldType.(string), int64(oldID.(int)))
+			if err != nil && !isGenericAPINotFound(err) {
+				return err
+			}
+			if err == nil && primaryID == id {
+				err = setPrimaryMACAddress(api, oldType.(string), int64(oldID.(int)), nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/dcim/mac-addresses/%d/", id), getMACAddressRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	if d.Get("is_primary").(bool) {
+		err = setPrimaryMACAddress(api, d.Get("assigned_object_type").(string), int64(d.Get("assigned_object_id").(int)), &id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxMACAddressRead(d, m)
+}
+
+func resourceNetboxMACAddressDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/dcim/mac-addresses/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getMACAddressRequestData returns the request body for creating or updating a MAC address. All fields are
+// always sent, so removed attributes are cleared as well.
+func getMACAddressRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"mac_address":          d.Get("mac_address").(string),
+		"assigned_object_type": nil,
+		"assigned_object_id":   nil,
+		"description":          d.Get("description").(string),
+		"comments":             d.Get("comments").(string),
+	}
+
+	if assignedObjectType, ok := d.GetOk("assigned_object_type"); ok {
+		data["assigned_object_type"] = assignedObjectType.(string)
+		data["assigned_object_id"] = d.Get("assigned_object_id").(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
+
+// getPrimaryMACAddressID returns the ID of the primary MAC address of the given interface, or 0 if it has none.
+func getPrimaryMACAddressID(api *providerState, interfaceType string, interfaceID int64) (int64, error) {
+	path, err := getObjectAPIPath(interfaceType, interfaceID)
+	if err != nil {
+		return 0, err
+	}
+	iface, err := genericAPIRequest(api, "GET", path, nil)
+	if err != nil {
+		return 0, err
+	}
+	primaryID, _ := getGenericNestedObjectID(iface, "primary_mac_address")
+	return primaryID, nil
+}
+
+// setPrimaryMACAddress sets the primary MAC address of the given interface. A nil ID unsets it.
+func setPrimaryMACAddress(api *providerState, interfaceType string, interfaceID int64, macAddressID *int64) error {
+	path, err := getObjectAPIPath(interfaceType, interfaceID)
+	if err != nil {
+		return err
+	}
+	_, err = genericAPIRequest(api, "PATCH", path, map[string]interface{}{"primary_mac_address": macAddressID})
+	return err
+}
+
+// readPrimaryMACAddressID sets the primary_mac_address_id attribute of an interface resource from the
+// interface at the given path. It is a no-op on Netbox versions without MAC address objects.
+func readPrimaryMACAddressID(api *providerState, d *schema.ResourceData, path string) error {
+	if !api.hasNetboxVersion(macAddressMinimumNetboxVersion) {
+		return nil
+	}
+	iface, err := genericAPIRequest(api, "GET", path, nil)
+	if err != nil {
+		return err
+	}
+	if primaryID, ok := getGenericNestedObjectID(iface, "primary_mac_address"); ok {
+		d.Set("primary_mac_address_id", primaryID)
+	} else {
+		d.Set("primary_mac_address_id", nil)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxMACAddressRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.4.3"}
+	d := resourceNetboxMACAddress().TestResourceData()
+	d.Set("mac_address", "00:11:22:33:44:55")
+
+	err := resourceNetboxMACAddressCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+}
+
+func TestReadPrimaryMACAddressID(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/dcim/interfaces/1/":
+			w.Write([]byte(`{"id": 1, "primary_mac_address": {"id": 7, "mac_address": "00:11:22:33:44:55"}}`))
+		default:
+			w.Write([]byte(`{"id": 2, "primary_mac_address": null}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	cases := []struct {
+		netboxVersion string
+		path          string
+		expected      interface{}
+	}{
+		{"4.2.0", "/dcim/interfaces/1/", 7},
+		{"4.2.0", "/dcim/interfaces/2/", 0},
+		// Older versions do not have MAC address objects, so the interface is not requested at all
+		{"3.4.3", "/dcim/interfaces/1/", 0},
+	}
+	for _, c := range cases {
+		api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI), netboxVersion: c.netboxVersion}
+		d := schema.TestResourceDataRaw(t, resourceNetboxDeviceInterface().Schema, map[string]interface{}{})
+
+		err = readPrimaryMACAddressID(api, d, c.path)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, d.Get("primary_mac_address_id"))
+	}
+}
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +31,7 @@ func resourceNetboxManufacturer() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 30),
 			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -40,7 +40,7 @@ func resourceNetboxManufacturer() *schema.Resource {
 }
 
 func resourceNetboxManufacturerCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.Manufacturer{}
 
@@ -57,6 +57,8 @@ func resourceNetboxManufacturerCreate(d *schema.ResourceData, m interface{}) err
 
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := dcim.NewDcimManufacturersCreateParams().WithData(&data)
 
 	res, err := api.Dcim.DcimManufacturersCreate(params, nil)
@@ -70,7 +72,7 @@ func resourceNetboxManufacturerCreate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxManufacturerRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimManufacturersReadParams().WithID(id)
 
@@ -89,11 +91,16 @@ func resourceNetboxManufacturerRead(d *schema.ResourceData, m interface{}) error
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
 
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxManufacturerUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.Manufacturer{}
@@ -111,6 +118,8 @@ func resourceNetboxManufacturerUpdate(d *schema.ResourceData, m interface{}) err
 
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := dcim.NewDcimManufacturersPartialUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimManufacturersPartialUpdate(params, nil)
@@ -122,7 +131,7 @@ func resourceNetboxManufacturerUpdate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxManufacturerDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimManufacturersDeleteParams().WithID(id)
@@ -0,0 +1,192 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxModule() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxModuleCreate,
+		Read:          resourceNetboxModuleRead,
+		Update:        resourceNetboxModuleUpdate,
+		Delete:        resourceNetboxModuleDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/dcim/modules/"),
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/module/):
+
+> A module is a field-replaceable hardware component installed within a device which houses its own child components. The most common example is a chassis-based router or switch.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"module_bay_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"module_type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("offline", "active", "planned", "staged", "failed", "decommissioning"),
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"replicate_components": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Automatically populate the components of the module from the templates of the module type. Only used when the module is created.",
+			},
+			"adopt_components": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Adopt already existing components of the device that match the templates of the module type. Only used when the module is created.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxModuleCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	// Neither the status nor the component options are part of the generated client
+	data := getModuleRequestData(api, d)
+	data["replicate_components"] = d.Get("replicate_components").(bool)
+	data["adopt_components"] = d.Get("adopt_components").(bool)
+
+	res, err := genericAPIRequest(api, "POST", "/dcim/modules/", data)
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxModuleRead(d, m)
+}
+
+func resourceNetboxModuleRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModulesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimModulesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimModulesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	module := res.GetPayload()
+
+	d.Set("device_id", module.Device.ID)
+	d.Set("module_bay_id", module.ModuleBay.ID)
+	d.Set("module_type_id", module.ModuleType.ID)
+	d.Set("serial", module.Serial)
+	d.Set("asset_tag", module.AssetTag)
+	d.Set("comments", module.Comments)
+	d.Set(tagsKey, getManagedTagList(api, d, module.Tags))
+
+	cf := getManagedCustomFields(api, d, module.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	// The status is not part of the generated client
+	raw, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/modules/%d/", id), nil)
+	if err != nil {
+		return err
+	}
+	if status, ok := raw["status"].(map[string]interface{}); ok {
+		d.Set("status", status["value"])
+	}
+
+	return nil
+}
+
+func resourceNetboxModuleUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/dcim/modules/%d/", id), getModuleRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxModuleRead(d, m)
+}
+
+func resourceNetboxModuleDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModulesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimModulesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// getModuleRequestData returns the request body for creating or updating a module. All fields are always
+// sent, so removed attributes are cleared as well.
+func getModuleRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"module_bay":  d.Get("module_bay_id").(int),
+		"module_type": d.Get("module_type_id").(int),
+		"status":      d.Get("status").(string),
+		"serial":      d.Get("serial").(string),
+		"asset_tag":   nil,
+		"comments":    d.Get("comments").(string),
+	}
+
+	if assetTag, ok := d.GetOk("asset_tag"); ok {
+		data["asset_tag"] = assetTag.(string)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,145 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxModuleBayTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxModuleBayTemplateCreate,
+		Read:   resourceNetboxModuleBayTemplateRead,
+		Update: resourceNetboxModuleBayTemplateUpdate,
+		Delete: resourceNetboxModuleBayTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/modulebaytemplate/):
+
+> A template for a module bay that will be created on all instantiations of the parent device type.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instantiated component.",
+			},
+			"position": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxModuleBayTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableModuleBayTemplateFromResourceData(d)
+
+	params := dcim.NewDcimModuleBayTemplatesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimModuleBayTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxModuleBayTemplateRead(d, m)
+}
+
+func resourceNetboxModuleBayTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModuleBayTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimModuleBayTemplatesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimModuleBayTemplatesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+
+	d.Set("device_type_id", template.DeviceType.ID)
+	d.Set("name", template.Name)
+	d.Set("position", template.Position)
+	d.Set("label", template.Label)
+	d.Set("description", template.Description)
+
+	return nil
+}
+
+func resourceNetboxModuleBayTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableModuleBayTemplateFromResourceData(d)
+
+	params := dcim.NewDcimModuleBayTemplatesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimModuleBayTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/module-bay-templates/%d/", id), map[string]string{
+		"position":    "position",
+		"label":       "label",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxModuleBayTemplateRead(d, m)
+}
+
+func resourceNetboxModuleBayTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModuleBayTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimModuleBayTemplatesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableModuleBayTemplateFromResourceData(d *schema.ResourceData) *models.WritableModuleBayTemplate {
+	data := models.WritableModuleBayTemplate{
+		DeviceType:  int64ToPtr(int64(d.Get("device_type_id").(int))),
+		Name:        strToPtr(d.Get("name").(string)),
+		Position:    d.Get("position").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	return &data
+}
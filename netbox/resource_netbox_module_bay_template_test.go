@@ -0,0 +1,47 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxModuleBayTemplate_basic(t *testing.T) {
+
+	testSlug := "module_bay_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_module_bay_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+  position       = "1"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_module_bay_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_module_bay_template.test", "position", "1"),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_module_bay_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_module_bay_template.test", "position", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_module_bay_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxModuleFullDependencies(testName string) string {
+	return testAccNetboxDeviceConsolePortFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_module_bay" "test" {
+  device_id = netbox_device.test.id
+  name      = "%[1]s"
+}
+
+resource "netbox_module_type" "test" {
+  manufacturer_id = netbox_manufacturer.test.id
+  model           = "%[1]s"
+}`, testName)
+}
+
+func TestAccNetboxModule_basic(t *testing.T) {
+
+	testSlug := "module_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxModuleFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_module" "test" {
+  device_id      = netbox_device.test.id
+  module_bay_id  = netbox_device_module_bay.test.id
+  module_type_id = netbox_module_type.test.id
+  serial         = "%[1]s"
+  asset_tag      = "%[1]s"
+  comments       = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_module.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_module.test", "module_bay_id", "netbox_device_module_bay.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_module.test", "module_type_id", "netbox_module_type.test", "id"),
+					resource.TestCheckResourceAttr("netbox_module.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_module.test", "serial", testName),
+					resource.TestCheckResourceAttr("netbox_module.test", "asset_tag", testName),
+					resource.TestCheckResourceAttr("netbox_module.test", "comments", testName),
+				),
+			},
+			{
+				Config: testAccNetboxModuleFullDependencies(testName) + `
+resource "netbox_module" "test" {
+  device_id      = netbox_device.test.id
+  module_bay_id  = netbox_device_module_bay.test.id
+  module_type_id = netbox_module_type.test.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_module.test", "serial", ""),
+					resource.TestCheckResourceAttr("netbox_module.test", "asset_tag", ""),
+					resource.TestCheckResourceAttr("netbox_module.test", "comments", ""),
+				),
+			},
+			{
+				ResourceName:            "netbox_module.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replicate_components", "adopt_components"},
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_module", &resource.Sweeper{
+		Name:         "netbox_module",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimModulesListParams()
+			res, err := api.Dcim.DcimModulesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, module := range res.GetPayload().Results {
+				if strings.HasPrefix(module.Serial, testPrefix) {
+					deleteParams := dcim.NewDcimModulesDeleteParams().WithID(module.ID)
+					_, err := api.Dcim.DcimModulesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a module")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxModuleType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxModuleTypeCreate,
+		Read:   resourceNetboxModuleTypeRead,
+		Update: resourceNetboxModuleTypeUpdate,
+		Delete: resourceNetboxModuleTypeDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/moduletype/):
+
+> A module type represents a specific make and model of hardware component which is installable within a device's module bay and has its own child components. For example, consider a chassis-based switch or router with a number of field-replaceable line cards. Each line card has its own model number and includes a certain set of components such as interfaces. Each module type may have a manufacturer, model number, and part number assigned to it.`,
+
+		Schema: map[string]*schema.Schema{
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"part_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxModuleTypeCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritableModuleTypeFromResourceData(api, d)
+
+	params := dcim.NewDcimModuleTypesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimModuleTypesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxModuleTypeRead(d, m)
+}
+
+func resourceNetboxModuleTypeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModuleTypesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimModuleTypesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimModuleTypesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	moduleType := res.GetPayload()
+
+	d.Set("manufacturer_id", moduleType.Manufacturer.ID)
+	d.Set("model", moduleType.Model)
+	d.Set("part_number", moduleType.PartNumber)
+	d.Set("comments", moduleType.Comments)
+	d.Set(tagsKey, getManagedTagList(api, d, moduleType.Tags))
+
+	cf := getManagedCustomFields(api, d, moduleType.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxModuleTypeUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritableModuleTypeFromResourceData(api, d)
+
+	params := dcim.NewDcimModuleTypesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimModuleTypesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/module-types/%d/", id), map[string]string{
+		"part_number": "part_number",
+		"comments":    "comments",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxModuleTypeRead(d, m)
+}
+
+func resourceNetboxModuleTypeDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModuleTypesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimModuleTypesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritableModuleTypeFromResourceData(api *providerState, d *schema.ResourceData) *models.WritableModuleType {
+	data := models.WritableModuleType{
+		Manufacturer: int64ToPtr(int64(d.Get("manufacturer_id").(int))),
+		Model:        strToPtr(d.Get("model").(string)),
+		PartNumber:   d.Get("part_number").(string),
+		Comments:     d.Get("comments").(string),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
@@ -0,0 +1,93 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxModuleType_basic(t *testing.T) {
+
+	testSlug := "module_type_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_module_type" "test" {
+  manufacturer_id = netbox_manufacturer.test.id
+  model           = "%[1]s"
+  part_number     = "%[1]s"
+  comments        = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_module_type.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttr("netbox_module_type.test", "model", testName),
+					resource.TestCheckResourceAttr("netbox_module_type.test", "part_number", testName),
+					resource.TestCheckResourceAttr("netbox_module_type.test", "comments", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_module_type" "test" {
+  manufacturer_id = netbox_manufacturer.test.id
+  model           = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_module_type.test", "part_number", ""),
+					resource.TestCheckResourceAttr("netbox_module_type.test", "comments", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_module_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_module_type", &resource.Sweeper{
+		Name:         "netbox_module_type",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimModuleTypesListParams()
+			res, err := api.Dcim.DcimModuleTypesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, moduleType := range res.GetPayload().Results {
+				if strings.HasPrefix(*moduleType.Model, testPrefix) {
+					deleteParams := dcim.NewDcimModuleTypesDeleteParams().WithID(moduleType.ID)
+					_, err := api.Dcim.DcimModuleTypesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a module type")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,258 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	objectTagsModeMerge   = "merge"
+	objectTagsModeEnforce = "enforce"
+)
+
+func resourceNetboxObjectTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxObjectTagsCreate,
+		Read:   resourceNetboxObjectTagsRead,
+		Update: resourceNetboxObjectTagsUpdate,
+		Delete: resourceNetboxObjectTagsDelete,
+
+		Description: `:meta:subcategory:Extras:This resource attaches tags to an arbitrary Netbox object that is not necessarily managed by Terraform, e.g. to roll out governance tagging across an existing inventory.
+
+The tags are given by their slugs. In ` + "`merge`" + ` mode, only the given tags are managed and all other tags of the object are left untouched. In ` + "`enforce`" + ` mode, the tags of the object are set to exactly the given tags. In both modes, the given tags are removed from the object when this resource is destroyed. Tags that do not exist in Netbox are an error, so that no tags are detached from the object by mistake.`,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(supportedContentTypes(), false),
+				Description:  "The content type of the tagged object, e.g. `dcim.device`.",
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			tagsKey: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:    true,
+				Set:         schema.HashString,
+				Description: "The slugs of the tags to attach to the object.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      objectTagsModeMerge,
+				ValidateFunc: validation.StringInSlice([]string{objectTagsModeMerge, objectTagsModeEnforce}, false),
+				Description:  "One of `merge` or `enforce`.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetboxObjectTagsImport,
+		},
+	}
+}
+
+func resourceNetboxObjectTagsCreate(d *schema.ResourceData, m interface{}) error {
+	contentType := d.Get("content_type").(string)
+	objectID := d.Get("object_id").(int)
+
+	d.SetId(fmt.Sprintf("%s:%d", contentType, objectID))
+
+	return resourceNetboxObjectTagsUpdate(d, m)
+}
+
+func resourceNetboxObjectTagsRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	currentTags, err := getObjectTags(api, d)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	tags := []string{}
+	if d.Get("mode").(string) == objectTagsModeEnforce {
+		tags = getTagSlugListFromNestedTagList(currentTags)
+	} else {
+		// In merge mode, we only track the tags that are given in the configuration
+		managedTags := d.Get(tagsKey).(*schema.Set)
+		for _, tag := range getTagSlugListFromNestedTagList(currentTags) {
+			if managedTags.Contains(tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	d.Set(tagsKey, tags)
+
+	return nil
+}
+
+func resourceNetboxObjectTagsUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	// The default tags of the provider are deliberately not applied here
+	desiredTags, err := getNestedTagListBySlug(api, d.Get(tagsKey).(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	var tags []*models.NestedTag
+	if d.Get("mode").(string) == objectTagsModeEnforce {
+		tags = desiredTags
+	} else {
+		currentTags, err := getObjectTags(api, d)
+		if err != nil {
+			return err
+		}
+
+		// Tags that were removed from the configuration have to be detached from the object
+		removedTags := &schema.Set{F: schema.HashString}
+		if d.HasChange(tagsKey) {
+			oldTags, newTags := d.GetChange(tagsKey)
+			removedTags = oldTags.(*schema.Set).Difference(newTags.(*schema.Set))
+		}
+
+		tags = desiredTags
+		for _, tag := range currentTags {
+			if !removedTags.Contains(*tag.Slug) && !nestedTagListContains(desiredTags, *tag.Slug) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	err = setObjectTags(api, d, tags)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxObjectTagsRead(d, m)
+}
+
+func resourceNetboxObjectTagsDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	currentTags, err := getObjectTags(api, d)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	managedTags := d.Get(tagsKey).(*schema.Set)
+	tags := []*models.NestedTag{}
+	for _, tag := range currentTags {
+		if !managedTags.Contains(*tag.Slug) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return setObjectTags(api, d, tags)
+}
+
+func resourceNetboxObjectTagsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <content_type>:<object_id>", d.Id())
+	}
+	objectID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%s), object_id must be a number", d.Id())
+	}
+
+	d.Set("content_type", parts[0])
+	d.Set("object_id", objectID)
+	d.Set("mode", objectTagsModeEnforce)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getObjectTags(api *providerState, d *schema.ResourceData) ([]*models.NestedTag, error) {
+	path, err := getObjectAPIPath(d.Get("content_type").(string), int64(d.Get("object_id").(int)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := genericAPIRequest(api, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return getNestedTagListFromGenericObject(res), nil
+}
+
+func setObjectTags(api *providerState, d *schema.ResourceData, tags []*models.NestedTag) error {
+	path, err := getObjectAPIPath(d.Get("content_type").(string), int64(d.Get("object_id").(int)))
+	if err != nil {
+		return err
+	}
+
+	tagList := []map[string]string{}
+	for _, tag := range tags {
+		tagList = append(tagList, map[string]string{
+			"name": *tag.Name,
+			"slug": *tag.Slug,
+		})
+	}
+
+	_, err = genericAPIRequest(api, "PATCH", path, map[string]interface{}{tagsKey: tagList})
+	return err
+}
+
+// getNestedTagListBySlug looks up the tags with the given slugs. Unlike getNestedTagList, a tag that cannot be found is
+// an error, as silently dropping it would detach it from the object in enforce mode.
+func getNestedTagListBySlug(api *providerState, slugs []interface{}) ([]*models.NestedTag, error) {
+	tags := []*models.NestedTag{}
+	for _, slug := range slugs {
+		slugString := slug.(string)
+		params := extras.NewExtrasTagsListParams()
+		params.Slug = &slugString
+		limit := int64(2) // We search for a unique tag. Having two hits suffices to know its not unique.
+		params.Limit = &limit
+		res, err := api.Extras.ExtrasTagsList(params, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving tag %s from netbox: %w", slugString, err)
+		}
+		if *res.GetPayload().Count != int64(1) {
+			return nil, fmt.Errorf("could not map tag %s to a unique tag in netbox", slugString)
+		}
+		tags = append(tags, &models.NestedTag{
+			Name: res.GetPayload().Results[0].Name,
+			Slug: res.GetPayload().Results[0].Slug,
+		})
+	}
+	return tags, nil
+}
+
+func getTagSlugListFromNestedTagList(nestedTags []*models.NestedTag) []string {
+	slugs := []string{}
+	for _, nestedTag := range nestedTags {
+		slugs = append(slugs, *nestedTag.Slug)
+	}
+	return slugs
+}
+
+func nestedTagListContains(tags []*models.NestedTag, slug string) bool {
+	for _, tag := range tags {
+		if *tag.Slug == slug {
+			return true
+		}
+	}
+	return false
+}
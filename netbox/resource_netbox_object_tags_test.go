@@ -0,0 +1,110 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxObjectTagsFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tag" "test_a" {
+  name = "%[1]s-a"
+}
+
+resource "netbox_tag" "test_b" {
+  name = "%[1]s-b"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  tags = [netbox_tag.test_a.name]
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+`, testName)
+}
+
+func TestAccNetboxObjectTags_merge(t *testing.T) {
+
+	testSlug := "objtags_merge"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxObjectTagsFullDependencies(testName) + `
+resource "netbox_object_tags" "test" {
+  content_type = "dcim.site"
+  object_id    = netbox_site.test.id
+  tags         = [netbox_tag.test_b.slug]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_object_tags.test", "content_type", "dcim.site"),
+					resource.TestCheckResourceAttrPair("netbox_object_tags.test", "object_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttr("netbox_object_tags.test", "mode", "merge"),
+					resource.TestCheckResourceAttr("netbox_object_tags.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_object_tags.test", "tags.*", "netbox_tag.test_b", "slug"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxObjectTags_enforce(t *testing.T) {
+
+	testSlug := "objtags_enforce"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxObjectTagsFullDependencies(testName) + `
+resource "netbox_object_tags" "test" {
+  content_type = "dcim.site"
+  object_id    = netbox_site.test.id
+  tags         = [netbox_tag.test_b.slug]
+  mode         = "enforce"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_object_tags.test", "mode", "enforce"),
+					resource.TestCheckResourceAttr("netbox_object_tags.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_object_tags.test", "tags.*", "netbox_tag.test_b", "slug"),
+				),
+			},
+			{
+				ResourceName:      "netbox_object_tags.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxObjectTags_unknownTag(t *testing.T) {
+
+	testSlug := "objtags_unknown"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxObjectTagsFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_object_tags" "test" {
+  content_type = "dcim.site"
+  object_id    = netbox_site.test.id
+  tags         = ["%[1]s-missing"]
+  mode         = "enforce"
+}`, testName),
+				ExpectError: regexp.MustCompile("could not map tag .* to a unique tag in netbox"),
+			},
+		},
+	})
+}
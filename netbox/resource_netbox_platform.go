@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +32,18 @@ func resourceNetboxPlatform() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 30),
 			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Limits the platform to devices of this manufacturer.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -40,7 +52,7 @@ func resourceNetboxPlatform() *schema.Resource {
 }
 
 func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -53,13 +65,21 @@ func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
 		slug = slugValue.(string)
 	}
 
-	params := dcim.NewDcimPlatformsCreateParams().WithData(
-		&models.WritablePlatform{
-			Name: &name,
-			Slug: &slug,
-			Tags: []*models.NestedTag{},
-		},
-	)
+	data := models.WritablePlatform{
+		Name:        &name,
+		Slug:        &slug,
+		Description: d.Get("description").(string),
+	}
+
+	if manufacturerID, ok := d.GetOk("manufacturer_id"); ok {
+		data.Manufacturer = int64ToPtr(int64(manufacturerID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	params := dcim.NewDcimPlatformsCreateParams().WithData(&data)
 
 	res, err := api.Dcim.DcimPlatformsCreate(params, nil)
 	if err != nil {
@@ -73,7 +93,7 @@ func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxPlatformRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimPlatformsReadParams().WithID(id)
 
@@ -91,11 +111,23 @@ func resourceNetboxPlatformRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
+	if res.GetPayload().Manufacturer != nil {
+		d.Set("manufacturer_id", res.GetPayload().Manufacturer.ID)
+	} else {
+		d.Set("manufacturer_id", nil)
+	}
+	d.Set("description", res.GetPayload().Description)
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	return nil
 }
 
 func resourceNetboxPlatformUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritablePlatform{}
@@ -113,7 +145,15 @@ func resourceNetboxPlatformUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Slug = &slug
 	data.Name = &name
-	data.Tags = []*models.NestedTag{}
+	data.Description = d.Get("description").(string)
+
+	if manufacturerID, ok := d.GetOk("manufacturer_id"); ok {
+		data.Manufacturer = int64ToPtr(int64(manufacturerID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := dcim.NewDcimPlatformsPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -122,11 +162,19 @@ func resourceNetboxPlatformUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/platforms/%d/", id), map[string]string{
+		"manufacturer_id": "manufacturer",
+		"description":     "description",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxPlatformRead(d, m)
 }
 
 func resourceNetboxPlatformDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimPlatformsDeleteParams().WithID(id)
@@ -40,6 +40,65 @@ resource "netbox_platform" "test" {
 	})
 }
 
+func TestAccNetboxPlatform_manufacturer(t *testing.T) {
+
+	testSlug := "platform_manufacturer"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_platform" "test" {
+  name            = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  description     = "%[1]s"
+  tags            = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_platform.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttr("netbox_platform.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_platform.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_platform.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_platform.test", "manufacturer_id", "0"),
+					resource.TestCheckResourceAttr("netbox_platform.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_platform.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_platform.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccNetboxPlatform_defaultSlug(t *testing.T) {
 
 	testSlug := "platform_defSlug"
@@ -0,0 +1,234 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxPowerFeed() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxPowerFeedCreate,
+		Read:          resourceNetboxPowerFeedRead,
+		Update:        resourceNetboxPowerFeedUpdate,
+		Delete:        resourceNetboxPowerFeedDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/dcim/power-feeds/"),
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerfeed/):
+
+> A power feed represents the distribution of power from a power panel to a particular device, typically a power distribution unit (PDU). The power port (inlet) on a device can be connected via a cable to a power feed. A power feed may optionally be assigned to a rack to allow more easily tracking the distribution of power among racks.`,
+
+		Schema: map[string]*schema.Schema{
+			"power_panel_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rack_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("offline", "active", "planned", "failed"),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "primary",
+				ValidateFunc: validation.StringInSlice([]string{"primary", "redundant"}, false),
+				Description:  "One of `primary` or `redundant`.",
+			},
+			"supply": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ac",
+				ValidateFunc: validation.StringInSlice([]string{"ac", "dc"}, false),
+				Description:  "One of `ac` or `dc`.",
+			},
+			"phase": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "single-phase",
+				ValidateFunc: validation.StringInSlice([]string{"single-phase", "three-phase"}, false),
+				Description:  "One of `single-phase` or `three-phase`.",
+			},
+			"voltage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      120,
+				ValidateFunc: validation.IntBetween(-32768, 32767),
+			},
+			"amperage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 32767),
+			},
+			"max_utilization": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      80,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "Maximum permissible draw in percent.",
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Treat the feed as if a cable is connected.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxPowerFeedCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritablePowerFeedFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerFeedsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimPowerFeedsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxPowerFeedRead(d, m)
+}
+
+func resourceNetboxPowerFeedRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerFeedsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerFeedsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimPowerFeedsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	feed := res.GetPayload()
+
+	d.Set("name", feed.Name)
+	d.Set("power_panel_id", feed.PowerPanel.ID)
+	if feed.Rack != nil {
+		d.Set("rack_id", feed.Rack.ID)
+	} else {
+		d.Set("rack_id", nil)
+	}
+	if feed.Status != nil {
+		d.Set("status", feed.Status.Value)
+	}
+	if feed.Type != nil {
+		d.Set("type", feed.Type.Value)
+	}
+	if feed.Supply != nil {
+		d.Set("supply", feed.Supply.Value)
+	}
+	if feed.Phase != nil {
+		d.Set("phase", feed.Phase.Value)
+	}
+	d.Set("voltage", feed.Voltage)
+	d.Set("amperage", feed.Amperage)
+	d.Set("max_utilization", feed.MaxUtilization)
+	d.Set("mark_connected", feed.MarkConnected)
+	d.Set("comments", feed.Comments)
+	d.Set(tagsKey, getManagedTagList(api, d, feed.Tags))
+
+	cf := getManagedCustomFields(api, d, feed.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxPowerFeedUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritablePowerFeedFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerFeedsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimPowerFeedsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/power-feeds/%d/", id), map[string]string{
+		"rack_id":        "rack",
+		"mark_connected": "mark_connected",
+		"comments":       "comments",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxPowerFeedRead(d, m)
+}
+
+func resourceNetboxPowerFeedDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerFeedsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerFeedsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritablePowerFeedFromResourceData(api *providerState, d *schema.ResourceData) *models.WritablePowerFeed {
+	data := models.WritablePowerFeed{
+		Name:           strToPtr(d.Get("name").(string)),
+		PowerPanel:     int64ToPtr(int64(d.Get("power_panel_id").(int))),
+		Status:         d.Get("status").(string),
+		Type:           d.Get("type").(string),
+		Supply:         d.Get("supply").(string),
+		Phase:          d.Get("phase").(string),
+		Voltage:        int64ToPtr(int64(d.Get("voltage").(int))),
+		Amperage:       int64(d.Get("amperage").(int)),
+		MaxUtilization: int64(d.Get("max_utilization").(int)),
+		MarkConnected:  d.Get("mark_connected").(bool),
+		Comments:       d.Get("comments").(string),
+	}
+
+	if rackID, ok := d.GetOk("rack_id"); ok {
+		data.Rack = int64ToPtr(int64(rackID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
@@ -0,0 +1,115 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxPowerFeed_basic(t *testing.T) {
+
+	testSlug := "power_feed_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxPowerPanelFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_power_panel" "test" {
+  name    = "%[1]s"
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_power_feed" "test" {
+  power_panel_id  = netbox_power_panel.test.id
+  name            = "%[1]s"
+  status          = "planned"
+  type            = "redundant"
+  supply          = "ac"
+  phase           = "three-phase"
+  voltage         = 400
+  amperage        = 32
+  max_utilization = 90
+  mark_connected  = true
+  comments        = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_power_feed.test", "power_panel_id", "netbox_power_panel.test", "id"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "type", "redundant"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "supply", "ac"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "phase", "three-phase"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "voltage", "400"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "amperage", "32"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "max_utilization", "90"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "mark_connected", "true"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "comments", testName),
+				),
+			},
+			{
+				Config: testAccNetboxPowerPanelFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_power_panel" "test" {
+  name    = "%[1]s"
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_power_feed" "test" {
+  power_panel_id = netbox_power_panel.test.id
+  name           = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "type", "primary"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "phase", "single-phase"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "voltage", "120"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "amperage", "20"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "max_utilization", "80"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "mark_connected", "false"),
+					resource.TestCheckResourceAttr("netbox_power_feed.test", "comments", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_power_feed.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_power_feed", &resource.Sweeper{
+		Name:         "netbox_power_feed",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimPowerFeedsListParams()
+			res, err := api.Dcim.DcimPowerFeedsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, feed := range res.GetPayload().Results {
+				if strings.HasPrefix(*feed.Name, testPrefix) {
+					deleteParams := dcim.NewDcimPowerFeedsDeleteParams().WithID(feed.ID)
+					_, err := api.Dcim.DcimPowerFeedsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a power feed")
+				}
+			}
+			return nil
+		},
+	})
+}
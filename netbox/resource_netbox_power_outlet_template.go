@@ -0,0 +1,201 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxPowerOutletTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPowerOutletTemplateCreate,
+		Read:   resourceNetboxPowerOutletTemplateRead,
+		Update: resourceNetboxPowerOutletTemplateUpdate,
+		Delete: resourceNetboxPowerOutletTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/poweroutlettemplate/):
+
+> A template for a power outlet that will be created on all instantiations of the parent device type or module type.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instantiated component. On module types, `{module}` is replaced with the position of the module bay.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the power outlet, e.g. `iec-60320-c13`. See the Netbox documentation for possible values.",
+			},
+			"power_port_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the power port template of the same device or module type that feeds this outlet.",
+			},
+			"feed_leg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"A", "B", "C"}, false),
+				Description:  "One of `A`, `B` or `C`.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxPowerOutletTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritablePowerOutletTemplateFromResourceData(d)
+
+	params := dcim.NewDcimPowerOutletTemplatesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimPowerOutletTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxPowerOutletTemplateRead(d, m)
+}
+
+func resourceNetboxPowerOutletTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerOutletTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerOutletTemplatesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimPowerOutletTemplatesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+
+	if template.DeviceType != nil {
+		d.Set("device_type_id", template.DeviceType.ID)
+	} else {
+		d.Set("device_type_id", nil)
+	}
+	if template.ModuleType != nil {
+		d.Set("module_type_id", template.ModuleType.ID)
+	} else {
+		d.Set("module_type_id", nil)
+	}
+	d.Set("name", template.Name)
+	if template.Type != nil {
+		d.Set("type", template.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+	if template.PowerPort != nil {
+		d.Set("power_port_template_id", template.PowerPort.ID)
+	} else {
+		d.Set("power_port_template_id", nil)
+	}
+	if template.FeedLeg != nil {
+		d.Set("feed_leg", template.FeedLeg.Value)
+	} else {
+		d.Set("feed_leg", nil)
+	}
+	d.Set("label", template.Label)
+	d.Set("description", template.Description)
+
+	return nil
+}
+
+func resourceNetboxPowerOutletTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritablePowerOutletTemplateFromResourceData(d)
+
+	params := dcim.NewDcimPowerOutletTemplatesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimPowerOutletTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/power-outlet-templates/%d/", id), map[string]string{
+		"type":                   "type",
+		"power_port_template_id": "power_port",
+		"feed_leg":               "feed_leg",
+		"label":                  "label",
+		"description":            "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxPowerOutletTemplateRead(d, m)
+}
+
+func resourceNetboxPowerOutletTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerOutletTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerOutletTemplatesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritablePowerOutletTemplateFromResourceData(d *schema.ResourceData) *models.WritablePowerOutletTemplate {
+	data := models.WritablePowerOutletTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Type:        d.Get("type").(string),
+		FeedLeg:     d.Get("feed_leg").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if deviceTypeID, ok := d.GetOk("device_type_id"); ok {
+		data.DeviceType = int64ToPtr(int64(deviceTypeID.(int)))
+	}
+	if moduleTypeID, ok := d.GetOk("module_type_id"); ok {
+		data.ModuleType = int64ToPtr(int64(moduleTypeID.(int)))
+	}
+
+	if powerPortTemplateID, ok := d.GetOk("power_port_template_id"); ok {
+		data.PowerPort = int64ToPtr(int64(powerPortTemplateID.(int)))
+	}
+
+	return &data
+}
@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxPowerOutletTemplate_basic(t *testing.T) {
+
+	testSlug := "power_outlet_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+
+resource "netbox_power_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s_inlet"
+}
+resource "netbox_power_outlet_template" "test" {
+  device_type_id         = netbox_device_type.test.id
+  name                   = "%[1]s"
+  type                   = "iec-60320-c13"
+  power_port_template_id = netbox_power_port_template.test.id
+  feed_leg               = "B"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_outlet_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_power_outlet_template.test", "type", "iec-60320-c13"),
+					resource.TestCheckResourceAttr("netbox_power_outlet_template.test", "feed_leg", "B"),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+
+resource "netbox_power_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s_inlet"
+}
+resource "netbox_power_outlet_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_outlet_template.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_power_outlet_template.test", "power_port_template_id", "0"),
+					resource.TestCheckResourceAttr("netbox_power_outlet_template.test", "feed_leg", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_power_outlet_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxPowerPanel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPowerPanelCreate,
+		Read:   resourceNetboxPowerPanelRead,
+		Update: resourceNetboxPowerPanelUpdate,
+		Delete: resourceNetboxPowerPanelDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerpanel/):
+
+> A power panel represents the origin point in NetBox for electrical power being disseminated by one or more power feeds. In a data center environment, one power panel often serves a group of racks, with an individual power feed extending to each rack, though this is not always the case. It is common to have two sets of panels and feeds arranged in parallel to provide redundant power to each rack.`,
+
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"location_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxPowerPanelCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritablePowerPanelFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerPanelsCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimPowerPanelsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxPowerPanelRead(d, m)
+}
+
+func resourceNetboxPowerPanelRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPanelsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerPanelsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimPowerPanelsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	panel := res.GetPayload()
+
+	d.Set("name", panel.Name)
+	d.Set("site_id", panel.Site.ID)
+	if panel.Location != nil {
+		d.Set("location_id", panel.Location.ID)
+	} else {
+		d.Set("location_id", nil)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, panel.Tags))
+
+	cf := getManagedCustomFields(api, d, panel.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxPowerPanelUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritablePowerPanelFromResourceData(api, d)
+
+	params := dcim.NewDcimPowerPanelsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimPowerPanelsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/power-panels/%d/", id), map[string]string{
+		"location_id": "location",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxPowerPanelRead(d, m)
+}
+
+func resourceNetboxPowerPanelDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPanelsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerPanelsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritablePowerPanelFromResourceData(api *providerState, d *schema.ResourceData) *models.WritablePowerPanel {
+	data := models.WritablePowerPanel{
+		Name: strToPtr(d.Get("name").(string)),
+		Site: int64ToPtr(int64(d.Get("site_id").(int))),
+	}
+
+	if locationID, ok := d.GetOk("location_id"); ok {
+		data.Location = int64ToPtr(int64(locationID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return &data
+}
@@ -0,0 +1,95 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxPowerPanelFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_location" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+}`, testName)
+}
+
+func TestAccNetboxPowerPanel_basic(t *testing.T) {
+
+	testSlug := "power_panel_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxPowerPanelFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_power_panel" "test" {
+  name        = "%[1]s"
+  site_id     = netbox_site.test.id
+  location_id = netbox_location.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_panel.test", "name", testName),
+					resource.TestCheckResourceAttrPair("netbox_power_panel.test", "site_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_power_panel.test", "location_id", "netbox_location.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxPowerPanelFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_power_panel" "test" {
+  name    = "%[1]s"
+  site_id = netbox_site.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_panel.test", "location_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_power_panel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_power_panel", &resource.Sweeper{
+		Name:         "netbox_power_panel",
+		Dependencies: []string{"netbox_power_feed"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimPowerPanelsListParams()
+			res, err := api.Dcim.DcimPowerPanelsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, panel := range res.GetPayload().Results {
+				if strings.HasPrefix(*panel.Name, testPrefix) {
+					deleteParams := dcim.NewDcimPowerPanelsDeleteParams().WithID(panel.ID)
+					_, err := api.Dcim.DcimPowerPanelsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a power panel")
+				}
+			}
+			return nil
+		},
+	})
+}
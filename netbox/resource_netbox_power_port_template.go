@@ -0,0 +1,196 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxPowerPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPowerPortTemplateCreate,
+		Read:   resourceNetboxPowerPortTemplateRead,
+		Update: resourceNetboxPowerPortTemplateUpdate,
+		Delete: resourceNetboxPowerPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerporttemplate/):
+
+> A template for a power port that will be created on all instantiations of the parent device type or module type.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instantiated component. On module types, `{module}` is replaced with the position of the module bay.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the power port, e.g. `iec-60320-c14`. See the Netbox documentation for possible values.",
+			},
+			"maximum_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+				Description:  "The maximum power draw in watts.",
+			},
+			"allocated_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+				Description:  "The allocated power draw in watts.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxPowerPortTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWritablePowerPortTemplateFromResourceData(d)
+
+	params := dcim.NewDcimPowerPortTemplatesCreateParams().WithData(data)
+
+	res, err := api.Dcim.DcimPowerPortTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxPowerPortTemplateRead(d, m)
+}
+
+func resourceNetboxPowerPortTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerPortTemplatesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*dcim.DcimPowerPortTemplatesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+
+	if template.DeviceType != nil {
+		d.Set("device_type_id", template.DeviceType.ID)
+	} else {
+		d.Set("device_type_id", nil)
+	}
+	if template.ModuleType != nil {
+		d.Set("module_type_id", template.ModuleType.ID)
+	} else {
+		d.Set("module_type_id", nil)
+	}
+	d.Set("name", template.Name)
+	if template.Type != nil {
+		d.Set("type", template.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+	d.Set("maximum_draw", template.MaximumDraw)
+	d.Set("allocated_draw", template.AllocatedDraw)
+	d.Set("label", template.Label)
+	d.Set("description", template.Description)
+
+	return nil
+}
+
+func resourceNetboxPowerPortTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWritablePowerPortTemplateFromResourceData(d)
+
+	params := dcim.NewDcimPowerPortTemplatesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Dcim.DcimPowerPortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/power-port-templates/%d/", id), map[string]string{
+		"type":           "type",
+		"maximum_draw":   "maximum_draw",
+		"allocated_draw": "allocated_draw",
+		"label":          "label",
+		"description":    "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxPowerPortTemplateRead(d, m)
+}
+
+func resourceNetboxPowerPortTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerPortTemplatesDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getWritablePowerPortTemplateFromResourceData(d *schema.ResourceData) *models.WritablePowerPortTemplate {
+	data := models.WritablePowerPortTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Type:        d.Get("type").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if deviceTypeID, ok := d.GetOk("device_type_id"); ok {
+		data.DeviceType = int64ToPtr(int64(deviceTypeID.(int)))
+	}
+	if moduleTypeID, ok := d.GetOk("module_type_id"); ok {
+		data.ModuleType = int64ToPtr(int64(moduleTypeID.(int)))
+	}
+
+	if maximumDraw, ok := d.GetOk("maximum_draw"); ok {
+		data.MaximumDraw = int64ToPtr(int64(maximumDraw.(int)))
+	}
+	if allocatedDraw, ok := d.GetOk("allocated_draw"); ok {
+		data.AllocatedDraw = int64ToPtr(int64(allocatedDraw.(int)))
+	}
+
+	return &data
+}
@@ -0,0 +1,53 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxPowerPortTemplate_basic(t *testing.T) {
+
+	testSlug := "power_port_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_power_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+  type           = "iec-60320-c14"
+  maximum_draw   = 500
+  allocated_draw = 250
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_port_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_power_port_template.test", "type", "iec-60320-c14"),
+					resource.TestCheckResourceAttr("netbox_power_port_template.test", "maximum_draw", "500"),
+					resource.TestCheckResourceAttr("netbox_power_port_template.test", "allocated_draw", "250"),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_power_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_port_template.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_power_port_template.test", "maximum_draw", "0"),
+					resource.TestCheckResourceAttr("netbox_power_port_template.test", "allocated_draw", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_power_port_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -1,21 +1,29 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"sort"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxPrefix() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxPrefixCreate,
-		Read:   resourceNetboxPrefixRead,
-		Update: resourceNetboxPrefixUpdate,
-		Delete: resourceNetboxPrefixDelete,
+		Create:        resourceNetboxPrefixCreate,
+		Read:          resourceNetboxPrefixRead,
+		Update:        resourceNetboxPrefixUpdate,
+		DeleteContext: resourceNetboxPrefixDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/ipam/prefixes/"),
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#prefixes):
 
@@ -30,9 +38,9 @@ func resourceNetboxPrefix() *schema.Resource {
 				ValidateFunc: validation.IsCIDR,
 			},
 			"status": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"active", "reserved", "deprecated", "container"}, false),
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: statusDescription("active", "reserved", "deprecated", "container"),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -66,7 +74,29 @@ func resourceNetboxPrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the prefix in percent. Container prefixes are utilized by their child prefixes, all other prefixes by their child IP addresses and IP ranges.",
+			},
+			"children": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of child prefixes.",
+			},
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of the prefix in the prefix hierarchy, `0` for a top-level prefix.",
+			},
+			"delete_children_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, all IP addresses and child prefixes within the prefix (in the same VRF) are deleted before the prefix is destroyed, including those not managed by Terraform. Use with care.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -74,7 +104,7 @@ func resourceNetboxPrefix() *schema.Resource {
 	}
 }
 func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritablePrefix{}
 
 	prefix := d.Get("prefix").(string)
@@ -113,6 +143,8 @@ func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamPrefixesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamPrefixesCreate(params, nil)
 	if err != nil {
@@ -124,7 +156,7 @@ func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamPrefixesReadParams().WithID(id)
 
@@ -179,14 +211,28 @@ func resourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("role_id", nil)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	// FIGURE OUT NESTED VRF AND NESTED VLAN (from maybe interfaces?)
 
+	d.Set("children", res.GetPayload().Children)
+	d.Set("depth", res.GetPayload().Depth)
+
+	utilization, err := getPrefixUtilization(api, res.GetPayload())
+	if err != nil {
+		return err
+	}
+	d.Set("utilization", utilization)
+
 	return nil
 }
 
 func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritablePrefix{}
 	prefix := d.Get("prefix").(string)
@@ -224,6 +270,8 @@ func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamPrefixesUpdateParams().WithID(id).WithData(&data)
 	_, err := api.Ipam.IpamPrefixesUpdate(params, nil)
 	if err != nil {
@@ -232,14 +280,207 @@ func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 	return resourceNetboxPrefixRead(d, m)
 }
 
-func resourceNetboxPrefixDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+func resourceNetboxPrefixDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	var diags diag.Diagnostics
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if d.Get("delete_children_on_destroy").(bool) {
+		ipAddresses, prefixes, err := deletePrefixChildren(api, d.Get("prefix").(string), d.Get("vrf_id").(int), id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if ipAddresses > 0 || prefixes > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Deleted the children of prefix %s", d.Get("prefix").(string)),
+				Detail:   fmt.Sprintf("delete_children_on_destroy is set, so %d IP addresses and %d child prefixes within the prefix were deleted from Netbox.", ipAddresses, prefixes),
+			})
+		}
+	}
+
 	params := ipam.NewIpamPrefixesDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamPrefixesDelete(params, nil)
 	if err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 	d.SetId("")
-	return nil
+	return diags
+}
+
+// deletePrefixChildren deletes all IP addresses and child prefixes within the given prefix and VRF, where a VRF ID
+// of 0 is the global table. The prefix with the given ID itself is skipped. It returns the number of deleted IP
+// addresses and prefixes.
+func deletePrefixChildren(api *providerState, prefix string, vrfID int, prefixID int64) (int, int, error) {
+	query := url.Values{}
+	if vrfID != 0 {
+		query.Set("vrf_id", strconv.Itoa(vrfID))
+	} else {
+		query.Set("vrf_id", "null")
+	}
+	query.Set("parent", prefix)
+
+	ipAddresses, err := genericAPIList(api, "/ipam/ip-addresses/", query)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, ipAddress := range ipAddresses {
+		id, err := getGenericObjectID(ipAddress)
+		if err != nil {
+			return 0, 0, err
+		}
+		_, err = genericAPIRequest(api, "DELETE", fmt.Sprintf("/ipam/ip-addresses/%d/", id), nil)
+		if err != nil && !isGenericAPINotFound(err) {
+			return 0, 0, err
+		}
+	}
+
+	query.Del("parent")
+	query.Set("within", prefix)
+	children, err := genericAPIList(api, "/ipam/prefixes/", query)
+	if err != nil {
+		return 0, 0, err
+	}
+	deletedPrefixes := 0
+	for _, child := range children {
+		id, err := getGenericObjectID(child)
+		if err != nil {
+			return 0, 0, err
+		}
+		if id == prefixID {
+			continue
+		}
+		_, err = genericAPIRequest(api, "DELETE", fmt.Sprintf("/ipam/prefixes/%d/", id), nil)
+		if err != nil && !isGenericAPINotFound(err) {
+			return 0, 0, err
+		}
+		deletedPrefixes++
+	}
+
+	return len(ipAddresses), deletedPrefixes, nil
+}
+
+// getPrefixUtilization returns the utilization of the prefix in percent. The API does not expose the utilization,
+// so it is calculated like Netbox calculates it for its UI: Container prefixes are utilized by their child prefixes,
+// all other prefixes by their child IP addresses and IP ranges.
+func getPrefixUtilization(api *providerState, prefix *models.Prefix) (float64, error) {
+	if prefix.MarkUtilized {
+		return 100, nil
+	}
+
+	network, err := netip.ParsePrefix(*prefix.Prefix)
+	if err != nil {
+		return 0, err
+	}
+	network = network.Masked()
+	prefixSize := new(big.Int).Lsh(big.NewInt(1), uint(network.Addr().BitLen()-network.Bits()))
+
+	query := url.Values{}
+	if prefix.Vrf != nil {
+		query.Set("vrf_id", strconv.FormatInt(prefix.Vrf.ID, 10))
+	} else {
+		query.Set("vrf_id", "null")
+	}
+
+	var intervals []ipAddressInterval
+	if prefix.Status != nil && prefix.Status.Value != nil && *prefix.Status.Value == "container" {
+		query.Set("within", network.String())
+		children, err := genericAPIList(api, "/ipam/prefixes/", query)
+		if err != nil {
+			return 0, err
+		}
+		for _, child := range children {
+			childPrefix, _ := child["prefix"].(string)
+			childNetwork, err := netip.ParsePrefix(childPrefix)
+			if err != nil {
+				return 0, err
+			}
+			intervals = append(intervals, getPrefixInterval(childNetwork))
+		}
+	} else {
+		query.Set("parent", network.String())
+		ipAddresses, err := genericAPIList(api, "/ipam/ip-addresses/", query)
+		if err != nil {
+			return 0, err
+		}
+		for _, ipAddress := range ipAddresses {
+			address, _ := ipAddress["address"].(string)
+			ip, err := netip.ParsePrefix(address)
+			if err != nil {
+				return 0, err
+			}
+			intervals = append(intervals, ipAddressInterval{start: ipAddressToInt(ip.Addr()), end: ipAddressToInt(ip.Addr())})
+		}
+
+		ipRanges, err := genericAPIList(api, "/ipam/ip-ranges/", query)
+		if err != nil {
+			return 0, err
+		}
+		for _, ipRange := range ipRanges {
+			startAddress, _ := ipRange["start_address"].(string)
+			endAddress, _ := ipRange["end_address"].(string)
+			start, err := netip.ParsePrefix(startAddress)
+			if err != nil {
+				return 0, err
+			}
+			end, err := netip.ParsePrefix(endAddress)
+			if err != nil {
+				return 0, err
+			}
+			intervals = append(intervals, ipAddressInterval{start: ipAddressToInt(start.Addr()), end: ipAddressToInt(end.Addr())})
+		}
+
+		// The network and broadcast addresses are not usable, unless the prefix is a pool
+		if network.Addr().Is4() && network.Bits() < 31 && !prefix.IsPool {
+			prefixSize.Sub(prefixSize, big.NewInt(2))
+		}
+	}
+
+	utilization, _ := new(big.Float).Quo(new(big.Float).SetInt(getIPAddressIntervalsSize(intervals)), new(big.Float).SetInt(prefixSize)).Float64()
+	return math.Min(utilization*100, 100), nil
+}
+
+// ipAddressInterval is an inclusive interval of IP addresses.
+type ipAddressInterval struct {
+	start *big.Int
+	end   *big.Int
+}
+
+func ipAddressToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func getPrefixInterval(network netip.Prefix) ipAddressInterval {
+	network = network.Masked()
+	start := ipAddressToInt(network.Addr())
+	size := new(big.Int).Lsh(big.NewInt(1), uint(network.Addr().BitLen()-network.Bits()))
+	return ipAddressInterval{start: start, end: new(big.Int).Sub(new(big.Int).Add(start, size), big.NewInt(1))}
+}
+
+// getIPAddressIntervalsSize returns the number of IP addresses in the union of the given intervals, so
+// overlapping intervals are only counted once.
+func getIPAddressIntervalsSize(intervals []ipAddressInterval) *big.Int {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Cmp(intervals[j].start) < 0
+	})
+
+	size := big.NewInt(0)
+	var current *ipAddressInterval
+	for i := range intervals {
+		interval := intervals[i]
+		if current != nil && interval.start.Cmp(new(big.Int).Add(current.end, big.NewInt(1))) <= 0 {
+			if interval.end.Cmp(current.end) > 0 {
+				current.end = interval.end
+			}
+			continue
+		}
+		if current != nil {
+			size.Add(size, new(big.Int).Sub(current.end, current.start)).Add(size, big.NewInt(1))
+		}
+		current = &ipAddressInterval{start: interval.start, end: interval.end}
+	}
+	if current != nil {
+		size.Add(size, new(big.Int).Sub(current.end, current.start)).Add(size, big.NewInt(1))
+	}
+	return size
 }
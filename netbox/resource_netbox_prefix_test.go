@@ -3,13 +3,20 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxPrefixFullDependencies(testName string, testSlug string, testVid string) string {
@@ -216,14 +223,259 @@ resource "netbox_prefix" "test" {
 				),
 			},
 			{
-				ResourceName:      "netbox_prefix.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_prefix.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_children_on_destroy"},
 			},
 		},
 	})
 }
 
+func TestAccNetboxPrefix_utilization(t *testing.T) {
+	testSlug := "prefix_utilization"
+	testName := testAccGetTestName(testSlug)
+	config := fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "container" {
+  prefix = "10.200.0.0/24"
+  status = "container"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_prefix" "child" {
+  prefix = "10.200.0.0/25"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+
+  depends_on = [netbox_prefix.container]
+}
+
+resource "netbox_prefix" "grandchild" {
+  prefix = "10.200.0.0/30"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+
+  depends_on = [netbox_prefix.child]
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address = "10.200.0.1/30"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// The utilization and the hierarchy of a prefix change with its children, so they are only known after a refresh
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_prefix.container", "utilization", "50"),
+					resource.TestCheckResourceAttr("netbox_prefix.container", "children", "2"),
+					resource.TestCheckResourceAttr("netbox_prefix.container", "depth", "0"),
+					resource.TestCheckResourceAttr("netbox_prefix.child", "depth", "1"),
+					resource.TestCheckResourceAttr("netbox_prefix.grandchild", "utilization", "50"),
+					resource.TestCheckResourceAttr("netbox_prefix.grandchild", "depth", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxPrefix_deleteChildrenOnDestroy(t *testing.T) {
+	testSlug := "prefix_delete_children"
+	testName := testAccGetTestName(testSlug)
+	var ipAddressID int64
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		CheckDestroy: func(s *terraform.State) error {
+			api := testAccProvider.Meta().(*providerState)
+			_, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/ip-addresses/%d/", ipAddressID), nil)
+			if err == nil {
+				return fmt.Errorf("ip address %d within the destroyed prefix still exists", ipAddressID)
+			}
+			if !isGenericAPINotFound(err) {
+				return err
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "test" {
+  prefix                     = "10.201.0.0/24"
+  status                     = "active"
+  vrf_id                     = netbox_vrf.test.id
+  delete_children_on_destroy = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_prefix.test", "delete_children_on_destroy", "true"),
+					// Create an IP address within the prefix that is not managed by Terraform
+					func(s *terraform.State) error {
+						api := testAccProvider.Meta().(*providerState)
+						vrfID, _ := strconv.Atoi(s.RootModule().Resources["netbox_vrf.test"].Primary.ID)
+						res, err := genericAPIRequest(api, "POST", "/ipam/ip-addresses/", map[string]interface{}{
+							"address": "10.201.0.1/24",
+							"status":  "active",
+							"vrf":     vrfID,
+						})
+						if err != nil {
+							return err
+						}
+						ipAddressID, err = getGenericObjectID(res)
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestDeletePrefixChildren(t *testing.T) {
+	var queries []url.Values
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "DELETE" {
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		queries = append(queries, r.URL.Query())
+		switch r.URL.Path {
+		case "/api/ipam/ip-addresses/":
+			w.Write([]byte(`{"count": 2, "results": [{"id": 11}, {"id": 12}]}`))
+		case "/api/ipam/prefixes/":
+			// The filter for child prefixes also matches the prefix itself
+			w.Write([]byte(`{"count": 2, "results": [{"id": 1}, {"id": 21}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	ipAddresses, prefixes, err := deletePrefixChildren(api, "10.0.0.0/24", 0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, ipAddresses)
+	assert.Equal(t, 1, prefixes)
+	assert.Equal(t, []string{"/api/ipam/ip-addresses/11/", "/api/ipam/ip-addresses/12/", "/api/ipam/prefixes/21/"}, deleted)
+	assert.Equal(t, "10.0.0.0/24", queries[0].Get("parent"))
+	assert.Equal(t, "null", queries[0].Get("vrf_id"))
+	assert.Equal(t, "10.0.0.0/24", queries[1].Get("within"))
+	assert.Equal(t, "", queries[1].Get("parent"))
+}
+
+func TestGetIPAddressIntervalsSize(t *testing.T) {
+	prefix := func(s string) ipAddressInterval {
+		return getPrefixInterval(netip.MustParsePrefix(s))
+	}
+	address := func(s string) ipAddressInterval {
+		addr := netip.MustParseAddr(s)
+		return ipAddressInterval{start: ipAddressToInt(addr), end: ipAddressToInt(addr)}
+	}
+
+	for _, tt := range []struct {
+		intervals []ipAddressInterval
+		expected  string
+	}{
+		{intervals: nil, expected: "0"},
+		{intervals: []ipAddressInterval{prefix("10.0.0.0/24")}, expected: "256"},
+		// Nested prefixes are only counted once
+		{intervals: []ipAddressInterval{prefix("10.0.0.0/30"), prefix("10.0.0.0/25"), prefix("10.0.0.128/26")}, expected: "192"},
+		// Adjacent intervals are merged
+		{intervals: []ipAddressInterval{address("10.0.0.2"), address("10.0.0.1"), address("10.0.0.1")}, expected: "2"},
+		{intervals: []ipAddressInterval{prefix("2001:db8::/64")}, expected: "18446744073709551616"},
+	} {
+		size := getIPAddressIntervalsSize(tt.intervals)
+		if size.String() != tt.expected {
+			t.Fatalf("\n\nexpected:\n\n%s\n\ngot:\n\n%s\n\n", tt.expected, size.String())
+		}
+	}
+}
+
+func TestGetPrefixUtilization(t *testing.T) {
+	var queries []url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		queries = append(queries, r.URL.Query())
+		switch r.URL.Path {
+		case "/api/ipam/prefixes/":
+			w.Write([]byte(`{"count": 2, "results": [{"prefix": "10.0.0.0/25"}, {"prefix": "10.0.0.0/30"}]}`))
+		case "/api/ipam/ip-addresses/":
+			w.Write([]byte(`{"count": 2, "results": [{"address": "10.0.0.1/24"}, {"address": "10.0.0.10/24"}]}`))
+		case "/api/ipam/ip-ranges/":
+			// The range overlaps with one of the IP addresses
+			w.Write([]byte(`{"count": 1, "results": [{"start_address": "10.0.0.10/24", "end_address": "10.0.0.20/24"}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	prefix := &models.Prefix{
+		Prefix: strToPtr("10.0.0.0/24"),
+		Status: &models.PrefixStatus{Value: strToPtr("container")},
+		Vrf:    &models.NestedVRF{ID: 3},
+	}
+	utilization, err := getPrefixUtilization(api, prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(50), utilization)
+	assert.Equal(t, "10.0.0.0/24", queries[0].Get("within"))
+	assert.Equal(t, "3", queries[0].Get("vrf_id"))
+
+	// 12 of 254 usable addresses
+	queries = nil
+	prefix = &models.Prefix{
+		Prefix: strToPtr("10.0.0.0/24"),
+		Status: &models.PrefixStatus{Value: strToPtr("active")},
+	}
+	utilization, err = getPrefixUtilization(api, prefix)
+	assert.NoError(t, err)
+	assert.InDelta(t, 12.0/254*100, utilization, 0.0001)
+	assert.Equal(t, "10.0.0.0/24", queries[0].Get("parent"))
+	assert.Equal(t, "null", queries[0].Get("vrf_id"))
+
+	// Pools have no network and broadcast addresses
+	prefix.IsPool = true
+	utilization, err = getPrefixUtilization(api, prefix)
+	assert.NoError(t, err)
+	assert.InDelta(t, 12.0/256*100, utilization, 0.0001)
+
+	queries = nil
+	prefix.MarkUtilized = true
+	utilization, err = getPrefixUtilization(api, prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(100), utilization)
+	assert.Empty(t, queries)
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_prefix", &resource.Sweeper{
 		Name:         "netbox_prefix",
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxPrimaryIPFullDependencies(testName string) string {
@@ -76,6 +77,14 @@ resource "netbox_ip_address" "test_v6" {
 `, testName)
 }
 
+func TestNetboxPrimaryIPUpdatesInPlace(t *testing.T) {
+	// The deprecated alias keeps updating in place, while the new resource is replaced
+	for _, key := range []string{"virtual_machine_id", "ip_address_version"} {
+		assert.False(t, resourceNetboxPrimaryIP().Schema[key].ForceNew, key)
+		assert.True(t, resourceNetboxVirtualMachinePrimaryIP().Schema[key].ForceNew, key)
+	}
+}
+
 func TestAccNetboxPrimaryIP4_basic(t *testing.T) {
 
 	testSlug := "pr_ip_basic"
@@ -109,6 +118,20 @@ resource "netbox_primary_ip" "test_v4" {
 					resource.TestCheckResourceAttr("netbox_virtual_machine.test", "status", "planned"),
 				),
 			},
+			{
+				// Switching the IP address version updates the resource in place
+				Config: testAccNetboxPrimaryIPFullDependencies(testName) + `
+resource "netbox_primary_ip" "test_v4" {
+  virtual_machine_id = netbox_virtual_machine.test.id
+  ip_address_id = netbox_ip_address.test_v6.id
+  ip_address_version = 6
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_primary_ip.test_v4", "id", "netbox_virtual_machine.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_primary_ip.test_v4", "ip_address_id", "netbox_ip_address.test_v6", "id"),
+					resource.TestCheckResourceAttr("netbox_primary_ip.test_v4", "ip_address_version", "6"),
+				),
+			},
 		},
 	})
 }
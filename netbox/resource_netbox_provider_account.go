@@ -0,0 +1,153 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// providerAccountMinimumNetboxVersion is the first Netbox version with provider accounts.
+const providerAccountMinimumNetboxVersion = "3.5.0"
+
+func resourceNetboxProviderAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxProviderAccountCreate,
+		Read:   resourceNetboxProviderAccountRead,
+		Update: resourceNetboxProviderAccountUpdate,
+		Delete: resourceNetboxProviderAccountDelete,
+
+		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/models/circuits/provideraccount/):
+
+> This model can be used to represent individual accounts associated with a provider.
+
+This resource requires Netbox 3.5 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"provider_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The account identifier. It must be unique per provider.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxProviderAccountCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(providerAccountMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_provider_account requires Netbox %s or later, but the Netbox version is %s", providerAccountMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/circuits/provider-accounts/", getProviderAccountRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxProviderAccountRead(d, m)
+}
+
+func resourceNetboxProviderAccountRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	account, err := genericAPIRequest(api, "GET", fmt.Sprintf("/circuits/provider-accounts/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if providerID, ok := getGenericNestedObjectID(account, "provider"); ok {
+		d.Set("provider_id", providerID)
+	}
+	d.Set("account", account["account"])
+	d.Set("name", account["name"])
+	d.Set("description", account["description"])
+	d.Set("comments", account["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(account)))
+
+	cf := getManagedCustomFields(api, d, account[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxProviderAccountUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/circuits/provider-accounts/%d/", id), getProviderAccountRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxProviderAccountRead(d, m)
+}
+
+func resourceNetboxProviderAccountDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/circuits/provider-accounts/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getProviderAccountRequestData returns the request body for creating or updating a provider account.
+func getProviderAccountRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"provider":    d.Get("provider_id").(int),
+		"account":     d.Get("account").(string),
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,17 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxProviderAccountRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.4.3"}
+	d := resourceNetboxProviderAccount().TestResourceData()
+	d.Set("provider_id", 1)
+	d.Set("account", "12345")
+
+	err := resourceNetboxProviderAccountCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.5.0 or later")
+}
@@ -0,0 +1,53 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxRearPortTemplate_basic(t *testing.T) {
+
+	testSlug := "rear_port_template_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_rear_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+  type           = "mpo"
+  positions      = 12
+  color_hex      = "aa1409"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_rear_port_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_rear_port_template.test", "type", "mpo"),
+					resource.TestCheckResourceAttr("netbox_rear_port_template.test", "positions", "12"),
+					resource.TestCheckResourceAttr("netbox_rear_port_template.test", "color_hex", "aa1409"),
+				),
+			},
+			{
+				Config: testAccNetboxComponentTemplateFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_rear_port_template" "test" {
+  device_type_id = netbox_device_type.test.id
+  name           = "%[1]s"
+  type           = "lc"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_rear_port_template.test", "positions", "1"),
+					resource.TestCheckResourceAttr("netbox_rear_port_template.test", "color_hex", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_rear_port_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
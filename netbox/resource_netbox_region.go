@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,8 +35,9 @@ func resourceNetboxRegion() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 30),
 			},
 			"parent_region_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent region. Regions can be nested to build a geographic hierarchy, e.g. continent, country and city.",
 			},
 			"description": {
 				Type:         schema.TypeString,
@@ -47,6 +48,7 @@ func resourceNetboxRegion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -55,7 +57,7 @@ func resourceNetboxRegion() *schema.Resource {
 }
 
 func resourceNetboxRegionCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableRegion{}
 
@@ -81,6 +83,8 @@ func resourceNetboxRegionCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := dcim.NewDcimRegionsCreateParams().WithData(&data)
 
 	res, err := api.Dcim.DcimRegionsCreate(params, nil)
@@ -93,7 +97,7 @@ func resourceNetboxRegionCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimRegionsReadParams().WithID(id)
 
@@ -117,11 +121,17 @@ func resourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("parent_region_id", nil)
 	}
 	d.Set("description", res.GetPayload().Description)
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxRegionUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableRegion{}
@@ -148,6 +158,8 @@ func resourceNetboxRegionUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := dcim.NewDcimRegionsPartialUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimRegionsPartialUpdate(params, nil)
@@ -155,17 +167,29 @@ func resourceNetboxRegionUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/regions/%d/", id), map[string]string{
+		"parent_region_id": "parent",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxRegionRead(d, m)
 }
 
 func resourceNetboxRegionDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimRegionsDeleteParams().WithID(id)
 
 	_, err := api.Dcim.DcimRegionsDelete(params, nil)
 	if err != nil {
+		// Deleting a region also deletes its child regions, so the region may
+		// already be gone if its parent was destroyed first
+		if errresp, ok := err.(*dcim.DcimRegionsDeleteDefault); ok && errresp.Code() == 404 {
+			return nil
+		}
 		return err
 	}
 	return nil
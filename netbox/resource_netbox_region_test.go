@@ -43,6 +43,56 @@ resource "netbox_region" "test" {
 	})
 }
 
+func TestAccNetboxRegion_parentRegion(t *testing.T) {
+
+	testSlug := "region_parent"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_region" "continent" {
+  name = "%[1]s-continent"
+}
+
+resource "netbox_region" "country" {
+  name             = "%[1]s-country"
+  parent_region_id = netbox_region.continent.id
+}
+
+resource "netbox_region" "city" {
+  name             = "%[1]s-city"
+  parent_region_id = netbox_region.country.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_region.country", "parent_region_id", "netbox_region.continent", "id"),
+					resource.TestCheckResourceAttrPair("netbox_region.city", "parent_region_id", "netbox_region.country", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_region" "continent" {
+  name = "%[1]s-continent"
+}
+
+resource "netbox_region" "country" {
+  name             = "%[1]s-country"
+  parent_region_id = netbox_region.continent.id
+}
+
+resource "netbox_region" "city" {
+  name = "%[1]s-city"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_region.city", "parent_region_id", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetboxRegion_defaultSlug(t *testing.T) {
 
 	testSlug := "region_defSlug"
@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +32,18 @@ func resourceNetboxRir() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
+			"is_private": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the IP space managed by this RIR is considered private, e.g. RFC 1918.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -39,7 +51,7 @@ func resourceNetboxRir() *schema.Resource {
 	}
 }
 func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.RIR{}
 
 	name := d.Get("name").(string)
@@ -56,6 +68,8 @@ func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
 	data.Slug = &slug
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamRirsCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamRirsCreate(params, nil)
 	if err != nil {
@@ -67,7 +81,7 @@ func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxRirRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRirsReadParams().WithID(id)
 
@@ -90,11 +104,19 @@ func resourceNetboxRirRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("slug", res.GetPayload().Slug)
 	}
 
+	d.Set("is_private", res.GetPayload().IsPrivate)
+	d.Set("description", res.GetPayload().Description)
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxRirUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.RIR{}
 
@@ -110,18 +132,31 @@ func resourceNetboxRirUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Name = &name
 	data.Slug = &slug
+	data.IsPrivate = d.Get("is_private").(bool)
+	data.Description = d.Get("description").(string)
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := ipam.NewIpamRirsUpdateParams().WithID(id).WithData(&data)
 	_, err := api.Ipam.IpamRirsUpdate(params, nil)
 	if err != nil {
 		return err
 	}
+
+	// The generated client omits false and empty values
+	err = clearRemovedFields(api, d, fmt.Sprintf("/ipam/rirs/%d/", id), map[string]string{
+		"is_private":  "is_private",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
 	return resourceNetboxRirRead(d, m)
 }
 
 func resourceNetboxRirDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRirsDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamRirsDelete(params, nil)
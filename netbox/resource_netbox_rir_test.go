@@ -29,6 +29,20 @@ resource "netbox_rir" "test_basic" {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("netbox_rir.test_basic", "name", testName),
 					resource.TestCheckResourceAttr("netbox_rir.test_basic", "slug", randomSlug),
+					resource.TestCheckResourceAttr("netbox_rir.test_basic", "is_private", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test_basic" {
+  name = "%s"
+  slug = "%s"
+  is_private = true
+  description = "RFC 1918"
+}`, testName, randomSlug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_rir.test_basic", "is_private", "true"),
+					resource.TestCheckResourceAttr("netbox_rir.test_basic", "description", "RFC 1918"),
 				),
 			},
 			{
@@ -0,0 +1,143 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// netboxBGPAPIPath is the path of the API of the netbox-bgp plugin.
+const netboxBGPAPIPath = "/plugins/bgp/"
+
+func resourceNetboxRoutingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxRoutingPolicyCreate,
+		Read:          resourceNetboxRoutingPolicyRead,
+		Update:        resourceNetboxRoutingPolicyUpdate,
+		Delete:        resourceNetboxRoutingPolicyDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxBGP, "netbox_routing_policy"),
+
+		Description: `:meta:subcategory:Plugins:A routing policy of the netbox-bgp plugin describes which routes are imported from or exported to BGP peers. Routing policies are assigned to BGP sessions and peer groups.
+
+This resource requires the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(0, 32767),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxRoutingPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxBGPAPIPath+"routing-policy/", getRoutingPolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxRoutingPolicyRead(d, m)
+}
+
+func resourceNetboxRoutingPolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	policy, err := genericAPIRequest(api, "GET", fmt.Sprintf("%srouting-policy/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy["name"])
+	d.Set("description", policy["description"])
+	if weight, ok := getGenericInt(policy, "weight"); ok {
+		d.Set("weight", weight)
+	}
+	d.Set("comments", policy["comments"])
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(policy)))
+
+	cf := getManagedCustomFields(api, d, policy[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxRoutingPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%srouting-policy/%d/", netboxBGPAPIPath, id), getRoutingPolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxRoutingPolicyRead(d, m)
+}
+
+func resourceNetboxRoutingPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%srouting-policy/%d/", netboxBGPAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getRoutingPolicyRequestData returns the request body for creating or updating a routing policy.
+func getRoutingPolicyRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"weight":      d.Get("weight").(int),
+		"comments":    d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,196 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// savedFilterMinimumNetboxVersion is the first Netbox version with saved filters.
+const savedFilterMinimumNetboxVersion = "3.5.0"
+
+func resourceNetboxSavedFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSavedFilterCreate,
+		Read:   resourceNetboxSavedFilterRead,
+		Update: resourceNetboxSavedFilterUpdate,
+		Delete: resourceNetboxSavedFilterDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/models/extras/savedfilter/):
+
+> When filtering lists of objects in NetBox, users can save applied filters for future use. This is handy for complex filter strategies involving multiple discrete filters. For example, you might want to find all planned devices within a region that have a specific platform. Once you've applied the desired filters to the object list, simply create a saved filter with name and optional description. This filter can then be applied directly for future queries via both the UI and REST API.
+
+This resource requires Netbox 3.5 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"object_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The object types the saved filter can be applied to, e.g. `dcim.device`.",
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The filter parameters as JSON object, e.g. `jsonencode({ status = [\"planned\"], region_id = [1] })`.",
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(0, 32767),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"shared": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If true, the saved filter is available to all users, not only to the user of the provider.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxSavedFilterCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(savedFilterMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_saved_filter requires Netbox %s or later, but the Netbox version is %s", savedFilterMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	data, err := getSavedFilterRequestData(api, d)
+	if err != nil {
+		return err
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/extras/saved-filters/", data)
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxSavedFilterRead(d, m)
+}
+
+func resourceNetboxSavedFilterRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	filter, err := genericAPIRequest(api, "GET", fmt.Sprintf("/extras/saved-filters/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", filter["name"])
+	d.Set("slug", filter["slug"])
+	d.Set("description", filter["description"])
+	d.Set("object_types", filter[getObjectTypesField(api)])
+	if weight, ok := getGenericInt(filter, "weight"); ok {
+		d.Set("weight", weight)
+	}
+	d.Set("enabled", filter["enabled"])
+	d.Set("shared", filter["shared"])
+
+	parameters, err := formatOptionalJSON(filter["parameters"])
+	if err != nil {
+		return err
+	}
+	d.Set("parameters", parameters)
+
+	return nil
+}
+
+func resourceNetboxSavedFilterUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := getSavedFilterRequestData(api, d)
+	if err != nil {
+		return err
+	}
+
+	_, err = genericAPIRequest(api, "PATCH", fmt.Sprintf("/extras/saved-filters/%d/", id), data)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxSavedFilterRead(d, m)
+}
+
+func resourceNetboxSavedFilterDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/extras/saved-filters/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getSavedFilterRequestData returns the request body for creating or updating a saved filter.
+func getSavedFilterRequestData(api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	parameters, err := getOptionalJSON(d, "parameters")
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	slug := getSlug(name)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := map[string]interface{}{
+		"name":        name,
+		"slug":        slug,
+		"description": d.Get("description").(string),
+		"parameters":  parameters,
+		"weight":      d.Get("weight").(int),
+		"enabled":     d.Get("enabled").(bool),
+		"shared":      d.Get("shared").(bool),
+	}
+	data[getObjectTypesField(api)] = d.Get("object_types").(*schema.Set).List()
+
+	return data, nil
+}
@@ -0,0 +1,39 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxSavedFilterRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.4.3"}
+	d := resourceNetboxSavedFilter().TestResourceData()
+	d.Set("name", "Planned devices")
+
+	err := resourceNetboxSavedFilterCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.5.0 or later")
+}
+
+func TestGetSavedFilterRequestData(t *testing.T) {
+	d := resourceNetboxSavedFilter().TestResourceData()
+	d.Set("name", "Planned devices")
+	d.Set("object_types", []interface{}{"dcim.device"})
+	d.Set("parameters", `{"status": ["planned"]}`)
+	d.Set("weight", 100)
+	d.Set("enabled", true)
+	d.Set("shared", false)
+
+	data, err := getSavedFilterRequestData(&providerState{netboxVersion: "4.0.0"}, d)
+	assert.NoError(t, err)
+	assert.Equal(t, "planned-devices", data["slug"])
+	assert.Equal(t, []interface{}{"dcim.device"}, data["object_types"])
+	assert.Equal(t, map[string]interface{}{"status": []interface{}{"planned"}}, data["parameters"])
+	assert.Equal(t, false, data["shared"])
+
+	// Before Netbox 4.0, the object types are called content types
+	data, err = getSavedFilterRequestData(&providerState{netboxVersion: "3.7.0"}, d)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"dcim.device"}, data["content_types"])
+	assert.NotContains(t, data, "object_types")
+}
@@ -0,0 +1,209 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// jobsNetboxVersion is the first Netbox version with the jobs endpoint. Before, jobs were called job results.
+const jobsNetboxVersion = "3.5.0"
+
+func resourceNetboxScriptExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxScriptExecutionCreate,
+		ReadContext:   resourceNetboxScriptExecutionRead,
+		DeleteContext: resourceNetboxScriptExecutionDelete,
+
+		Description: `:meta:subcategory:Extras:This resource runs a [custom script](https://docs.netbox.dev/en/stable/customization/custom-scripts/) in Netbox and waits until the job of the script is finished.
+
+The script runs when the resource is created. Change ` + "`triggers`" + ` to run it again. If the script fails, the resource is tainted, so the script runs again on the next apply. Destroying the resource only removes it from the state, the job of the script is kept in Netbox. The time to wait for the script is set with the ` + "`create`" + ` timeout, which defaults to 10 minutes.
+
+This resource is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"script_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the script, e.g. `42`. Before Netbox 4.0, scripts are identified by their module and class name instead, e.g. `my_module.MyScript`.",
+			},
+			"data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The input variables of the script as JSON object, e.g. `jsonencode({ site_name = \"hq\" })`.",
+			},
+			"commit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "If false, the changes of the script are rolled back after it ran.",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values that run the script again when they change.",
+			},
+			"job_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the job of the script when it finished.",
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The output that the script returned.",
+			},
+			"log": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The log messages of the script.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceNetboxScriptExecutionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	scriptData, err := getOptionalJSON(d, "data")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if scriptData == nil {
+		scriptData = map[string]interface{}{}
+	}
+
+	res, err := genericAPIRequest(api, "POST", fmt.Sprintf("/extras/scripts/%s/", d.Get("script_id").(string)), map[string]interface{}{
+		"data":   scriptData,
+		"commit": d.Get("commit").(bool),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobID, ok := getGenericNestedObjectID(res, "result")
+	if !ok {
+		return diag.Errorf("response of script %s does not contain a job", d.Get("script_id").(string))
+	}
+	d.SetId(strconv.FormatInt(jobID, 10))
+
+	// Netbox runs scripts in a background worker, so wait for the job to finish
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "scheduled", "running"},
+		Target:     []string{"completed", "errored", "failed"},
+		Refresh:    getScriptJobStateRefreshFunc(api, jobID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 2 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for job %d of script %s: %s", jobID, d.Get("script_id").(string), err)
+	}
+	job := result.(map[string]interface{})
+
+	status := getScriptJobStatus(job)
+	output, log := getScriptJobOutput(job)
+	d.Set("job_id", jobID)
+	d.Set("status", status)
+	d.Set("output", output)
+	d.Set("log", log)
+
+	if status != "completed" {
+		// The resource is tainted because its ID is already set, so the script runs again on the next apply
+		errorMessage, _ := job["error"].(string)
+		return diag.Errorf("job %d of script %s finished with status %s: %s", jobID, d.Get("script_id").(string), status, errorMessage)
+	}
+
+	return nil
+}
+
+func resourceNetboxScriptExecutionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The job is not read again, as Netbox removes old jobs from time to time and the script must not run again
+	// because of that.
+	return nil
+}
+
+func resourceNetboxScriptExecutionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// A script cannot be undone, so the job is kept in Netbox
+	return nil
+}
+
+// getScriptJobPath returns the path of the job with the given ID in the Netbox version.
+func getScriptJobPath(api *providerState, jobID int64) string {
+	if api.hasNetboxVersion(jobsNetboxVersion) {
+		return fmt.Sprintf("/core/jobs/%d/", jobID)
+	}
+	return fmt.Sprintf("/extras/job-results/%d/", jobID)
+}
+
+func getScriptJobStateRefreshFunc(api *providerState, jobID int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := genericAPIRequest(api, "GET", getScriptJobPath(api, jobID), nil)
+		if err != nil {
+			return nil, "", err
+		}
+		return job, getScriptJobStatus(job), nil
+	}
+}
+
+func getScriptJobStatus(job map[string]interface{}) string {
+	status, _ := job["status"].(map[string]interface{})
+	value, _ := status["value"].(string)
+	return value
+}
+
+// getScriptJobOutput returns the output and the log messages of a finished script job.
+func getScriptJobOutput(job map[string]interface{}) (string, []map[string]interface{}) {
+	log := []map[string]interface{}{}
+	data, ok := job["data"].(map[string]interface{})
+	if !ok {
+		return "", log
+	}
+	output, _ := data["output"].(string)
+
+	entries, _ := data["log"].([]interface{})
+	for _, entry := range entries {
+		if entryMap, ok := entry.(map[string]interface{}); ok {
+			log = append(log, map[string]interface{}{
+				"level":   entryMap["status"],
+				"message": entryMap["message"],
+			})
+		}
+	}
+	return output, log
+}
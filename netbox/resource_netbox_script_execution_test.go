@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetScriptJobPath(t *testing.T) {
+	assert.Equal(t, "/core/jobs/5/", getScriptJobPath(&providerState{netboxVersion: "4.0.0"}, 5))
+	assert.Equal(t, "/extras/job-results/5/", getScriptJobPath(&providerState{netboxVersion: "3.4.3"}, 5))
+}
+
+func TestNetboxScriptExecutionCreate(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/extras/scripts/42/":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"site_name": "hq"}, body["data"])
+			assert.Equal(t, true, body["commit"])
+			w.Write([]byte(`{"id": 42, "result": {"id": 7, "url": "http://netbox/api/core/jobs/7/"}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/core/jobs/7/":
+			// The job runs in the background, so it is running when it is polled first
+			polls++
+			if polls == 1 {
+				w.Write([]byte(`{"id": 7, "status": {"value": "running"}}`))
+				return
+			}
+			w.Write([]byte(`{"id": 7, "status": {"value": "completed"}, "data": {"output": "done", "log": [{"status": "success", "message": "Created site hq"}]}}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI), netboxVersion: "4.0.0"}
+
+	d := resourceNetboxScriptExecution().TestResourceData()
+	d.Set("script_id", "42")
+	d.Set("data", `{"site_name": "hq"}`)
+	d.Set("commit", true)
+
+	diags := resourceNetboxScriptExecutionCreate(context.Background(), d, api)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "7", d.Id())
+	assert.Equal(t, "completed", d.Get("status"))
+	assert.Equal(t, "done", d.Get("output"))
+	assert.Equal(t, "success", d.Get("log.0.level"))
+	assert.Equal(t, "Created site hq", d.Get("log.0.message"))
+}
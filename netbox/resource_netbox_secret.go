@@ -0,0 +1,247 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// secretsSessionKeyHeader is the header that the session key of the secrets plugin is sent in.
+const secretsSessionKeyHeader = "X-Session-Key"
+
+func resourceNetboxSecret() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxSecretCreate,
+		Read:          resourceNetboxSecretRead,
+		Update:        resourceNetboxSecretUpdate,
+		Delete:        resourceNetboxSecretDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxSecrets, "netbox_secret"),
+
+		Description: `:meta:subcategory:Plugins:A secret of the netbox-secrets plugin is a piece of confidential data assigned to an object, e.g. the password of a device. The plugin encrypts secrets with a master key that is only accessible with the session key of a user.
+
+The plaintext of a secret is never stored in the Terraform state, only a salted PBKDF2 hash of it, so changes of the plaintext in the configuration are detected while changes of the plaintext in Netbox are not. Creating or changing the plaintext requires a session key, which the provider negotiates with the ` + "`secrets_private_key` argument or takes from the `secrets_session_key`" + ` argument of the provider.
+
+This resource requires the [netbox-secrets](https://github.com/Onemind-Services-LLC/netbox-secrets) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"assigned_object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The object type of the object the secret is assigned to, e.g. `dcim.device`.",
+			},
+			"assigned_object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"plaintext": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				// Only a salted hash of the plaintext is stored in the state
+				DiffSuppressFunc: suppressSensitiveValueDiff,
+				Description:      "The plaintext of the secret. It is not stored in the state, only a salted PBKDF2-SHA256 hash of it.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxSecretCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	sessionKey, err := api.getSecretsSessionKey()
+	if err != nil {
+		return err
+	}
+
+	data := getSecretRequestData(api, d)
+	plaintext := d.Get("plaintext").(string)
+	data["plaintext"] = plaintext
+	plaintextHash, err := saltedHashSensitiveValue(plaintext)
+	if err != nil {
+		return err
+	}
+
+	res, err := genericAPIRequestWithQuery(api, "POST", netboxSecretsAPIPath+"secrets/", nil, data, withSecretsSessionKey(sessionKey))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+	d.Set("plaintext", plaintextHash)
+
+	return resourceNetboxSecretRead(d, m)
+}
+
+func resourceNetboxSecretRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	// The secret is read without session key, so the plaintext is not decrypted
+	secret, err := genericAPIRequest(api, "GET", fmt.Sprintf("%ssecrets/%d/", netboxSecretsAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", secret["name"])
+	if roleID, ok := getGenericNestedObjectID(secret, "role"); ok {
+		d.Set("role_id", roleID)
+	}
+	d.Set("assigned_object_type", secret["assigned_object_type"])
+	if assignedObjectID, ok := getGenericInt(secret, "assigned_object_id"); ok {
+		d.Set("assigned_object_id", assignedObjectID)
+	}
+	d.Set("description", secret["description"])
+	d.Set("comments", secret["comments"])
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(secret)))
+
+	cf := getManagedCustomFields(api, d, secret[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxSecretUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getSecretRequestData(api, d)
+	var opts []func(*runtime.ClientOperation)
+	plaintextHash := ""
+	if d.HasChange("plaintext") {
+		sessionKey, err := api.getSecretsSessionKey()
+		if err != nil {
+			return err
+		}
+		plaintext := d.Get("plaintext").(string)
+		plaintextHash, err = saltedHashSensitiveValue(plaintext)
+		if err != nil {
+			return err
+		}
+		data["plaintext"] = plaintext
+		opts = append(opts, withSecretsSessionKey(sessionKey))
+	}
+
+	_, err := genericAPIRequestWithQuery(api, "PATCH", fmt.Sprintf("%ssecrets/%d/", netboxSecretsAPIPath, id), nil, data, opts...)
+	if err != nil {
+		return err
+	}
+	if plaintextHash != "" {
+		d.Set("plaintext", plaintextHash)
+	}
+
+	return resourceNetboxSecretRead(d, m)
+}
+
+func resourceNetboxSecretDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%ssecrets/%d/", netboxSecretsAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getSecretRequestData returns the request body for creating or updating a secret without its plaintext, which is
+// only sent together with a session key.
+func getSecretRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":                 d.Get("name").(string),
+		"role":                 d.Get("role_id").(int),
+		"assigned_object_type": d.Get("assigned_object_type").(string),
+		"assigned_object_id":   d.Get("assigned_object_id").(int),
+		"description":          d.Get("description").(string),
+		"comments":             d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
+
+// getSecretsSessionKey returns the session key of the secrets plugin. Unless a session key is configured, it is
+// negotiated with the configured private key on first use and reused for all later requests.
+func (state *providerState) getSecretsSessionKey() (string, error) {
+	state.secretsSessionKeyLock.Lock()
+	defer state.secretsSessionKeyLock.Unlock()
+
+	if state.secretsSessionKey != "" {
+		return state.secretsSessionKey, nil
+	}
+	if state.secretsPrivateKey == "" {
+		return "", fmt.Errorf("encrypting secrets requires a session key, set `secrets_private_key` or `secrets_session_key` in the provider configuration")
+	}
+
+	// Preserve the existing session key of the user, so other sessions of the user stay valid
+	res, err := genericAPIRequest(state, "POST", netboxSecretsAPIPath+"get-session-key/", map[string]interface{}{
+		"private_key":  state.secretsPrivateKey,
+		"preserve_key": true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error while trying to negotiate a session key of the secrets plugin: %s", err)
+	}
+	sessionKey, ok := res["session_key"].(string)
+	if !ok || sessionKey == "" {
+		return "", fmt.Errorf("error while trying to negotiate a session key of the secrets plugin: no session key returned")
+	}
+
+	state.secretsSessionKey = sessionKey
+	return sessionKey, nil
+}
+
+// withSecretsSessionKey returns a client option that sends the given session key of the secrets plugin.
+func withSecretsSessionKey(sessionKey string) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		params := op.Params
+		op.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			if err := params.WriteToRequest(r, reg); err != nil {
+				return err
+			}
+			return r.SetHeaderParam(secretsSessionKeyHeader, sessionKey)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// netboxSecretsAPIPath is the path of the API of the netbox-secrets plugin.
+const netboxSecretsAPIPath = "/plugins/secrets/"
+
+func resourceNetboxSecretRole() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxSecretRoleCreate,
+		Read:          resourceNetboxSecretRoleRead,
+		Update:        resourceNetboxSecretRoleUpdate,
+		Delete:        resourceNetboxSecretRoleDelete,
+		CustomizeDiff: customizeDiffRequirePlugin(pluginNetboxSecrets, "netbox_secret_role"),
+
+		Description: `:meta:subcategory:Plugins:A secret role of the netbox-secrets plugin classifies secrets by their purpose, e.g. login credentials or SNMP communities.
+
+This resource requires the [netbox-secrets](https://github.com/Onemind-Services-LLC/netbox-secrets) plugin, which must be enabled with the ` + "`plugins`" + ` argument of the provider. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxSecretRoleCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := genericAPIRequest(api, "POST", netboxSecretsAPIPath+"secret-roles/", getSecretRoleRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxSecretRoleRead(d, m)
+}
+
+func resourceNetboxSecretRoleRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	role, err := genericAPIRequest(api, "GET", fmt.Sprintf("%ssecret-roles/%d/", netboxSecretsAPIPath, id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", role["name"])
+	d.Set("slug", role["slug"])
+	d.Set("description", role["description"])
+	d.Set("comments", role["comments"])
+
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(role)))
+
+	cf := getManagedCustomFields(api, d, role[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxSecretRoleUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("%ssecret-roles/%d/", netboxSecretsAPIPath, id), getSecretRoleRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxSecretRoleRead(d, m)
+}
+
+func resourceNetboxSecretRoleDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("%ssecret-roles/%d/", netboxSecretsAPIPath, id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getSecretRoleRequestData returns the request body for creating or updating a secret role.
+func getSecretRoleRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	name := d.Get("name").(string)
+	slug := getSlug(name)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := map[string]interface{}{
+		"name":        name,
+		"slug":        slug,
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
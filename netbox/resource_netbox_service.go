@@ -1,9 +1,12 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,10 +15,11 @@ import (
 
 func resourceNetboxService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxServiceCreate,
-		Read:   resourceNetboxServiceRead,
-		Update: resourceNetboxServiceUpdate,
-		Delete: resourceNetboxServiceDelete,
+		Create:        resourceNetboxServiceCreate,
+		Read:          resourceNetboxServiceRead,
+		Update:        resourceNetboxServiceUpdate,
+		Delete:        resourceNetboxServiceDelete,
+		CustomizeDiff: resourceNetboxServiceCustomizeDiff,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/services/#services):
 
@@ -34,24 +38,37 @@ func resourceNetboxService() *schema.Resource {
 				Required: true,
 			},
 			"protocol": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"tcp", "udp", "sctp"}, false)),
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The protocol of the service, e.g. `tcp`, `udp` or `sctp`. It is validated during plan against the protocols that the Netbox instance accepts, so protocols added with the `FIELD_CHOICES` configuration can be used as well.",
 			},
 			"port": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ExactlyOneOf: []string{"port", "ports"},
+				ExactlyOneOf: []string{"port", "ports", "port_ranges"},
 				Deprecated:   "This field is deprecated. Please use the new \"ports\" attribute instead.",
 			},
 			"ports": {
 				Type:         schema.TypeSet,
 				Optional:     true,
-				ExactlyOneOf: []string{"port", "ports"},
+				Computed:     true,
+				ExactlyOneOf: []string{"port", "ports", "port_ranges"},
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				Description: "The ports of the service. If `port_ranges` is set, this contains the expanded ports of the ranges.",
 			},
+			"port_ranges": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"port", "ports", "port_ranges"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePortRange,
+				},
+				Description: "The ports of the service as ranges like `8000-8100` or single ports like `22`. Netbox only stores single ports, so the ranges are expanded by the provider.",
+			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -59,7 +76,7 @@ func resourceNetboxService() *schema.Resource {
 	}
 }
 func resourceNetboxServiceCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableService{}
 
 	dataName := d.Get("name").(string)
@@ -68,27 +85,18 @@ func resourceNetboxServiceCreate(d *schema.ResourceData, m interface{}) error {
 	dataProtocol := d.Get("protocol").(string)
 	data.Protocol = &dataProtocol
 
-	// for backwards compatibility, we allow either port or ports
-	// the API only supports ports. We give precedence to port, if it exists.
-	//dataPort := int64(d.Get("port").(int))
-	dataPort, dataPortOk := d.GetOk("port")
-	if dataPortOk {
-		data.Ports = []int64{int64(dataPort.(int))}
-	} else {
-		// if port is not set, ports has to be set
-		var dataPorts []int64
-		if v := d.Get("ports").(*schema.Set); v.Len() > 0 {
-			for _, v := range v.List() {
-				dataPorts = append(dataPorts, int64(v.(int)))
-			}
-			data.Ports = dataPorts
-		}
+	dataPorts, err := getServicePorts(d)
+	if err != nil {
+		return err
 	}
+	data.Ports = dataPorts
 
 	dataVirtualMachineID := int64(d.Get("virtual_machine_id").(int))
 	data.VirtualMachine = &dataVirtualMachineID
 
 	data.Tags = []*models.NestedTag{}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 	data.Ipaddresses = []int64{}
 
 	params := ipam.NewIpamServicesCreateParams().WithData(&data)
@@ -102,7 +110,7 @@ func resourceNetboxServiceCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxServiceRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamServicesReadParams().WithID(id)
 
@@ -120,13 +128,26 @@ func resourceNetboxServiceRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("name", res.GetPayload().Name)
 	d.Set("protocol", res.GetPayload().Protocol.Value)
 	d.Set("ports", res.GetPayload().Ports)
+
+	// Netbox does not know port ranges, so the configured ranges are kept unless the ports changed out of band
+	if portRanges, ok := d.GetOk("port_ranges"); ok {
+		configuredPorts, err := expandPortRanges(portRanges.(*schema.Set).List())
+		if err != nil || !equalPorts(configuredPorts, res.GetPayload().Ports) {
+			d.Set("port_ranges", compressPortRanges(res.GetPayload().Ports))
+		}
+	}
 	d.Set("virtual_machine_id", res.GetPayload().VirtualMachine.ID)
 
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxServiceUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableService{}
 
@@ -136,28 +157,22 @@ func resourceNetboxServiceUpdate(d *schema.ResourceData, m interface{}) error {
 	dataProtocol := d.Get("protocol").(string)
 	data.Protocol = &dataProtocol
 
-	dataPort, dataPortOk := d.GetOk("port")
-	if dataPortOk {
-		data.Ports = []int64{int64(dataPort.(int))}
-	} else {
-		// if port is not set, ports has to be set
-		var dataPorts []int64
-		if v := d.Get("ports").(*schema.Set); v.Len() > 0 {
-			for _, v := range v.List() {
-				dataPorts = append(dataPorts, int64(v.(int)))
-			}
-			data.Ports = dataPorts
-		}
+	dataPorts, err := getServicePorts(d)
+	if err != nil {
+		return err
 	}
+	data.Ports = dataPorts
 
 	data.Tags = []*models.NestedTag{}
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 	data.Ipaddresses = []int64{}
 
 	dataVirtualMachineID := int64(d.Get("virtual_machine_id").(int))
 	data.VirtualMachine = &dataVirtualMachineID
 
 	params := ipam.NewIpamServicesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamServicesUpdate(params, nil)
+	_, err = api.Ipam.IpamServicesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -165,7 +180,7 @@ func resourceNetboxServiceUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxServiceDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamServicesDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamServicesDelete(params, nil)
@@ -174,3 +189,114 @@ func resourceNetboxServiceDelete(d *schema.ResourceData, m interface{}) error {
 	}
 	return nil
 }
+
+func resourceNetboxServiceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateChoiceAttribute(d, m, "/ipam/services/", "protocol", "protocol")
+}
+
+// getServicePorts returns the ports of the service from either port, ports or port_ranges.
+func getServicePorts(d *schema.ResourceData) ([]int64, error) {
+	// for backwards compatibility, we allow either port, ports or port_ranges
+	// the API only supports ports. We give precedence to port, if it exists.
+	if port, ok := d.GetOk("port"); ok {
+		return []int64{int64(port.(int))}, nil
+	}
+	if portRanges, ok := d.GetOk("port_ranges"); ok {
+		return expandPortRanges(portRanges.(*schema.Set).List())
+	}
+	// if neither port nor port_ranges is set, ports has to be set
+	var ports []int64
+	for _, port := range d.Get("ports").(*schema.Set).List() {
+		ports = append(ports, int64(port.(int)))
+	}
+	return ports, nil
+}
+
+var portRangeRegexp = regexp.MustCompile(`^(\d+)(?:-(\d+))?$`)
+
+// parsePortRange returns the first and last port of a port range like `8000-8100` or a single port like `22`.
+func parsePortRange(portRange string) (int64, int64, error) {
+	match := portRangeRegexp.FindStringSubmatch(portRange)
+	if match == nil {
+		return 0, 0, fmt.Errorf("invalid port range %q, expected a port like 22 or a range like 8000-8100", portRange)
+	}
+	start, _ := strconv.ParseInt(match[1], 10, 64)
+	end := start
+	if match[2] != "" {
+		end, _ = strconv.ParseInt(match[2], 10, 64)
+	}
+	if start < 1 || end > 65535 {
+		return 0, 0, fmt.Errorf("invalid port range %q, ports must be between 1 and 65535", portRange)
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("invalid port range %q, the first port must not be greater than the last port", portRange)
+	}
+	return start, end, nil
+}
+
+func validatePortRange(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, _, err := parsePortRange(v); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+// expandPortRanges returns the sorted and deduplicated ports of the given port ranges.
+func expandPortRanges(portRanges []interface{}) ([]int64, error) {
+	seen := map[int64]bool{}
+	ports := []int64{}
+	for _, portRange := range portRanges {
+		start, end, err := parsePortRange(portRange.(string))
+		if err != nil {
+			return nil, err
+		}
+		for port := start; port <= end; port++ {
+			if !seen[port] {
+				seen[port] = true
+				ports = append(ports, port)
+			}
+		}
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports, nil
+}
+
+// compressPortRanges returns the given ports as the shortest list of port ranges.
+func compressPortRanges(ports []int64) []string {
+	sorted := append([]int64{}, ports...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	portRanges := []string{}
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		if sorted[i] == sorted[j] {
+			portRanges = append(portRanges, strconv.FormatInt(sorted[i], 10))
+		} else {
+			portRanges = append(portRanges, fmt.Sprintf("%d-%d", sorted[i], sorted[j]))
+		}
+		i = j + 1
+	}
+	return portRanges
+}
+
+// equalPorts checks whether the sorted ports a contain the same ports as b, regardless of the order of b.
+func equalPorts(a []int64, b []int64) bool {
+	sorted := append([]int64{}, b...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	if len(a) != len(sorted) {
+		return false
+	}
+	for i := range a {
+		if a[i] != sorted[i] {
+			return false
+		}
+	}
+	return true
+}
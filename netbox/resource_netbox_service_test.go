@@ -3,6 +3,7 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxServiceFullDependencies(testName string) string {
@@ -66,9 +68,64 @@ resource "netbox_service" "test" {
 	})
 }
 
+func TestAccNetboxService_portRanges(t *testing.T) {
+	testSlug := "svc_port_ranges"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxServiceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_service" "test" {
+  name               = "%s"
+  virtual_machine_id = netbox_virtual_machine.test.id
+  port_ranges        = ["22", "8000-8002"]
+  protocol           = "tcp"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_service.test", "port_ranges.#", "2"),
+					resource.TestCheckResourceAttr("netbox_service.test", "ports.#", "4"),
+					resource.TestCheckTypeSetElemAttr("netbox_service.test", "ports.*", "22"),
+					resource.TestCheckTypeSetElemAttr("netbox_service.test", "ports.*", "8001"),
+				),
+			},
+			{
+				Config: testAccNetboxServiceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_service" "test" {
+  name               = "%s"
+  virtual_machine_id = netbox_virtual_machine.test.id
+  port_ranges        = ["22"]
+  protocol           = "quic"
+}`, testName),
+				ExpectError: regexp.MustCompile(`protocol "quic" is not supported by Netbox`),
+			},
+		},
+	})
+}
+
+func TestExpandPortRanges(t *testing.T) {
+	ports, err := expandPortRanges([]interface{}{"8000-8002", "22", "8001"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{22, 8000, 8001, 8002}, ports)
+
+	for _, portRange := range []string{"0", "8100-8000", "80-65536", "80,443", "http"} {
+		_, err = expandPortRanges([]interface{}{portRange})
+		assert.Error(t, err, portRange)
+	}
+}
+
+func TestCompressPortRanges(t *testing.T) {
+	assert.Equal(t, []string{}, compressPortRanges(nil))
+	assert.Equal(t, []string{"22", "8000-8002", "9000"}, compressPortRanges([]int64{9000, 8001, 22, 8000, 8002}))
+	assert.True(t, equalPorts([]int64{22, 8000}, []int64{8000, 22}))
+	assert.False(t, equalPorts([]int64{22, 8000}, []int64{22}))
+}
+
 func testAccCheckServiceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each service
 	// is destroyed
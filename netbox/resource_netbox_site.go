@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,10 +12,11 @@ import (
 
 func resourceNetboxSite() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxSiteCreate,
-		Read:   resourceNetboxSiteRead,
-		Update: resourceNetboxSiteUpdate,
-		Delete: resourceNetboxSiteDelete,
+		Create:        resourceNetboxSiteCreate,
+		Read:          resourceNetboxSiteRead,
+		Update:        resourceNetboxSiteUpdate,
+		Delete:        resourceNetboxSiteDelete,
+		CustomizeDiff: customizeDiffStatusChoices("/dcim/sites/"),
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/sites-and-racks/#sites):
 
@@ -35,10 +36,10 @@ func resourceNetboxSite() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 100),
 			},
 			"status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "active",
-				ValidateFunc: validation.StringInSlice([]string{"planned", "staging", "active", "decommissioning", "retired"}, false),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("planned", "staging", "active", "decommissioning", "retired"),
 			},
 			"description": {
 				Type:         schema.TypeString,
@@ -51,12 +52,18 @@ func resourceNetboxSite() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 50),
 			},
 			"longitude": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				ValidateFunc:     validation.FloatBetween(-180, 180),
+				DiffSuppressFunc: suppressFloatRoundingDiff(6),
+				Description:      "Netbox stores the longitude with six decimal places, further decimal places are rounded.",
 			},
 			"latitude": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				ValidateFunc:     validation.FloatBetween(-90, 90),
+				DiffSuppressFunc: suppressFloatRoundingDiff(6),
+				Description:      "Netbox stores the latitude with six decimal places, further decimal places are rounded.",
 			},
 			"region_id": {
 				Type:     schema.TypeInt,
@@ -82,7 +89,8 @@ func resourceNetboxSite() *schema.Resource {
 					Type: schema.TypeInt,
 				},
 			},
-			customFieldsKey: customFieldsSchema,
+			customFieldsKey:           customFieldsSchema,
+			preventDeletionIfInUseKey: preventDeletionIfInUseSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -91,7 +99,7 @@ func resourceNetboxSite() *schema.Resource {
 }
 
 func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableSite{}
 
@@ -118,12 +126,12 @@ func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
 
 	latitudeValue, ok := d.GetOk("latitude")
 	if ok {
-		data.Latitude = float64ToPtr(float64(latitudeValue.(float64)))
+		data.Latitude = float64ToPtr(roundFloat(latitudeValue.(float64), 6))
 	}
 
 	longitudeValue, ok := d.GetOk("longitude")
 	if ok {
-		data.Longitude = float64ToPtr(float64(longitudeValue.(float64)))
+		data.Longitude = float64ToPtr(roundFloat(longitudeValue.(float64), 6))
 	}
 
 	regionIDValue, ok := d.GetOk("region_id")
@@ -152,10 +160,7 @@ func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := dcim.NewDcimSitesCreateParams().WithData(&data)
 
@@ -170,7 +175,7 @@ func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimSitesReadParams().WithID(id)
 
@@ -216,17 +221,17 @@ func resourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant_id", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
 
 	return nil
 }
 
 func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableSite{}
@@ -257,12 +262,12 @@ func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
 
 	latitudeValue, ok := d.GetOk("latitude")
 	if ok {
-		data.Latitude = float64ToPtr(float64(latitudeValue.(float64)))
+		data.Latitude = float64ToPtr(roundFloat(latitudeValue.(float64), 6))
 	}
 
 	longitudeValue, ok := d.GetOk("longitude")
 	if ok {
-		data.Longitude = float64ToPtr(float64(longitudeValue.(float64)))
+		data.Longitude = float64ToPtr(roundFloat(longitudeValue.(float64), 6))
 	}
 
 	regionIDValue, ok := d.GetOk("region_id")
@@ -291,10 +296,7 @@ func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := dcim.NewDcimSitesPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -303,16 +305,30 @@ func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/dcim/sites/%d/", id), map[string]string{
+		"latitude":  "latitude",
+		"longitude": "longitude",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxSiteRead(d, m)
 }
 
 func resourceNetboxSiteDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	err := checkObjectNotInUse(api, d, "site", id, siteDependents)
+	if err != nil {
+		return err
+	}
+
 	params := dcim.NewDcimSitesDeleteParams().WithID(id)
 
-	_, err := api.Dcim.DcimSitesDelete(params, nil)
+	_, err = api.Dcim.DcimSitesDelete(params, nil)
 	if err != nil {
 		return err
 	}
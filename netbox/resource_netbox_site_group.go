@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,6 +41,7 @@ func resourceNetboxSiteGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -50,7 +50,7 @@ func resourceNetboxSiteGroup() *schema.Resource {
 }
 
 func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	parent_id := int64(d.Get("parent_id").(int))
@@ -71,6 +71,8 @@ func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	if parent_id != 0 {
 		data.Parent = &parent_id
 	}
@@ -88,7 +90,7 @@ func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	params := dcim.NewDcimSiteGroupsReadParams().WithID(id)
@@ -111,11 +113,17 @@ func resourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
 	if siteGroup.Parent != nil {
 		d.Set("parent_id", siteGroup.Parent.ID)
 	}
+
+	cf := getManagedCustomFields(api, d, siteGroup.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableSiteGroup{}
@@ -138,6 +146,8 @@ func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	if parent_id != 0 {
 		data.Parent = &parent_id
 	}
@@ -152,7 +162,7 @@ func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxSiteGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimSiteGroupsDeleteParams().WithID(id)
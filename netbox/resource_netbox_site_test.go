@@ -55,9 +55,10 @@ resource "netbox_site" "test" {
 				),
 			},
 			{
-				ResourceName:      "netbox_site.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_site.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_deletion_if_in_use"},
 			},
 		},
 	})
@@ -132,6 +133,39 @@ resource "netbox_site" "test" {
 	})
 }
 
+func TestAccNetboxSite_geolocation(t *testing.T) {
+	testSlug := "site_geo"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name      = "%[1]s"
+  latitude  = 48.1371538
+  longitude = 11.5753821
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "latitude", "48.137154"),
+					resource.TestCheckResourceAttr("netbox_site.test", "longitude", "11.575382"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "latitude", "0"),
+					resource.TestCheckResourceAttr("netbox_site.test", "longitude", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetboxSite_fieldUpdate(t *testing.T) {
 	testSlug := "site_field_update"
 	testName := testAccGetTestName(testSlug)
@@ -1,22 +1,27 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// tagObjectTypesMinimumNetboxVersion is the first Netbox version that can restrict tags to object types.
+const tagObjectTypesMinimumNetboxVersion = "4.0.0"
+
 func resourceNetboxTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxTagCreate,
-		Read:   resourceNetboxTagRead,
-		Update: resourceNetboxTagUpdate,
-		Delete: resourceNetboxTagDelete,
+		Create:        resourceNetboxTagCreate,
+		Read:          resourceNetboxTagRead,
+		Update:        resourceNetboxTagUpdate,
+		Delete:        resourceNetboxTagDelete,
+		CustomizeDiff: resourceNetboxTagCustomizeDiff,
 
 		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/models/extras/tag/):
 > Tags are user-defined labels which can be applied to a variety of objects within NetBox. They can be used to establish dimensions of organization beyond the relationships built into NetBox. For example, you might create a tag to identify a particular ownership or condition across several types of objects.
@@ -43,6 +48,15 @@ func resourceNetboxTag() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"object_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "The object types the tag can be applied to, e.g. `dcim.device`. If empty, the tag can be applied to all object types. Requires Netbox 4.0 or later.",
+			},
 			tagsKey: tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
@@ -52,7 +66,7 @@ func resourceNetboxTag() *schema.Resource {
 }
 
 func resourceNetboxTagCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -84,21 +98,28 @@ func resourceNetboxTagCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	err = setTagObjectTypes(api, d)
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxTagRead(d, m)
 }
 
 func resourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasTagsReadParams().WithID(id)
 
 	res, err := api.Extras.ExtrasTagsRead(params, nil)
 	if err != nil {
-		errorcode := err.(*extras.ExtrasTagsReadDefault).Code()
-		if errorcode == 404 {
-			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-			d.SetId("")
-			return nil
+		if errresp, ok := err.(*extras.ExtrasTagsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
 		}
 		return err
 	}
@@ -107,11 +128,20 @@ func resourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("slug", res.GetPayload().Slug)
 	d.Set("color_hex", res.GetPayload().Color)
 	d.Set("description", res.GetPayload().Description)
+
+	if api.hasNetboxVersion(tagObjectTypesMinimumNetboxVersion) {
+		tag, err := genericAPIRequest(api, "GET", fmt.Sprintf("/extras/tags/%d/", id), nil)
+		if err != nil {
+			return err
+		}
+		objectTypes, _ := tag["object_types"].([]interface{})
+		d.Set("object_types", objectTypes)
+	}
 	return nil
 }
 
 func resourceNetboxTagUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.Tag{}
@@ -141,11 +171,16 @@ func resourceNetboxTagUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = setTagObjectTypes(api, d)
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxTagRead(d, m)
 }
 
 func resourceNetboxTagDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasTagsDeleteParams().WithID(id)
@@ -156,3 +191,27 @@ func resourceNetboxTagDelete(d *schema.ResourceData, m interface{}) error {
 	}
 	return nil
 }
+
+func resourceNetboxTagCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || config.GetAttr("object_types").IsNull() {
+		return nil
+	}
+	api := m.(*providerState)
+	if !api.hasNetboxVersion(tagObjectTypesMinimumNetboxVersion) {
+		return fmt.Errorf("object_types requires Netbox %s or later, but the Netbox version is %s", tagObjectTypesMinimumNetboxVersion, api.netboxVersion)
+	}
+	return nil
+}
+
+// setTagObjectTypes sets the object types of the tag if they changed. The generated client does not know the object
+// types, so they are patched separately.
+func setTagObjectTypes(api *providerState, d *schema.ResourceData) error {
+	if !d.HasChange("object_types") || !api.hasNetboxVersion(tagObjectTypesMinimumNetboxVersion) {
+		return nil
+	}
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	objectTypes := d.Get("object_types").(*schema.Set).List()
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/extras/tags/%d/", id), map[string]interface{}{"object_types": objectTypes})
+	return err
+}
@@ -1,16 +1,59 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestSetTagObjectTypes(t *testing.T) {
+	var body map[string]interface{}
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/extras/tags/5/", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 5}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxTag().Schema, map[string]interface{}{
+		"name":         "test",
+		"object_types": []interface{}{"dcim.device"},
+	})
+	d.SetId("5")
+
+	// Older versions do not know the object types, so nothing is patched
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI), netboxVersion: "3.7.8"}
+	assert.NoError(t, setTagObjectTypes(api, d))
+	assert.Equal(t, 0, requests)
+
+	api.netboxVersion = "4.0.0"
+	assert.NoError(t, setTagObjectTypes(api, d))
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, map[string]interface{}{"object_types": []interface{}{"dcim.device"}}, body)
+}
+
 func TestAccNetboxTag_basic(t *testing.T) {
 
 	testSlug := "tag_basic"
@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +43,12 @@ func resourceNetboxTenant() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:           customFieldsSchema,
+			preventDeletionIfInUseKey: preventDeletionIfInUseSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -51,11 +57,12 @@ func resourceNetboxTenant() *schema.Resource {
 }
 
 func resourceNetboxTenantCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	group_id := int64(d.Get("group_id").(int))
 	description := d.Get("description").(string)
+	comments := d.Get("comments").(string)
 
 	slugValue, slugOk := d.GetOk("slug")
 	var slug string
@@ -73,12 +80,15 @@ func resourceNetboxTenantCreate(d *schema.ResourceData, m interface{}) error {
 	data.Name = &name
 	data.Slug = &slug
 	data.Description = description
+	data.Comments = comments
 	data.Tags = tags
 
 	if group_id != 0 {
 		data.Group = &group_id
 	}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := tenancy.NewTenancyTenantsCreateParams().WithData(data)
 
 	res, err := api.Tenancy.TenancyTenantsCreate(params, nil)
@@ -92,7 +102,7 @@ func resourceNetboxTenantCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxTenantRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyTenantsReadParams().WithID(id)
 
@@ -110,21 +120,31 @@ func resourceNetboxTenantRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
 	d.Set("description", res.GetPayload().Description)
+	d.Set("comments", res.GetPayload().Comments)
 	if res.GetPayload().Group != nil {
 		d.Set("group_id", res.GetPayload().Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
 	}
 
 	return nil
 }
 
 func resourceNetboxTenantUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableTenant{}
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
+	comments := d.Get("comments").(string)
 	group_id := int64(d.Get("group_id").(int))
 	slugValue, slugOk := d.GetOk("slug")
 	var slug string
@@ -140,11 +160,14 @@ func resourceNetboxTenantUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Slug = &slug
 	data.Name = &name
 	data.Description = description
+	data.Comments = comments
 	data.Tags = tags
 	if group_id != 0 {
 		data.Group = &group_id
 	}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	params := tenancy.NewTenancyTenantsPartialUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Tenancy.TenancyTenantsPartialUpdate(params, nil)
@@ -152,16 +175,31 @@ func resourceNetboxTenantUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/tenancy/tenants/%d/", id), map[string]string{
+		"group_id":    "group",
+		"description": "description",
+		"comments":    "comments",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxTenantRead(d, m)
 }
 
 func resourceNetboxTenantDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	err := checkObjectNotInUse(api, d, "tenant", id, tenantDependents)
+	if err != nil {
+		return err
+	}
+
 	params := tenancy.NewTenancyTenantsDeleteParams().WithID(id)
 
-	_, err := api.Tenancy.TenancyTenantsDelete(params, nil)
+	_, err = api.Tenancy.TenancyTenantsDelete(params, nil)
 	if err != nil {
 		return err
 	}
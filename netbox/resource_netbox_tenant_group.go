@@ -1,9 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,13 +35,15 @@ func resourceNetboxTenantGroup() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 30),
 			},
 			"parent_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent tenant group. Tenant groups can be nested to build multi-level hierarchies.",
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -50,7 +52,7 @@ func resourceNetboxTenantGroup() *schema.Resource {
 }
 
 func resourceNetboxTenantGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	parent_id := int64(d.Get("parent_id").(int))
@@ -71,6 +73,8 @@ func resourceNetboxTenantGroupCreate(d *schema.ResourceData, m interface{}) erro
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	if parent_id != 0 {
 		data.Parent = &parent_id
 	}
@@ -88,7 +92,7 @@ func resourceNetboxTenantGroupCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxTenantGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	params := tenancy.NewTenancyTenantGroupsReadParams().WithID(id)
@@ -108,13 +112,21 @@ func resourceNetboxTenantGroupRead(d *schema.ResourceData, m interface{}) error
 	d.Set("slug", res.GetPayload().Slug)
 	d.Set("description", res.GetPayload().Description)
 	if res.GetPayload().Parent != nil {
-		d.Set("parent", res.GetPayload().Parent.ID)
+		d.Set("parent_id", res.GetPayload().Parent.ID)
+	} else {
+		d.Set("parent_id", nil)
 	}
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
 	return nil
 }
 
 func resourceNetboxTenantGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableTenantGroup{}
@@ -137,6 +149,8 @@ func resourceNetboxTenantGroupUpdate(d *schema.ResourceData, m interface{}) erro
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	if parent_id != 0 {
 		data.Parent = &parent_id
 	}
@@ -147,11 +161,19 @@ func resourceNetboxTenantGroupUpdate(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
+	err = clearRemovedFields(api, d, fmt.Sprintf("/tenancy/tenant-groups/%d/", id), map[string]string{
+		"parent_id":   "parent",
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxTenantGroupRead(d, m)
 }
 
 func resourceNetboxTenantGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyTenantGroupsDeleteParams().WithID(id)
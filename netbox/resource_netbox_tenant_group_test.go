@@ -62,6 +62,50 @@ resource "netbox_tenant_group" "test" {
 	})
 }
 
+func TestAccNetboxTenantGroup_parent(t *testing.T) {
+
+	testSlug := "t_grp_parent"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tenant_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_tenant_group" "test" {
+  name      = "%[1]s"
+  parent_id = netbox_tenant_group.parent.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_tenant_group.test", "parent_id", "netbox_tenant_group.parent", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_tenant_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tenant_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_tenant_group" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tenant_group.test", "parent_id", "0"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_tenant_group", &resource.Sweeper{
 		Name:         "netbox_tenant_group",
@@ -3,12 +3,15 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func testAccNetboxTenantTagDependencies(testName string) string {
@@ -47,9 +50,10 @@ resource "netbox_tenant" "test" {
 				),
 			},
 			{
-				ResourceName:      "netbox_tenant.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_tenant.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_deletion_if_in_use"},
 			},
 		},
 	})
@@ -77,6 +81,124 @@ resource "netbox_tenant" "test" {
 	})
 }
 
+func TestAccNetboxTenant_commentsAndCustomFields(t *testing.T) {
+
+	testSlug := "tenant_comm"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["tenancy.tenant"]
+}
+
+resource "netbox_tenant_group" "test" {
+  name = "%[2]s"
+}
+
+resource "netbox_tenant" "test" {
+  name          = "%[2]s"
+  group_id      = netbox_tenant_group.test.id
+  comments      = "Customer since 2019"
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tenant.test", "comments", "Customer since 2019"),
+					resource.TestCheckResourceAttr("netbox_tenant.test", "custom_fields."+testField, "81"),
+					resource.TestCheckResourceAttrPair("netbox_tenant.test", "group_id", "netbox_tenant_group.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["tenancy.tenant"]
+}
+
+resource "netbox_tenant_group" "test" {
+  name = "%[2]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[2]s"
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tenant.test", "comments", ""),
+					resource.TestCheckResourceAttr("netbox_tenant.test", "group_id", "0"),
+				),
+			},
+			{
+				ResourceName:            "netbox_tenant.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_deletion_if_in_use"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxTenant_preventDeletionIfInUse(t *testing.T) {
+
+	testSlug := "tenant_prevdel"
+	testName := testAccGetTestName(testSlug)
+	config := fmt.Sprintf(`
+resource "netbox_tenant" "test" {
+  name                       = "%s"
+  prevent_deletion_if_in_use = true
+}`, testName)
+	var siteID int64
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tenant.test", "prevent_deletion_if_in_use", "true"),
+					// Create a site of the tenant that is not managed by Terraform
+					func(s *terraform.State) error {
+						api := testAccProvider.Meta().(*providerState)
+						tenantID, _ := strconv.Atoi(s.RootModule().Resources["netbox_tenant.test"].Primary.ID)
+						res, err := genericAPIRequest(api, "POST", "/dcim/sites/", map[string]interface{}{
+							"name":   testName,
+							"slug":   getSlug(testName),
+							"tenant": tenantID,
+						})
+						if err != nil {
+							return err
+						}
+						siteID, err = getGenericObjectID(res)
+						return err
+					},
+				),
+			},
+			{
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("is still in use by 1 sites"),
+			},
+			{
+				PreConfig: func() {
+					api := testAccProvider.Meta().(*providerState)
+					_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/dcim/sites/%d/", siteID), nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:  config,
+				Destroy: true,
+			},
+		},
+	})
+}
+
 func TestAccNetboxTenant_tags(t *testing.T) {
 
 	testSlug := "tenant_tags"
@@ -1,11 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
-	"github.com/fbreckle/go-netbox/netbox/client/users"
-	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,7 +17,13 @@ func resourceNetboxToken() *schema.Resource {
 
 		Description: `:meta:subcategory:Authentication:From the [official documentation](https://docs.netbox.dev/en/stable/rest-api/authentication/#tokens):
 
-> A token is a unique identifier mapped to a NetBox user account. Each user may have one or more tokens which he or she can use for authentication when making REST API requests. To create a token, navigate to the API tokens page under your user profile.`,
+> A token is a unique identifier mapped to a NetBox user account. Each user may have one or more tokens which he or she can use for authentication when making REST API requests. To create a token, navigate to the API tokens page under your user profile.
+
+The key of a token is either set in the configuration or generated by Netbox. A configured key is never stored in the Terraform state, only a salted PBKDF2 hash of it, so reference the configured value instead of the ` + "`key`" + ` attribute. A generated key is only returned by Netbox when the token is created, so it is stored in the state as sensitive value and can be passed on to other providers from there. If Netbox does not return the key, e.g. because ` + "`ALLOW_TOKEN_RETRIEVAL`" + ` is disabled on older versions, configure the key instead. The key is never read from Netbox again.
+
+~> **Upgrading:** Older provider versions stored every key in plaintext in the state, including keys read from Netbox. On upgrade, the key in the state is replaced by its salted hash, so a generated key can no longer be read from the ` + "`key`" + ` attribute. Configure the key or recreate the token to pass the key on.
+
+This resource is not covered by the generated API client and therefore uses the generic API of the provider.`,
 
 		Schema: map[string]*schema.Schema{
 			"user_id": {
@@ -30,7 +34,11 @@ func resourceNetboxToken() *schema.Resource {
 				Type:         schema.TypeString,
 				Sensitive:    true,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(40, 256),
+				// Only a salted hash of a configured key is stored in the state
+				DiffSuppressFunc: suppressSensitiveValueDiff,
+				Description:      "The key of the token. If set, only a salted PBKDF2-SHA256 hash of it is stored in the state. If not set, Netbox generates a key, which is stored in the state.",
 			},
 			"allowed_ips": {
 				Type:     schema.TypeList,
@@ -43,12 +51,21 @@ func resourceNetboxToken() *schema.Resource {
 			"write_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
-			"last_used": {
-				Type:     schema.TypeString,
-				Computed: true,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
 			},
 			"expires": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiff,
+				Description:      "The time the token expires, e.g. `2025-12-31T23:59:59Z`. If not set, the token does not expire.",
+			},
+			"last_used": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -56,103 +73,138 @@ func resourceNetboxToken() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceNetboxTokenResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceNetboxTokenStateUpgradeV0,
+				Version: 0,
+			},
+		},
 	}
 }
 
 func resourceNetboxTokenCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	data := models.WritableToken{}
-
-	userid := int64(d.Get("user_id").(int))
-
-	key := d.Get("key").(string)
-	allowedIps := d.Get("allowed_ips").([]interface{})
-
-	data.User = &userid
-	data.Key = key
-
-	data.AllowedIps = make([]models.IPNetwork, len(allowedIps))
-	for i, v := range allowedIps {
-		data.AllowedIps[i] = v
+	api := m.(*providerState)
+
+	data := getTokenRequestData(d)
+	keyHash := ""
+	key, keyConfigured := d.GetOk("key")
+	if keyConfigured {
+		var err error
+		keyHash, err = saltedHashSensitiveValue(key.(string))
+		if err != nil {
+			return err
+		}
+		data["key"] = key.(string)
 	}
 
-	data.WriteEnabled = d.Get("write_enabled").(bool)
+	res, err := genericAPIRequest(api, "POST", "/users/tokens/", data)
+	if err != nil {
+		return err
+	}
 
-	params := users.NewUsersTokensCreateParams().WithData(&data)
-	res, err := api.Users.UsersTokensCreate(params, nil)
+	id, err := getGenericObjectID(res)
 	if err != nil {
 		return err
 	}
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	d.SetId(strconv.FormatInt(id, 10))
+
+	// A generated key is only returned once, so it is taken from the response of the creation
+	if keyConfigured {
+		d.Set("key", keyHash)
+	} else {
+		d.Set("key", res["key"])
+	}
 
-	return resourceNetboxTokenUpdate(d, m)
+	return resourceNetboxTokenRead(d, m)
 }
 
 func resourceNetboxTokenRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersTokensReadParams().WithID(id)
 
-	res, err := api.Users.UsersTokensRead(params, nil)
+	token, err := genericAPIRequest(api, "GET", fmt.Sprintf("/users/tokens/%d/", id), nil)
 	if err != nil {
-		errorcode := err.(*users.UsersTokensReadDefault).Code()
-		if errorcode == 404 {
+		if isGenericAPINotFound(err) {
 			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
 			d.SetId("")
 			return nil
 		}
 		return err
 	}
-	token := res.GetPayload()
 
-	if token.User != nil {
-		d.Set("user_id", token.User.ID)
+	if userID, ok := getGenericNestedObjectID(token, "user"); ok {
+		d.Set("user_id", userID)
 	}
 
-	d.Set("key", token.Key)
-	d.Set("last_used", token.LastUsed)
-	d.Set("expires", token.Expires)
-	d.Set("allowed_ips", token.AllowedIps)
-	d.Set("write_enabled", token.WriteEnabled)
+	// The key is not read, as Netbox only returns it on creation unless token retrieval is allowed
+
+	d.Set("allowed_ips", token["allowed_ips"])
+	d.Set("write_enabled", token["write_enabled"])
+	d.Set("description", token["description"])
+	d.Set("expires", token["expires"])
+	d.Set("last_used", token["last_used"])
 
 	return nil
 }
 
 func resourceNetboxTokenUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	data := models.WritableToken{}
 
-	userid := int64(d.Get("user_id").(int))
-	key := d.Get("key").(string)
-	allowedIps := d.Get("allowed_ips").([]interface{})
-
-	data.User = &userid
-	data.Key = key
-
-	data.AllowedIps = make([]models.IPNetwork, len(allowedIps))
-	for i, v := range allowedIps {
-		data.AllowedIps[i] = v
+	data := getTokenRequestData(d)
+	keyHash := ""
+	if d.HasChange("key") {
+		if key, ok := d.GetOk("key"); ok {
+			var err error
+			keyHash, err = saltedHashSensitiveValue(key.(string))
+			if err != nil {
+				return err
+			}
+			data["key"] = key.(string)
+		}
 	}
 
-	data.WriteEnabled = d.Get("write_enabled").(bool)
-
-	params := users.NewUsersTokensUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Users.UsersTokensUpdate(params, nil)
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/users/tokens/%d/", id), data)
 	if err != nil {
 		return err
 	}
+	if keyHash != "" {
+		d.Set("key", keyHash)
+	}
 	return resourceNetboxTokenRead(d, m)
 }
 
 func resourceNetboxTokenDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersTokensDeleteParams().WithID(id)
-	_, err := api.Users.UsersTokensDelete(params, nil)
-	if err != nil {
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/users/tokens/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
 		return err
 	}
-	d.SetId("")
 	return nil
 }
+
+// getTokenRequestData returns the request body for creating or updating a token without its key, which is only sent
+// when it is configured and changes.
+func getTokenRequestData(d *schema.ResourceData) map[string]interface{} {
+	allowedIPs := []string{}
+	for _, allowedIP := range d.Get("allowed_ips").([]interface{}) {
+		allowedIPs = append(allowedIPs, allowedIP.(string))
+	}
+
+	data := map[string]interface{}{
+		"user":          d.Get("user_id").(int),
+		"allowed_ips":   allowedIPs,
+		"write_enabled": d.Get("write_enabled").(bool),
+		"description":   d.Get("description").(string),
+		"expires":       nil,
+	}
+	if expires, ok := d.GetOk("expires"); ok {
+		data["expires"] = expires.(string)
+	}
+
+	return data
+}
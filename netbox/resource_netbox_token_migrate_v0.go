@@ -0,0 +1,55 @@
+package netbox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxTokenResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(40, 256),
+			},
+			"allowed_ips": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"write_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"last_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceNetboxTokenStateUpgradeV0 replaces the key, which version 0 stored in plaintext, with its salted hash. Version
+// 0 also read the key from Netbox, so the state may hold a generated key. The raw state does not tell configured and
+// generated keys apart, so generated keys are hashed as well and can no longer be read from the state.
+func resourceNetboxTokenStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if err := hashSensitiveValueInState(rawState, "key"); err != nil {
+		return nil, err
+	}
+	return rawState, nil
+}
@@ -0,0 +1,28 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResourceNetboxTokenStateUpgradeV0(t *testing.T) {
+	key := "abcdef0123456789abcdef0123456789abcdef01"
+	actual, err := resourceNetboxTokenStateUpgradeV0(context.Background(), map[string]interface{}{"user_id": 1, "key": key}, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	hash := actual["key"].(string)
+	if hash == key || !sensitiveValueMatchesHash(key, hash) {
+		t.Fatalf("\n\nexpected the salted hash of the key, got:\n\n%#v\n\n", hash)
+	}
+
+	// Tokens without key are kept
+	actual, err = resourceNetboxTokenStateUpgradeV0(context.Background(), map[string]interface{}{"user_id": 1, "key": ""}, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+	if actual["key"] != "" {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", "", actual["key"])
+	}
+}
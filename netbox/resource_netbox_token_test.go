@@ -1,16 +1,80 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestNetboxTokenCreate(t *testing.T) {
+	generatedKey := "0123456789abcdef0123456789abcdef01234567"
+	configuredKey := "abcdef0123456789abcdef0123456789abcdef01"
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/users/tokens/":
+			body = nil
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			key, ok := body["key"].(string)
+			if !ok {
+				key = generatedKey
+			}
+			w.Write([]byte(fmt.Sprintf(`{"id": 3, "key": "%s"}`, key)))
+		case r.Method == "GET" && r.URL.Path == "/api/users/tokens/3/":
+			// Netbox does not return the key once the token is created
+			w.Write([]byte(`{"id": 3, "user": {"id": 1}, "allowed_ips": [], "write_enabled": true, "description": "", "expires": null}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	// Tokens are read-only unless write access is enabled
+	d := schema.TestResourceDataRaw(t, resourceNetboxToken().Schema, map[string]interface{}{
+		"user_id": 1,
+	})
+	assert.Equal(t, false, getTokenRequestData(d)["write_enabled"])
+
+	// A generated key is stored in the state
+	d = schema.TestResourceDataRaw(t, resourceNetboxToken().Schema, map[string]interface{}{
+		"user_id":       1,
+		"write_enabled": true,
+	})
+	assert.NoError(t, resourceNetboxTokenCreate(d, api))
+	assert.NotContains(t, body, "key")
+	assert.Nil(t, body["expires"])
+	assert.Equal(t, generatedKey, d.State().Attributes["key"])
+
+	// A configured key is only stored as hash
+	d = schema.TestResourceDataRaw(t, resourceNetboxToken().Schema, map[string]interface{}{
+		"user_id":       1,
+		"key":           configuredKey,
+		"write_enabled": true,
+	})
+	assert.NoError(t, resourceNetboxTokenCreate(d, api))
+	assert.Equal(t, configuredKey, body["key"])
+	assert.True(t, sensitiveValueMatchesHash(configuredKey, d.State().Attributes["key"]))
+}
+
 func TestAccNetboxToken_basic(t *testing.T) {
 	testSlug := "users"
 	testName := testAccGetTestName(testSlug)
@@ -31,18 +95,28 @@ resource "netbox_token" "test_basic" {
   key           = "%s"
   allowed_ips   = ["2.4.8.16/32"]
   write_enabled = false
+  description   = "This is a test"
+  expires       = "2099-12-31T23:59:59Z"
 }`, testName, testToken),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("netbox_token.test_basic", "key", testToken),
+					resource.TestCheckResourceAttrWith("netbox_token.test_basic", "key", func(value string) error {
+						if !sensitiveValueMatchesHash(testToken, value) {
+							return fmt.Errorf("expected the salted hash of the key, got %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("netbox_token.test_basic", "description", "This is a test"),
+					resource.TestCheckResourceAttr("netbox_token.test_basic", "expires", "2099-12-31T23:59:59Z"),
 					resource.TestCheckResourceAttr("netbox_token.test_basic", "allowed_ips.#", "1"),
 					resource.TestCheckResourceAttr("netbox_token.test_basic", "allowed_ips.0", "2.4.8.16/32"),
 					resource.TestCheckResourceAttr("netbox_token.test_basic", "write_enabled", "false"),
 				),
 			},
 			{
-				ResourceName:      "netbox_token.test_basic",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_token.test_basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
 			},
 		},
 	})
@@ -0,0 +1,227 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vpnMinimumNetboxVersion is the first Netbox version with the VPN models.
+const vpnMinimumNetboxVersion = "3.7.0"
+
+func resourceNetboxTunnel() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxTunnelCreate,
+		Read:          resourceNetboxTunnelRead,
+		Update:        resourceNetboxTunnelUpdate,
+		Delete:        resourceNetboxTunnelDelete,
+		CustomizeDiff: resourceNetboxTunnelCustomizeDiff,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/tunnel/):
+
+> A tunnel represents a private virtual connection established among two or more endpoints across a shared infrastructure by employing protocol encapsulation. Common encapsulation techniques include Generic Routing Encapsulation (GRE), IP-in-IP, and IPSec. NetBox supports modeling both peer-to-peer and hub-and-spoke tunnel topologies.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider. The endpoints of the tunnel are managed with ` + "`netbox_tunnel_termination`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("planned", "active", "disabled"),
+			},
+			"encapsulation": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The encapsulation protocol of the tunnel, e.g. `ipsec-tunnel`, `ipsec-transport`, `ip-ip`, `gre`, `wireguard`, `openvpn`, `l2tp` or `pptp`. The encapsulation is validated against the encapsulations of Netbox during plan.",
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ipsec_profile_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the `netbox_ipsec_profile` used to establish the tunnel.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tunnel_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The numeric identifier of the tunnel, e.g. a GRE key.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxTunnelCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_tunnel requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/vpn/tunnels/", getTunnelRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxTunnelRead(d, m)
+}
+
+func resourceNetboxTunnelRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	tunnel, err := genericAPIRequest(api, "GET", fmt.Sprintf("/vpn/tunnels/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", tunnel["name"])
+	if status, ok := tunnel["status"].(map[string]interface{}); ok {
+		d.Set("status", status["value"])
+	}
+	if encapsulation, ok := tunnel["encapsulation"].(map[string]interface{}); ok {
+		d.Set("encapsulation", encapsulation["value"])
+	}
+	if groupID, ok := getGenericNestedObjectID(tunnel, "group"); ok {
+		d.Set("group_id", groupID)
+	} else {
+		d.Set("group_id", nil)
+	}
+	if profileID, ok := getGenericNestedObjectID(tunnel, "ipsec_profile"); ok {
+		d.Set("ipsec_profile_id", profileID)
+	} else {
+		d.Set("ipsec_profile_id", nil)
+	}
+	if tenantID, ok := getGenericNestedObjectID(tunnel, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	if tunnelID, ok := getGenericInt(tunnel, "tunnel_id"); ok {
+		d.Set("tunnel_id", tunnelID)
+	} else {
+		d.Set("tunnel_id", nil)
+	}
+	d.Set("description", tunnel["description"])
+	d.Set("comments", tunnel["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(tunnel)))
+
+	cf := getManagedCustomFields(api, d, tunnel[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxTunnelUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/vpn/tunnels/%d/", id), getTunnelRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxTunnelRead(d, m)
+}
+
+func resourceNetboxTunnelDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/vpn/tunnels/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxTunnelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	api := m.(*providerState)
+	// Older versions do not know the endpoint, so the choices cannot be retrieved
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_tunnel requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+	if err := validateChoiceAttribute(d, m, "/vpn/tunnels/", "status", "status"); err != nil {
+		return err
+	}
+	return validateChoiceAttribute(d, m, "/vpn/tunnels/", "encapsulation", "encapsulation")
+}
+
+// getTunnelRequestData returns the request body for creating or updating a tunnel. Unset references are sent as null,
+// so removing them from the configuration clears them.
+func getTunnelRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"status":        d.Get("status").(string),
+		"encapsulation": d.Get("encapsulation").(string),
+		"group":         nil,
+		"ipsec_profile": nil,
+		"tenant":        nil,
+		"tunnel_id":     nil,
+		"description":   d.Get("description").(string),
+		"comments":      d.Get("comments").(string),
+	}
+
+	if groupID, ok := d.GetOk("group_id"); ok {
+		data["group"] = groupID.(int)
+	}
+	if profileID, ok := d.GetOk("ipsec_profile_id"); ok {
+		data["ipsec_profile"] = profileID.(int)
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+	if tunnelID, ok := d.GetOk("tunnel_id"); ok {
+		data["tunnel_id"] = tunnelID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,16 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxTunnelGroupRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxTunnelGroup().TestResourceData()
+	d.Set("name", "AWS VPN")
+
+	err := resourceNetboxTunnelGroupCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
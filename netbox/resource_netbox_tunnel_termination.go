@@ -0,0 +1,166 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxTunnelTermination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxTunnelTerminationCreate,
+		Read:   resourceNetboxTunnelTerminationRead,
+		Update: resourceNetboxTunnelTerminationUpdate,
+		Delete: resourceNetboxTunnelTerminationDelete,
+
+		Description: `:meta:subcategory:VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/tunneltermination/):
+
+> A tunnel termination connects a device or virtual machine interface to a tunnel. Each tunnel may have one or more terminations.
+
+This resource requires Netbox 3.7 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"tunnel_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "peer",
+				ValidateFunc: validation.StringInSlice([]string{"peer", "hub", "spoke"}, false),
+				Description:  "The role of the termination in the tunnel topology. One of `peer`, `hub` or `spoke`.",
+			},
+			"termination_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"dcim.interface", "virtualization.vminterface"}, false),
+				Description:  "The type of the interface given in `termination_id`. One of `dcim.interface` or `virtualization.vminterface`.",
+			},
+			"termination_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the device or virtual machine interface that terminates the tunnel.",
+			},
+			"outside_ip_address_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the public or underlay IP address the tunnel is established from, e.g. the public IP address of a VPN gateway.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxTunnelTerminationCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vpnMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_tunnel_termination requires Netbox %s or later, but the Netbox version is %s", vpnMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/vpn/tunnel-terminations/", getTunnelTerminationRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxTunnelTerminationRead(d, m)
+}
+
+func resourceNetboxTunnelTerminationRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	termination, err := genericAPIRequest(api, "GET", fmt.Sprintf("/vpn/tunnel-terminations/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if tunnelID, ok := getGenericNestedObjectID(termination, "tunnel"); ok {
+		d.Set("tunnel_id", tunnelID)
+	}
+	if role, ok := termination["role"].(map[string]interface{}); ok {
+		d.Set("role", role["value"])
+	}
+	d.Set("termination_type", termination["termination_type"])
+	if terminationID, ok := getGenericInt(termination, "termination_id"); ok {
+		d.Set("termination_id", terminationID)
+	}
+	if outsideIPID, ok := getGenericNestedObjectID(termination, "outside_ip"); ok {
+		d.Set("outside_ip_address_id", outsideIPID)
+	} else {
+		d.Set("outside_ip_address_id", nil)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(termination)))
+
+	cf := getManagedCustomFields(api, d, termination[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxTunnelTerminationUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/vpn/tunnel-terminations/%d/", id), getTunnelTerminationRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxTunnelTerminationRead(d, m)
+}
+
+func resourceNetboxTunnelTerminationDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/vpn/tunnel-terminations/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getTunnelTerminationRequestData returns the request body for creating or updating a tunnel termination.
+func getTunnelTerminationRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"tunnel":           d.Get("tunnel_id").(int),
+		"role":             d.Get("role").(string),
+		"termination_type": d.Get("termination_type").(string),
+		"termination_id":   d.Get("termination_id").(int),
+		"outside_ip":       nil,
+	}
+
+	if outsideIPID, ok := d.GetOk("outside_ip_address_id"); ok {
+		data["outside_ip"] = outsideIPID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,35 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxTunnelTerminationRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxTunnelTermination().TestResourceData()
+	d.Set("tunnel_id", 1)
+	d.Set("termination_type", "dcim.interface")
+	d.Set("termination_id", 2)
+
+	err := resourceNetboxTunnelTerminationCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
+
+func TestGetTunnelTerminationRequestData(t *testing.T) {
+	api := &providerState{}
+	d := resourceNetboxTunnelTermination().TestResourceData()
+	d.Set("tunnel_id", 1)
+	d.Set("termination_type", "virtualization.vminterface")
+	d.Set("termination_id", 2)
+	d.Set("role", "hub")
+
+	data := getTunnelTerminationRequestData(api, d)
+	assert.Equal(t, 1, data["tunnel"])
+	assert.Equal(t, "hub", data["role"])
+	assert.Equal(t, "virtualization.vminterface", data["termination_type"])
+	assert.Equal(t, 2, data["termination_id"])
+	assert.Contains(t, data, "outside_ip")
+	assert.Nil(t, data["outside_ip"])
+}
@@ -0,0 +1,46 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxTunnelRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	d := resourceNetboxTunnel().TestResourceData()
+	d.Set("name", "aws-vpn-1")
+	d.Set("encapsulation", "ipsec-tunnel")
+
+	err := resourceNetboxTunnelCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
+
+func TestGetTunnelRequestData(t *testing.T) {
+	api := &providerState{}
+	d := resourceNetboxTunnel().TestResourceData()
+	d.Set("name", "aws-vpn-1")
+	d.Set("encapsulation", "ipsec-tunnel")
+	d.Set("group_id", 1)
+	d.Set("tunnel_id", 100)
+	d.Set("status", "planned")
+
+	data := getTunnelRequestData(api, d)
+	assert.Equal(t, "aws-vpn-1", data["name"])
+	assert.Equal(t, "planned", data["status"])
+	assert.Equal(t, "ipsec-tunnel", data["encapsulation"])
+	assert.Equal(t, 1, data["group"])
+	assert.Equal(t, 100, data["tunnel_id"])
+	// Unset references are sent explicitly to clear them
+	assert.Contains(t, data, "tenant")
+	assert.Nil(t, data["tenant"])
+	assert.Contains(t, data, "ipsec_profile")
+	assert.Nil(t, data["ipsec_profile"])
+}
+
+func TestNetboxTunnelCustomizeDiffRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.6.9"}
+	err := resourceNetboxTunnelCustomizeDiff(context.Background(), nil, api)
+	assert.ErrorContains(t, err, "requires Netbox 3.7.0 or later")
+}
@@ -1,12 +1,11 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
-	"github.com/fbreckle/go-netbox/netbox/client/users"
-	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxUser() *schema.Resource {
@@ -16,17 +15,42 @@ func resourceNetboxUser() *schema.Resource {
 		Update: resourceNetboxUserUpdate,
 		Delete: resourceNetboxUserDelete,
 
-		Description: `:meta:subcategory:Authentication:This resource is used to manage users.`,
+		Description: `:meta:subcategory:Authentication:This resource is used to manage local users, e.g. to bootstrap the users of a fresh Netbox instance.
+
+The password of a user cannot be read from Netbox, so it is never stored in the Terraform state, only a salted PBKDF2 hash of it. Changes of the password in the configuration are detected while changes of the password in Netbox are not. The password is only sent to Netbox when the user is created or the password in the configuration changes.
+
+The groups of a user are only managed if ` + "`group_ids`" + ` is set, in which case the user is a member of exactly the given groups. Otherwise the groups are left untouched, so they can be managed with the ` + "`netbox_user_group_assignment`" + ` resource instead.
+
+This resource is not covered by the generated API client and therefore uses the generic API of the provider.`,
 
 		Schema: map[string]*schema.Schema{
 			"username": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 150),
 			},
 			"password": {
 				Type:      schema.TypeString,
 				Required:  true,
 				Sensitive: true,
+				// Only a salted hash of the password is stored in the state
+				DiffSuppressFunc: suppressSensitiveValueDiff,
+				Description:      "The password of the user. It is not stored in the state, only a salted PBKDF2-SHA256 hash of it.",
+			},
+			"first_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 150),
+			},
+			"last_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 150),
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 254),
 			},
 			"active": {
 				Type:     schema.TypeBool,
@@ -38,47 +62,69 @@ func resourceNetboxUser() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"superuser": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the user has all permissions without explicitly assigning them. Netbox versions that do not expose this flag in their API always report `false`.",
+			},
+			"group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the groups the user is a member of. If set, the user is removed from all other groups. If not set, the groups of the user are not managed by this resource.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceNetboxUserResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceNetboxUserStateUpgradeV0,
+				Version: 0,
+			},
+		},
 	}
 }
+
 func resourceNetboxUserCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	data := models.WritableUser{}
+	api := m.(*providerState)
 
-	username := d.Get("username").(string)
+	data := getUserRequestData(d)
 	password := d.Get("password").(string)
-	active := d.Get("active").(bool)
-	staff := d.Get("staff").(bool)
-
-	data.Username = &username
-	data.Password = &password
-	data.IsActive = active
-	data.IsStaff = staff
+	data["password"] = password
+	passwordHash, err := saltedHashSensitiveValue(password)
+	if err != nil {
+		return err
+	}
 
-	data.Groups = []int64{}
+	res, err := genericAPIRequest(api, "POST", "/users/users/", data)
+	if err != nil {
+		return err
+	}
 
-	params := users.NewUsersUsersCreateParams().WithData(&data)
-	res, err := api.Users.UsersUsersCreate(params, nil)
+	id, err := getGenericObjectID(res)
 	if err != nil {
 		return err
 	}
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	d.SetId(strconv.FormatInt(id, 10))
+	d.Set("password", passwordHash)
 
 	return resourceNetboxUserRead(d, m)
 }
 
 func resourceNetboxUserRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersUsersReadParams().WithID(id)
 
-	res, err := api.Users.UsersUsersRead(params, nil)
+	user, err := genericAPIRequest(api, "GET", fmt.Sprintf("/users/users/%d/", id), nil)
 	if err != nil {
-		errorcode := err.(*users.UsersUsersReadDefault).Code()
-		if errorcode == 404 {
+		if isGenericAPINotFound(err) {
 			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
 			d.SetId("")
 			return nil
@@ -86,51 +132,81 @@ func resourceNetboxUserRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if res.GetPayload().Username != nil {
-		d.Set("username", res.GetPayload().Username)
-	}
-
-	d.Set("staff", res.GetPayload().IsStaff)
-	d.Set("active", res.GetPayload().IsActive)
+	d.Set("username", user["username"])
+	d.Set("first_name", user["first_name"])
+	d.Set("last_name", user["last_name"])
+	d.Set("email", user["email"])
+	d.Set("active", user["is_active"])
+	d.Set("staff", user["is_staff"])
+	superuser, _ := user["is_superuser"].(bool)
+	d.Set("superuser", superuser)
+	d.Set("group_ids", getGenericNestedObjectIDList(user, "groups"))
 
-	// Passwords cannot be set and not read
+	// Passwords cannot be read
 
 	return nil
 }
 
 func resourceNetboxUserUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	data := models.WritableUser{}
-
-	username := d.Get("username").(string)
-	password := d.Get("password").(string)
-	active := d.Get("active").(bool)
-	staff := d.Get("staff").(bool)
 
-	data.Username = &username
-	data.Password = &password
-	data.IsActive = active
-	data.IsStaff = staff
-
-	data.Groups = []int64{}
+	data := getUserRequestData(d)
+	// The password is only sent when it changes, so passwords changed in Netbox are kept on other changes
+	passwordHash := ""
+	if d.HasChange("password") {
+		password := d.Get("password").(string)
+		var err error
+		passwordHash, err = saltedHashSensitiveValue(password)
+		if err != nil {
+			return err
+		}
+		data["password"] = password
+	}
 
-	params := users.NewUsersUsersUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Users.UsersUsersUpdate(params, nil)
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/users/users/%d/", id), data)
 	if err != nil {
 		return err
 	}
+	if passwordHash != "" {
+		d.Set("password", passwordHash)
+	}
+
 	return resourceNetboxUserRead(d, m)
 }
 
 func resourceNetboxUserDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersUsersDeleteParams().WithID(id)
-	_, err := api.Users.UsersUsersDelete(params, nil)
-	if err != nil {
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/users/users/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
 		return err
 	}
-	d.SetId("")
 	return nil
 }
+
+// getUserRequestData returns the request body for creating or updating a user without its password, which is only
+// sent when it changes. The groups are only sent if they are configured.
+func getUserRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"username":     d.Get("username").(string),
+		"first_name":   d.Get("first_name").(string),
+		"last_name":    d.Get("last_name").(string),
+		"email":        d.Get("email").(string),
+		"is_active":    d.Get("active").(bool),
+		"is_staff":     d.Get("staff").(bool),
+		"is_superuser": d.Get("superuser").(bool),
+	}
+	if isUserGroupIDsConfigured(d) {
+		data["groups"] = toInt64List(d.Get("group_ids"))
+	}
+	return data
+}
+
+// isUserGroupIDsConfigured returns true if the groups of the user are managed by the configuration. The raw config is
+// used, as group_ids is computed if it is not set.
+func isUserGroupIDsConfigured(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	return !config.IsNull() && !config.GetAttr("group_ids").IsNull()
+}
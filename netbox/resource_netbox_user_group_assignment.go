@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+func resourceNetboxUserGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxUserGroupAssignmentCreate,
+		Read:   resourceNetboxUserGroupAssignmentRead,
+		Delete: resourceNetboxUserGroupAssignmentDelete,
+
+		Description: `:meta:subcategory:Authentication:This resource adds a user to a group. It allows managing the members of a group independently of the users, e.g. users that are not managed by Terraform or managed in separate Terraform states.
+
+Destroying this resource removes the user from the group, but keeps all other groups of the user. The ` + "`netbox_user`" + ` resource managing the user must not set ` + "`group_ids`" + `, as it would otherwise remove the user from all groups that are not listed there.`,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetboxUserGroupAssignmentImport,
+		},
+	}
+}
+
+func resourceNetboxUserGroupAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	userID := int64(d.Get("user_id").(int))
+	groupID := int64(d.Get("group_id").(int))
+
+	err := updateUserGroups(api, userID, func(groups []int64) []int64 {
+		if slices.Contains(groups, groupID) {
+			return groups
+		}
+		return append(groups, groupID)
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%d:%d", userID, groupID))
+
+	return resourceNetboxUserGroupAssignmentRead(d, m)
+}
+
+func resourceNetboxUserGroupAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	userID := int64(d.Get("user_id").(int))
+	groupID := int64(d.Get("group_id").(int))
+
+	user, err := genericAPIRequest(api, "GET", fmt.Sprintf("/users/users/%d/", userID), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if !slices.Contains(getGenericNestedObjectIDList(user, "groups"), groupID) {
+		// The user was removed from the group out of band
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceNetboxUserGroupAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	userID := int64(d.Get("user_id").(int))
+	groupID := int64(d.Get("group_id").(int))
+
+	err := updateUserGroups(api, userID, func(groups []int64) []int64 {
+		result := []int64{}
+		for _, id := range groups {
+			if id != groupID {
+				result = append(result, id)
+			}
+		}
+		return result
+	})
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxUserGroupAssignmentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <user_id>:<group_id>", d.Id())
+	}
+	userID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%s), user_id must be a number", d.Id())
+	}
+	groupID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%s), group_id must be a number", d.Id())
+	}
+
+	d.Set("user_id", userID)
+	d.Set("group_id", groupID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateUserGroups sets the groups of the user to the result of update, which is called with the current groups of
+// the user. Netbox only accepts the groups of a user as a whole, so concurrent updates are serialized.
+func updateUserGroups(api *providerState, userID int64, update func([]int64) []int64) error {
+	api.userGroupsLock.Lock()
+	defer api.userGroupsLock.Unlock()
+
+	path := fmt.Sprintf("/users/users/%d/", userID)
+	user, err := genericAPIRequest(api, "GET", path, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = genericAPIRequest(api, "PATCH", path, map[string]interface{}{
+		"groups": update(getGenericNestedObjectIDList(user, "groups")),
+	})
+	return err
+}
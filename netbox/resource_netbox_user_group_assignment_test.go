@@ -0,0 +1,98 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxUserGroupAssignment(t *testing.T) {
+	groups := `[{"id": 1}]`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/api/users/users/5/", r.URL.Path)
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(fmt.Sprintf(`{"id": 5, "groups": %s}`, groups)))
+		case "PATCH":
+			var body map[string][]int64
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			nested := []map[string]int64{}
+			for _, id := range body["groups"] {
+				nested = append(nested, map[string]int64{"id": id})
+			}
+			res, _ := json.Marshal(nested)
+			groups = string(res)
+			w.Write([]byte(`{"id": 5}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	client, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI)}
+
+	d := resourceNetboxUserGroupAssignment().TestResourceData()
+	d.Set("user_id", 5)
+	d.Set("group_id", 2)
+
+	// Other groups of the user are kept
+	assert.NoError(t, resourceNetboxUserGroupAssignmentCreate(d, api))
+	assert.Equal(t, "5:2", d.Id())
+	assert.JSONEq(t, `[{"id": 1}, {"id": 2}]`, groups)
+
+	assert.NoError(t, resourceNetboxUserGroupAssignmentDelete(d, api))
+	assert.JSONEq(t, `[{"id": 1}]`, groups)
+
+	// The user was removed from the group out of band
+	assert.NoError(t, resourceNetboxUserGroupAssignmentRead(d, api))
+	assert.Equal(t, "", d.Id())
+}
+
+func TestAccNetboxUserGroupAssignment_basic(t *testing.T) {
+	testSlug := "user_group_assignment"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_user" "test" {
+  username = "%[1]s"
+  password = "abcdefghijkl"
+}
+
+resource "netbox_user_group_assignment" "test" {
+  user_id  = netbox_user.test.id
+  group_id = netbox_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_user_group_assignment.test", "user_id", "netbox_user.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_user_group_assignment.test", "group_id", "netbox_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_user_group_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResourceNetboxUserStateUpgradeV0(t *testing.T) {
+	actual, err := resourceNetboxUserStateUpgradeV0(context.Background(), map[string]interface{}{"username": "jdoe", "password": "abcdefghijkl"}, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	password := actual["password"].(string)
+	if password == "abcdefghijkl" || !sensitiveValueMatchesHash("abcdefghijkl", password) {
+		t.Fatalf("\n\nexpected the salted hash of the password, got:\n\n%#v\n\n", password)
+	}
+	if actual["username"] != "jdoe" {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", "jdoe", actual["username"])
+	}
+
+	// An already hashed password is kept
+	again, err := resourceNetboxUserStateUpgradeV0(context.Background(), map[string]interface{}{"password": password}, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+	if again["password"] != password {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", password, again["password"])
+	}
+}
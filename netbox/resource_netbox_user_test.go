@@ -1,16 +1,112 @@
 package netbox
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestNetboxUserCreate(t *testing.T) {
+	var body map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/users/users/":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			w.Write([]byte(`{"id": 5}`))
+		case r.Method == "GET" && r.URL.Path == "/api/users/users/5/":
+			w.Write([]byte(`{"id": 5, "username": "jdoe", "email": "jane.doe@example.com", "is_active": true, "is_staff": false, "groups": [{"id": 2}]}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxUser().Schema, map[string]interface{}{
+		"username": "jdoe",
+		"password": "abcdefghijkl",
+		"email":    "jane.doe@example.com",
+		"active":   true,
+	})
+
+	// The password is only part of the request data on create or when it changes
+	assert.NotContains(t, getUserRequestData(d), "password")
+
+	assert.NoError(t, resourceNetboxUserCreate(d, api))
+	assert.Equal(t, "5", d.Id())
+	assert.Equal(t, "abcdefghijkl", body["password"])
+	assert.Equal(t, false, body["is_superuser"])
+	assert.True(t, sensitiveValueMatchesHash("abcdefghijkl", d.State().Attributes["password"]))
+
+	// The groups are not configured, so they are left to netbox_user_group_assignment
+	assert.NotContains(t, body, "groups")
+	assert.Equal(t, []interface{}{2}, d.Get("group_ids").(*schema.Set).List())
+}
+
+func TestGetUserRequestDataGroups(t *testing.T) {
+	r := resourceNetboxUser()
+	for _, tt := range []struct {
+		name     string
+		config   string
+		expected interface{}
+	}{
+		{
+			name:     "Configured",
+			config:   `{"username": "jdoe", "password": "abcdefghijkl", "group_ids": [2]}`,
+			expected: []int64{2},
+		},
+		{
+			name:     "ConfiguredEmpty",
+			config:   `{"username": "jdoe", "password": "abcdefghijkl", "group_ids": []}`,
+			expected: []int64{},
+		},
+		{
+			name:     "NotConfigured",
+			config:   `{"username": "jdoe", "password": "abcdefghijkl"}`,
+			expected: nil,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.config), &raw))
+			diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			assert.NoError(t, err)
+			diff.RawConfig, err = ctyjson.Unmarshal([]byte(tt.config), schema.InternalMap(r.Schema).CoreConfigSchema().ImpliedType())
+			assert.NoError(t, err)
+			d, err := schema.InternalMap(r.Schema).Data(nil, diff)
+			assert.NoError(t, err)
+
+			groups, ok := getUserRequestData(d)["groups"]
+			if tt.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+			assert.Equal(t, tt.expected, groups)
+		})
+	}
+}
+
 func TestAccNetboxUser_basic(t *testing.T) {
 	testSlug := "users"
 	testName := testAccGetTestName(testSlug)
@@ -23,6 +119,9 @@ func TestAccNetboxUser_basic(t *testing.T) {
 resource "netbox_user" "test_basic" {
   username = "%s"
   password = "abcdefghijkl"
+  first_name = "Jane"
+  last_name = "Doe"
+  email = "jane.doe@example.com"
   active = true
   staff = true
 }`, testName),
@@ -30,6 +129,10 @@ resource "netbox_user" "test_basic" {
 					resource.TestCheckResourceAttr("netbox_user.test_basic", "username", testName),
 					resource.TestCheckResourceAttr("netbox_user.test_basic", "active", "true"),
 					resource.TestCheckResourceAttr("netbox_user.test_basic", "staff", "true"),
+					resource.TestCheckResourceAttr("netbox_user.test_basic", "first_name", "Jane"),
+					resource.TestCheckResourceAttr("netbox_user.test_basic", "last_name", "Doe"),
+					resource.TestCheckResourceAttr("netbox_user.test_basic", "email", "jane.doe@example.com"),
+					resource.TestCheckResourceAttr("netbox_user.test_basic", "group_ids.#", "0"),
 				),
 			},
 			{
@@ -0,0 +1,203 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// virtualCircuitMinimumNetboxVersion is the first Netbox version with virtual circuits.
+const virtualCircuitMinimumNetboxVersion = "4.2.0"
+
+func resourceNetboxVirtualCircuit() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxVirtualCircuitCreate,
+		Read:          resourceNetboxVirtualCircuitRead,
+		Update:        resourceNetboxVirtualCircuitUpdate,
+		Delete:        resourceNetboxVirtualCircuitDelete,
+		CustomizeDiff: resourceNetboxVirtualCircuitCustomizeDiff,
+
+		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/models/circuits/virtualcircuit/):
+
+> A virtual circuit can connect two or more interfaces atop a set of decoupled physical connections. For example, it's very common to form a virtual connection between two virtual interfaces, each of which is bound to a physical interface on its respective device and physically connected to a provider network via an independent physical circuit.
+
+This resource requires Netbox 4.2 or later. It is not covered by the generated API client and therefore uses the generic API of the provider. Connect interfaces to the virtual circuit with ` + "`netbox_virtual_circuit_termination`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"cid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The circuit ID. It must be unique per provider network.",
+			},
+			"provider_network_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"provider_account_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("planned", "provisioning", "active", "offline", "deprovisioning", "decommissioning"),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVirtualCircuitCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(virtualCircuitMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_virtual_circuit requires Netbox %s or later, but the Netbox version is %s", virtualCircuitMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/circuits/virtual-circuits/", getVirtualCircuitRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxVirtualCircuitRead(d, m)
+}
+
+func resourceNetboxVirtualCircuitRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	circuit, err := genericAPIRequest(api, "GET", fmt.Sprintf("/circuits/virtual-circuits/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("cid", circuit["cid"])
+	if providerNetworkID, ok := getGenericNestedObjectID(circuit, "provider_network"); ok {
+		d.Set("provider_network_id", providerNetworkID)
+	}
+	if providerAccountID, ok := getGenericNestedObjectID(circuit, "provider_account"); ok {
+		d.Set("provider_account_id", providerAccountID)
+	} else {
+		d.Set("provider_account_id", nil)
+	}
+	if typeID, ok := getGenericNestedObjectID(circuit, "type"); ok {
+		d.Set("type_id", typeID)
+	}
+	if status, ok := circuit["status"].(map[string]interface{}); ok {
+		d.Set("status", status["value"])
+	}
+	if tenantID, ok := getGenericNestedObjectID(circuit, "tenant"); ok {
+		d.Set("tenant_id", tenantID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", circuit["description"])
+	d.Set("comments", circuit["comments"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(circuit)))
+
+	cf := getManagedCustomFields(api, d, circuit[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxVirtualCircuitUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/circuits/virtual-circuits/%d/", id), getVirtualCircuitRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVirtualCircuitRead(d, m)
+}
+
+func resourceNetboxVirtualCircuitDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/circuits/virtual-circuits/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxVirtualCircuitCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	api := m.(*providerState)
+	// Older versions do not know the endpoint, so the statuses cannot be retrieved
+	if !api.hasNetboxVersion(virtualCircuitMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_virtual_circuit requires Netbox %s or later, but the Netbox version is %s", virtualCircuitMinimumNetboxVersion, api.netboxVersion)
+	}
+	return validateChoiceAttribute(d, m, "/circuits/virtual-circuits/", "status", "status")
+}
+
+// getVirtualCircuitRequestData returns the request body for creating or updating a virtual circuit. Unset references
+// are sent as null, so removing them from the configuration clears them.
+func getVirtualCircuitRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"cid":              d.Get("cid").(string),
+		"provider_network": d.Get("provider_network_id").(int),
+		"provider_account": nil,
+		"type":             d.Get("type_id").(int),
+		"status":           d.Get("status").(string),
+		"tenant":           nil,
+		"description":      d.Get("description").(string),
+		"comments":         d.Get("comments").(string),
+	}
+
+	if providerAccountID, ok := d.GetOk("provider_account_id"); ok {
+		data["provider_account"] = providerAccountID.(int)
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = tenantID.(int)
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,151 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxVirtualCircuitTermination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVirtualCircuitTerminationCreate,
+		Read:   resourceNetboxVirtualCircuitTerminationRead,
+		Update: resourceNetboxVirtualCircuitTerminationUpdate,
+		Delete: resourceNetboxVirtualCircuitTerminationDelete,
+
+		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/models/circuits/virtualcircuittermination/):
+
+> This model represents the connection of a virtual device interface to a virtual circuit.
+
+This resource requires Netbox 4.2 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_circuit_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"interface_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the device interface that terminates the virtual circuit. This is usually a virtual interface on top of the physical interface connected to the provider network.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "peer",
+				ValidateFunc: validation.StringInSlice([]string{"peer", "hub", "spoke"}, false),
+				Description:  "The role of the termination in the virtual circuit topology. One of `peer`, `hub` or `spoke`.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVirtualCircuitTerminationCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(virtualCircuitMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_virtual_circuit_termination requires Netbox %s or later, but the Netbox version is %s", virtualCircuitMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/circuits/virtual-circuit-terminations/", getVirtualCircuitTerminationRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxVirtualCircuitTerminationRead(d, m)
+}
+
+func resourceNetboxVirtualCircuitTerminationRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	termination, err := genericAPIRequest(api, "GET", fmt.Sprintf("/circuits/virtual-circuit-terminations/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if circuitID, ok := getGenericNestedObjectID(termination, "virtual_circuit"); ok {
+		d.Set("virtual_circuit_id", circuitID)
+	}
+	if interfaceID, ok := getGenericNestedObjectID(termination, "interface"); ok {
+		d.Set("interface_id", interfaceID)
+	}
+	if role, ok := termination["role"].(map[string]interface{}); ok {
+		d.Set("role", role["value"])
+	}
+	d.Set("description", termination["description"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(termination)))
+
+	cf := getManagedCustomFields(api, d, termination[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxVirtualCircuitTerminationUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/circuits/virtual-circuit-terminations/%d/", id), getVirtualCircuitTerminationRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVirtualCircuitTerminationRead(d, m)
+}
+
+func resourceNetboxVirtualCircuitTerminationDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/circuits/virtual-circuit-terminations/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getVirtualCircuitTerminationRequestData returns the request body for creating or updating a virtual circuit
+// termination.
+func getVirtualCircuitTerminationRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"virtual_circuit": d.Get("virtual_circuit_id").(int),
+		"interface":       d.Get("interface_id").(int),
+		"role":            d.Get("role").(string),
+		"description":     d.Get("description").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,17 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxVirtualCircuitTerminationRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "4.1.11"}
+	d := resourceNetboxVirtualCircuitTermination().TestResourceData()
+	d.Set("virtual_circuit_id", 1)
+	d.Set("interface_id", 2)
+
+	err := resourceNetboxVirtualCircuitTerminationCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+}
@@ -0,0 +1,45 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxVirtualCircuitRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "4.1.11"}
+	d := resourceNetboxVirtualCircuit().TestResourceData()
+	d.Set("cid", "VC-1")
+	d.Set("provider_network_id", 1)
+	d.Set("type_id", 1)
+
+	err := resourceNetboxVirtualCircuitCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+}
+
+func TestGetVirtualCircuitRequestData(t *testing.T) {
+	api := &providerState{}
+	d := resourceNetboxVirtualCircuit().TestResourceData()
+	d.Set("cid", "VC-1")
+	d.Set("provider_network_id", 1)
+	d.Set("type_id", 2)
+	d.Set("status", "planned")
+	d.Set("tenant_id", 3)
+
+	data := getVirtualCircuitRequestData(api, d)
+	assert.Equal(t, "VC-1", data["cid"])
+	assert.Equal(t, 1, data["provider_network"])
+	assert.Equal(t, 2, data["type"])
+	assert.Equal(t, "planned", data["status"])
+	assert.Equal(t, 3, data["tenant"])
+	// Unset references are sent explicitly to clear them
+	assert.Contains(t, data, "provider_account")
+	assert.Nil(t, data["provider_account"])
+}
+
+func TestNetboxVirtualCircuitCustomizeDiffRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "4.1.11"}
+	err := resourceNetboxVirtualCircuitCustomizeDiff(context.Background(), nil, api)
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+}
@@ -0,0 +1,150 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxVirtualCircuitType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVirtualCircuitTypeCreate,
+		Read:   resourceNetboxVirtualCircuitTypeRead,
+		Update: resourceNetboxVirtualCircuitTypeUpdate,
+		Delete: resourceNetboxVirtualCircuitTypeDelete,
+
+		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/models/circuits/virtualcircuittype/):
+
+> Virtual circuits can be organized by their respective functions. Each virtual circuit type must be assigned a name and slug.
+
+This resource requires Netbox 4.2 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"color_hex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{6}$"), "Must be hex color string"),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVirtualCircuitTypeCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(virtualCircuitMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_virtual_circuit_type requires Netbox %s or later, but the Netbox version is %s", virtualCircuitMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/circuits/virtual-circuit-types/", getVirtualCircuitTypeRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxVirtualCircuitTypeRead(d, m)
+}
+
+func resourceNetboxVirtualCircuitTypeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	circuitType, err := genericAPIRequest(api, "GET", fmt.Sprintf("/circuits/virtual-circuit-types/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", circuitType["name"])
+	d.Set("slug", circuitType["slug"])
+	d.Set("color_hex", circuitType["color"])
+	d.Set("description", circuitType["description"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(circuitType)))
+
+	cf := getManagedCustomFields(api, d, circuitType[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxVirtualCircuitTypeUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/circuits/virtual-circuit-types/%d/", id), getVirtualCircuitTypeRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVirtualCircuitTypeRead(d, m)
+}
+
+func resourceNetboxVirtualCircuitTypeDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/circuits/virtual-circuit-types/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getVirtualCircuitTypeRequestData returns the request body for creating or updating a virtual circuit type.
+func getVirtualCircuitTypeRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	name := d.Get("name").(string)
+	slug := getSlug(name)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := map[string]interface{}{
+		"name":        name,
+		"slug":        slug,
+		"color":       d.Get("color_hex").(string),
+		"description": d.Get("description").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
@@ -0,0 +1,16 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxVirtualCircuitTypeRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "4.1.11"}
+	d := resourceNetboxVirtualCircuitType().TestResourceData()
+	d.Set("name", "Point-to-point")
+
+	err := resourceNetboxVirtualCircuitTypeCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+}
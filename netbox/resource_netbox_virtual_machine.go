@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,12 +12,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// virtualMachineConfigTemplateMinimumNetboxVersion is the first Netbox version with config templates on virtual machines.
+const virtualMachineConfigTemplateMinimumNetboxVersion = "4.0.0"
+
+// virtualMachineSerialMinimumNetboxVersion is the first Netbox version with serial numbers on virtual machines.
+const virtualMachineSerialMinimumNetboxVersion = "4.1.0"
+
 func resourceNetboxVirtualMachine() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNetboxVirtualMachineCreate,
 		ReadContext:   resourceNetboxVirtualMachineRead,
 		UpdateContext: resourceNetboxVirtualMachineUpdate,
 		DeleteContext: resourceNetboxVirtualMachineDelete,
+		CustomizeDiff: resourceNetboxVirtualMachineCustomizeDiff,
 
 		Description: `:meta:subcategory:Virtualization:From the [official documentation](https://docs.netbox.dev/en/stable/features/virtualization/#virtual-machines):
 
@@ -38,8 +45,10 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 				Optional: true,
 			},
 			"device_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"cluster_id"},
+				Description:  "The ID of the host device of the virtual machine. The device has to belong to the cluster given in `cluster_id`, which is validated during plan.",
 			},
 			"platform_id": {
 				Type:     schema.TypeInt,
@@ -63,19 +72,32 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 				Optional: true,
 			},
 			"vcpus": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				ValidateFunc:     validation.FloatBetween(0.01, 9999.99),
+				DiffSuppressFunc: suppressFloatRoundingDiff(2),
+				Description:      "The number of virtual CPUs, which may be fractional. Netbox stores the number with two decimal places, further decimal places are rounded.",
 			},
 			"disk_size_gb": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
 			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: statusDescription("offline", "active", "planned", "staged", "failed", "decommissioning"),
+			},
+			"serial": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"offline", "active", "planned", "staged", "failed", "decommissioning"}, false),
-				Default:      "active",
-				Description:  "Valid values are `offline`, `active`, `planned`, `staged`, `failed` and `decommissioning`",
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The serial number of the virtual machine. Requires Netbox 4.1 or later.",
+			},
+			"config_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the config template used to render the configuration of the virtual machine. Requires Netbox 4.0 or later.",
 			},
 			tagsKey: tagsSchema,
 			"primary_ipv4": {
@@ -103,7 +125,7 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 }
 
 func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -128,8 +150,7 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 
 	vcpusValue, ok := d.GetOk("vcpus")
 	if ok {
-		vcpus := vcpusValue.(float64)
-		data.Vcpus = &vcpus
+		data.Vcpus = float64ToPtr(roundFloat(vcpusValue.(float64), 2))
 	}
 
 	memoryMbValue, ok := d.GetOk("memory_mb")
@@ -172,10 +193,7 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	params := virtualization.NewVirtualizationVirtualMachinesCreateParams().WithData(&data)
 
@@ -186,11 +204,16 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	err = setVirtualMachineGenericFields(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceNetboxVirtualMachineRead(ctx, d, m)
 }
 
 func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -281,18 +304,33 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("status", nil)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(vm.Tags))
+	d.Set(tagsKey, getManagedTagList(api, d, vm.Tags))
 
-	cf := getCustomFields(vm.CustomFields)
+	cf := getManagedCustomFields(api, d, vm.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 
+	if api.hasNetboxVersion(virtualMachineConfigTemplateMinimumNetboxVersion) {
+		genericVM, err := genericAPIRequest(api, "GET", fmt.Sprintf("/virtualization/virtual-machines/%d/", id), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if configTemplateID, ok := getGenericNestedObjectID(genericVM, "config_template"); ok {
+			d.Set("config_template_id", configTemplateID)
+		} else {
+			d.Set("config_template_id", nil)
+		}
+		if api.hasNetboxVersion(virtualMachineSerialMinimumNetboxVersion) {
+			d.Set("serial", genericVM["serial"])
+		}
+	}
+
 	return diags
 }
 
 func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableVirtualMachineWithConfigContext{}
@@ -344,8 +382,7 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 
 	vcpusValue, ok := d.GetOk("vcpus")
 	if ok {
-		vcpus := vcpusValue.(float64)
-		data.Vcpus = &vcpus
+		data.Vcpus = float64ToPtr(roundFloat(vcpusValue.(float64), 2))
 	}
 
 	diskSizeValue, ok := d.GetOk("disk_size_gb")
@@ -377,10 +414,7 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
 
 	if d.HasChanges("comments") {
 		// check if comment is set
@@ -408,11 +442,16 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	err = setVirtualMachineGenericFields(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceNetboxVirtualMachineRead(ctx, d, m)
 }
 
 func resourceNetboxVirtualMachineDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -425,3 +464,73 @@ func resourceNetboxVirtualMachineDelete(ctx context.Context, d *schema.ResourceD
 	}
 	return diags
 }
+
+// resourceNetboxVirtualMachineCustomizeDiff validates the status and the host device during plan. A device or
+// cluster that is not known yet, e.g. because it is created in the same apply, is validated by Netbox instead.
+func resourceNetboxVirtualMachineCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	err := validateChoiceAttribute(d, m, "/virtualization/virtual-machines/", "status", "status")
+	if err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("device_id") || !d.NewValueKnown("cluster_id") || (!d.HasChange("device_id") && !d.HasChange("cluster_id")) {
+		return nil
+	}
+	deviceID := int64(d.Get("device_id").(int))
+	clusterID := int64(d.Get("cluster_id").(int))
+	api, ok := m.(*providerState)
+	if deviceID == 0 || clusterID == 0 || !ok || api == nil {
+		return nil
+	}
+	return validateVirtualMachineDevice(api, deviceID, clusterID)
+}
+
+// validateVirtualMachineDevice checks that the given host device belongs to the given cluster. Netbox rejects other
+// devices, but only with a generic error message after the other changes of the apply.
+func validateVirtualMachineDevice(api *providerState, deviceID int64, clusterID int64) error {
+	device, err := genericAPIRequest(api, "GET", fmt.Sprintf("/dcim/devices/%d/", deviceID), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			return fmt.Errorf("device_id: device %d does not exist", deviceID)
+		}
+		return err
+	}
+	deviceClusterID, ok := getGenericNestedObjectID(device, "cluster")
+	if !ok || deviceClusterID != clusterID {
+		return fmt.Errorf("device_id: device %d does not belong to cluster %d", deviceID, clusterID)
+	}
+	return nil
+}
+
+// setVirtualMachineGenericFields sets the changed attributes of the virtual machine that the generated client does
+// not know, i.e. the serial number and the config template.
+func setVirtualMachineGenericFields(api *providerState, d *schema.ResourceData) error {
+	data := map[string]interface{}{}
+
+	if d.HasChange("serial") {
+		serial := d.Get("serial").(string)
+		if serial != "" && !api.hasNetboxVersion(virtualMachineSerialMinimumNetboxVersion) {
+			return fmt.Errorf("serial requires Netbox %s or later, but the Netbox version is %s", virtualMachineSerialMinimumNetboxVersion, api.netboxVersion)
+		}
+		data["serial"] = serial
+	}
+
+	if d.HasChange("config_template_id") {
+		var configTemplateID interface{}
+		if id, ok := d.GetOk("config_template_id"); ok {
+			if !api.hasNetboxVersion(virtualMachineConfigTemplateMinimumNetboxVersion) {
+				return fmt.Errorf("config_template_id requires Netbox %s or later, but the Netbox version is %s", virtualMachineConfigTemplateMinimumNetboxVersion, api.netboxVersion)
+			}
+			configTemplateID = id.(int)
+		}
+		data["config_template"] = configTemplateID
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/virtualization/virtual-machines/%d/", id), data)
+	return err
+}
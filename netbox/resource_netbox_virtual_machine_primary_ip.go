@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxVirtualMachinePrimaryIP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVirtualMachinePrimaryIPCreate,
+		Read:   resourceNetboxVirtualMachinePrimaryIPRead,
+		Update: resourceNetboxVirtualMachinePrimaryIPUpdate,
+		Delete: resourceNetboxVirtualMachinePrimaryIPDelete,
+
+		Description: `:meta:subcategory:Virtualization:This resource is used to define the primary IP for a given virtual machine. The primary IP is reflected in the Virtual machine Netbox UI, which identifies the Primary IPv4 and IPv6 addresses.
+
+Netbox only accepts IP addresses that are assigned to an interface of the virtual machine as primary IP. Managing the primary IP in a separate resource allows creating the virtual machine, its interface, the IP address and the primary IP in a single apply.`,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ip_address_version": {
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+				Optional:     true,
+				Default:      4,
+				ForceNew:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVirtualMachinePrimaryIPCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(strconv.Itoa(d.Get("virtual_machine_id").(int)))
+
+	return resourceNetboxVirtualMachinePrimaryIPUpdate(d, m)
+}
+
+func resourceNetboxVirtualMachinePrimaryIPRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := virtualization.NewVirtualizationVirtualMachinesReadParams().WithID(id)
+
+	res, err := api.Virtualization.VirtualizationVirtualMachinesRead(params, nil)
+	if err != nil {
+		errorcode := err.(*virtualization.VirtualizationVirtualMachinesReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	vm := res.GetPayload()
+
+	IPAddressVersion := d.Get("ip_address_version").(int)
+	d.Set("ip_address_version", IPAddressVersion)
+
+	if IPAddressVersion == 4 && vm.PrimaryIp4 != nil {
+		d.Set("ip_address_id", vm.PrimaryIp4.ID)
+	} else if IPAddressVersion == 6 && vm.PrimaryIp6 != nil {
+		d.Set("ip_address_id", vm.PrimaryIp6.ID)
+	} else {
+		// if the vm exists, but has no primary ip, consider this element deleted
+		d.SetId("")
+		return nil
+	}
+	d.Set("virtual_machine_id", vm.ID)
+	return nil
+}
+
+func resourceNetboxVirtualMachinePrimaryIPUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	virtualMachineID := int64(d.Get("virtual_machine_id").(int))
+	IPAddressID := int64(d.Get("ip_address_id").(int))
+
+	err := validateVirtualMachineIPAssignment(api, virtualMachineID, IPAddressID)
+	if err != nil {
+		return err
+	}
+
+	err = setVirtualMachinePrimaryIP(api, virtualMachineID, d.Get("ip_address_version").(int), &IPAddressID)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVirtualMachinePrimaryIPRead(d, m)
+}
+
+func resourceNetboxVirtualMachinePrimaryIPDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := setVirtualMachinePrimaryIP(api, int64(d.Get("virtual_machine_id").(int)), d.Get("ip_address_version").(int), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// setVirtualMachinePrimaryIP sets the primary IP of the given version of a virtual machine. A nil ID unsets it.
+// Only the primary IP field is patched, so the other attributes of the virtual machine are left untouched.
+func setVirtualMachinePrimaryIP(api *providerState, virtualMachineID int64, IPAddressVersion int, IPAddressID *int64) error {
+	field := fmt.Sprintf("primary_ip%d", IPAddressVersion)
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/virtualization/virtual-machines/%d/", virtualMachineID), map[string]interface{}{field: IPAddressID})
+	return err
+}
+
+// validateVirtualMachineIPAssignment returns an error if the IP address is not assigned to an interface of the
+// virtual machine, which Netbox requires for a primary IP.
+func validateVirtualMachineIPAssignment(api *providerState, virtualMachineID int64, IPAddressID int64) error {
+	ipAddress, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/ip-addresses/%d/", IPAddressID), nil)
+	if err != nil {
+		return err
+	}
+
+	assignedObjectType, _ := ipAddress["assigned_object_type"].(string)
+	assignedObject, _ := ipAddress["assigned_object"].(map[string]interface{})
+	if assignedObjectType == "virtualization.vminterface" && assignedObject != nil {
+		if assignedVirtualMachineID, ok := getGenericNestedObjectID(assignedObject, "virtual_machine"); ok && assignedVirtualMachineID == virtualMachineID {
+			return nil
+		}
+	}
+	return fmt.Errorf("IP address %d is not assigned to an interface of virtual machine %d", IPAddressID, virtualMachineID)
+}
@@ -0,0 +1,78 @@
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVirtualMachineIPAssignment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/ipam/ip-addresses/1/":
+			w.Write([]byte(`{"id": 1, "assigned_object_type": "virtualization.vminterface", "assigned_object": {"id": 10, "virtual_machine": {"id": 42}}}`))
+		case "/api/ipam/ip-addresses/2/":
+			w.Write([]byte(`{"id": 2, "assigned_object_type": "dcim.interface", "assigned_object": {"id": 10, "device": {"id": 42}}}`))
+		case "/api/ipam/ip-addresses/3/":
+			w.Write([]byte(`{"id": 3, "assigned_object_type": null, "assigned_object": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	assert.NoError(t, validateVirtualMachineIPAssignment(api, 42, 1))
+	assert.Error(t, validateVirtualMachineIPAssignment(api, 43, 1))
+	assert.Error(t, validateVirtualMachineIPAssignment(api, 42, 2))
+	assert.Error(t, validateVirtualMachineIPAssignment(api, 42, 3))
+	assert.True(t, isGenericAPINotFound(validateVirtualMachineIPAssignment(api, 42, 4)))
+}
+
+func TestAccNetboxVirtualMachinePrimaryIP_basic(t *testing.T) {
+	testSlug := "vm_pr_ip_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxPrimaryIPFullDependencies(testName) + `
+resource "netbox_virtual_machine_primary_ip" "test_v4" {
+  virtual_machine_id = netbox_virtual_machine.test.id
+  ip_address_id = netbox_ip_address.test_v4.id
+}
+
+resource "netbox_virtual_machine_primary_ip" "test_v6" {
+  virtual_machine_id = netbox_virtual_machine.test.id
+  ip_address_id = netbox_ip_address.test_v6.id
+  ip_address_version = 6
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_virtual_machine_primary_ip.test_v4", "virtual_machine_id", "netbox_virtual_machine.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtual_machine_primary_ip.test_v4", "ip_address_id", "netbox_ip_address.test_v4", "id"),
+					resource.TestCheckResourceAttr("netbox_virtual_machine_primary_ip.test_v4", "ip_address_version", "4"),
+					resource.TestCheckResourceAttrPair("netbox_virtual_machine_primary_ip.test_v6", "ip_address_id", "netbox_ip_address.test_v6", "id"),
+					resource.TestCheckResourceAttr("netbox_virtual_machine_primary_ip.test_v6", "ip_address_version", "6"),
+					// Only the primary IP is patched, the other attributes of the virtual machine are kept
+					resource.TestCheckResourceAttr("netbox_virtual_machine.test", "comments", "thisisacomment"),
+					resource.TestCheckResourceAttr("netbox_virtual_machine.test", "status", "planned"),
+				),
+			},
+		},
+	})
+}
@@ -1,8 +1,11 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
@@ -10,7 +13,9 @@ import (
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxVirtualMachineFullDependencies(testName string) string {
@@ -311,6 +316,19 @@ resource "netbox_virtual_machine" "test" {
 					resource.TestCheckResourceAttr("netbox_virtual_machine.test", "vcpus", "4"),
 				),
 			},
+			{
+				// Netbox rounds to two decimal places, which must not show a diff
+				Config: testAccNetboxVirtualMachineFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_machine" "test" {
+  name = "%s"
+  cluster_id = netbox_cluster.test.id
+  site_id = netbox_site.test.id
+  vcpus = 1.333
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_machine.test", "vcpus", "1.33"),
+				),
+			},
 			{
 				ResourceName:      "netbox_virtual_machine.test",
 				ImportState:       true,
@@ -320,9 +338,87 @@ resource "netbox_virtual_machine" "test" {
 	})
 }
 
+func TestSetVirtualMachineGenericFields(t *testing.T) {
+	var requestBody map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/virtualization/virtual-machines/1/", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&requestBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI), netboxVersion: "4.1.0"}
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxVirtualMachine().Schema, map[string]interface{}{
+		"name":               "test",
+		"serial":             "ABC123",
+		"config_template_id": 3,
+	})
+	d.SetId("1")
+
+	err = setVirtualMachineGenericFields(api, d)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"serial": "ABC123", "config_template": float64(3)}, requestBody)
+
+	// Netbox 4.0 has config templates, but no serial numbers
+	api.netboxVersion = "4.0.0"
+	err = setVirtualMachineGenericFields(api, d)
+	assert.ErrorContains(t, err, "serial requires Netbox 4.1.0 or later")
+
+	// Nothing is sent if neither attribute is set
+	requestBody = nil
+	api.netboxVersion = "3.4.3"
+	d = schema.TestResourceDataRaw(t, resourceNetboxVirtualMachine().Schema, map[string]interface{}{
+		"name": "test",
+	})
+	d.SetId("1")
+	err = setVirtualMachineGenericFields(api, d)
+	assert.NoError(t, err)
+	assert.Nil(t, requestBody)
+}
+
+func TestValidateVirtualMachineDevice(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/dcim/devices/1/":
+			w.Write([]byte(`{"id": 1, "cluster": {"id": 5}}`))
+		case "/api/dcim/devices/2/":
+			w.Write([]byte(`{"id": 2, "cluster": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	c, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: c.(*client.NetBoxAPI)}
+
+	assert.NoError(t, validateVirtualMachineDevice(api, 1, 5))
+	assert.ErrorContains(t, validateVirtualMachineDevice(api, 1, 6), "device 1 does not belong to cluster 6")
+	assert.ErrorContains(t, validateVirtualMachineDevice(api, 2, 5), "device 2 does not belong to cluster 5")
+	assert.ErrorContains(t, validateVirtualMachineDevice(api, 3, 5), "device 3 does not exist")
+}
+
 func testAccCheckVirtualMachineDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each virtual machine
 	// is destroyed
@@ -0,0 +1,220 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vlanGroupScopeTypes are the content types a VLAN group can be scoped to.
+var vlanGroupScopeTypes = []string{"dcim.region", "dcim.sitegroup", "dcim.site", "dcim.location", "dcim.rack", "virtualization.clustergroup", "virtualization.cluster"}
+
+func resourceNetboxVlanGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxVlanGroupCreate,
+		Read:          resourceNetboxVlanGroupRead,
+		Update:        resourceNetboxVlanGroupUpdate,
+		Delete:        resourceNetboxVlanGroupDelete,
+		CustomizeDiff: resourceNetboxVlanGroupCustomizeDiff,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/vlangroup/):
+
+> VLAN groups can be used to organize VLANs within NetBox. Each VLAN group can be scoped to a particular region, site group, site, location, rack, cluster group, or cluster. Member VLANs will be available for assignment to devices and/or virtual machines within the specified scope.
+>
+> Groups can also be used to enforce uniqueness: Each VLAN within a group must have a unique ID and name. VLANs which are not assigned to a group may have overlapping names and IDs (including VLANs which belong to a common site).`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"scope_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(vlanGroupScopeTypes, false),
+				RequiredWith: []string{"scope_id"},
+				Description:  "One of `dcim.region`, `dcim.sitegroup`, `dcim.site`, `dcim.location`, `dcim.rack`, `virtualization.clustergroup` or `virtualization.cluster`.",
+			},
+			"scope_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"scope_type"},
+				Description:  "The ID of the object of type `scope_type` the VLAN group is scoped to.",
+			},
+			"min_vid": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "The lowest permissible VLAN ID of the VLANs in this group.",
+			},
+			"max_vid": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4094,
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "The highest permissible VLAN ID of the VLANs in this group.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVlanGroupCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params := ipam.NewIpamVlanGroupsCreateParams().WithData(getVlanGroupData(api, d))
+	res, err := api.Ipam.IpamVlanGroupsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxVlanGroupRead(d, m)
+}
+
+func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamVlanGroupsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamVlanGroupsRead(params, nil)
+	if err != nil {
+		errorcode := err.(*ipam.IpamVlanGroupsReadDefault).Code()
+		if errorcode == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	vlanGroup := res.GetPayload()
+
+	d.Set("name", vlanGroup.Name)
+	d.Set("slug", vlanGroup.Slug)
+	d.Set("min_vid", vlanGroup.MinVid)
+	d.Set("max_vid", vlanGroup.MaxVid)
+	d.Set("description", vlanGroup.Description)
+	d.Set(tagsKey, getManagedTagList(api, d, vlanGroup.Tags))
+
+	cf := getManagedCustomFields(api, d, vlanGroup.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	if vlanGroup.ScopeType != "" && vlanGroup.ScopeID != nil {
+		d.Set("scope_type", vlanGroup.ScopeType)
+		d.Set("scope_id", vlanGroup.ScopeID)
+	} else {
+		d.Set("scope_type", nil)
+		d.Set("scope_id", nil)
+	}
+
+	return nil
+}
+
+func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamVlanGroupsPartialUpdateParams().WithID(id).WithData(getVlanGroupData(api, d))
+	_, err := api.Ipam.IpamVlanGroupsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	// The generated client omits an empty scope, so a removed scope has to be cleared explicitly
+	if d.HasChanges("scope_type", "scope_id") && d.Get("scope_type").(string) == "" {
+		_, err = genericAPIRequest(api, "PATCH", fmt.Sprintf("/ipam/vlan-groups/%d/", id), map[string]interface{}{
+			"scope_type": nil,
+			"scope_id":   nil,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/ipam/vlan-groups/%d/", id), map[string]string{
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVlanGroupRead(d, m)
+}
+
+func resourceNetboxVlanGroupDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamVlanGroupsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamVlanGroupsDelete(params, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxVlanGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("min_vid") || !d.NewValueKnown("max_vid") {
+		return nil
+	}
+	minVid, maxVid := d.Get("min_vid").(int), d.Get("max_vid").(int)
+	if minVid > maxVid {
+		return fmt.Errorf("min_vid (%d) must not be greater than max_vid (%d)", minVid, maxVid)
+	}
+	return nil
+}
+
+func getVlanGroupData(api *providerState, d *schema.ResourceData) *models.VLANGroup {
+	name := d.Get("name").(string)
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	data := &models.VLANGroup{
+		Name:        &name,
+		Slug:        &slug,
+		MinVid:      int64(d.Get("min_vid").(int)),
+		MaxVid:      int64(d.Get("max_vid").(int)),
+		Description: d.Get("description").(string),
+	}
+
+	if scopeType, ok := d.GetOk("scope_type"); ok {
+		data.ScopeType = scopeType.(string)
+		data.ScopeID = int64ToPtr(int64(d.Get("scope_id").(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return data
+}
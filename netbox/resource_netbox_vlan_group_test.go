@@ -0,0 +1,148 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVlanGroup_basic(t *testing.T) {
+	testSlug := "vlan_group_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vlan_group" "test" {
+  name = "%s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "min_vid", "1"),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "max_vid", "4094"),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "scope_type", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_vlan_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVlanGroup_scope(t *testing.T) {
+	testSlug := "vlan_group_scope"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxVlanFullDependencies(testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_vlan_group" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+  scope_type = "dcim.site"
+  scope_id = netbox_site.test.id
+  min_vid = 100
+  max_vid = 199
+  description = "%[1]s"
+  tags = [netbox_tag.test.name]
+}
+
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid = 150
+  group_id = netbox_vlan_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "scope_type", "dcim.site"),
+					resource.TestCheckResourceAttrPair("netbox_vlan_group.test", "scope_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "min_vid", "100"),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "max_vid", "199"),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_vlan.test", "group_id", "netbox_vlan_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vlan_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_vlan_group" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "scope_type", ""),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "scope_id", "0"),
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "description", ""),
+				),
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_vlan_group" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+  scope_type = "dcim.site"
+}`, testName),
+				ExpectError: regexp.MustCompile("all of `scope_id,scope_type` must be specified"),
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_vlan_group" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+  min_vid = 200
+  max_vid = 100
+}`, testName),
+				ExpectError: regexp.MustCompile("min_vid \\(200\\) must not be greater than max_vid \\(100\\)"),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_vlan_group", &resource.Sweeper{
+		Name:         "netbox_vlan_group",
+		Dependencies: []string{"netbox_vlan"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := ipam.NewIpamVlanGroupsListParams()
+			res, err := api.Ipam.IpamVlanGroupsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, vlanGroup := range res.GetPayload().Results {
+				if strings.HasPrefix(*vlanGroup.Name, testPrefix) {
+					deleteParams := ipam.NewIpamVlanGroupsDeleteParams().WithID(vlanGroup.ID)
+					_, err := api.Ipam.IpamVlanGroupsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a vlan group")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,183 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vlanTranslationMinimumNetboxVersion is the first Netbox version with VLAN translation.
+const vlanTranslationMinimumNetboxVersion = "4.2.0"
+
+func resourceNetboxVlanTranslationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVlanTranslationPolicyCreate,
+		Read:   resourceNetboxVlanTranslationPolicyRead,
+		Update: resourceNetboxVlanTranslationPolicyUpdate,
+		Delete: resourceNetboxVlanTranslationPolicyDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/vlantranslationpolicy/):
+
+> A VLAN translation policy serves as a container for a set of VLAN translation rules, each of which represents a one-to-one mapping of a local VLAN ID (VID) to a remote VID. Many interfaces can reference the same VLAN translation policy.
+
+This resource requires Netbox 4.2 or later. It is not covered by the generated API client and therefore uses the generic API of the provider. Assign the policy to interfaces with the ` + "`vlan_translation_policy_id`" + ` attribute of ` + "`netbox_device_interface`" + ` or ` + "`netbox_interface`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVlanTranslationPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vlanTranslationMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_vlan_translation_policy requires Netbox %s or later, but the Netbox version is %s", vlanTranslationMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/ipam/vlan-translation-policies/", getVlanTranslationPolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxVlanTranslationPolicyRead(d, m)
+}
+
+func resourceNetboxVlanTranslationPolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	policy, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/vlan-translation-policies/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy["name"])
+	d.Set("description", policy["description"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(policy)))
+
+	cf := getManagedCustomFields(api, d, policy[customFieldsKey])
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxVlanTranslationPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/ipam/vlan-translation-policies/%d/", id), getVlanTranslationPolicyRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVlanTranslationPolicyRead(d, m)
+}
+
+func resourceNetboxVlanTranslationPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/ipam/vlan-translation-policies/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getVlanTranslationPolicyRequestData returns the request body for creating or updating a VLAN translation policy.
+func getVlanTranslationPolicyRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf := getCustomFieldsFromResourceData(api, d); cf != nil {
+		data[customFieldsKey] = cf
+	}
+
+	return data
+}
+
+// setInterfaceVlanTranslationPolicy sets the VLAN translation policy of the interface at the given path if the
+// vlan_translation_policy_id attribute changed. The generated client does not know the policy, so it is patched
+// separately.
+func setInterfaceVlanTranslationPolicy(api *providerState, d *schema.ResourceData, path string) error {
+	if !d.HasChange("vlan_translation_policy_id") {
+		return nil
+	}
+
+	var policyID interface{}
+	if id, ok := d.GetOk("vlan_translation_policy_id"); ok {
+		if !api.hasNetboxVersion(vlanTranslationMinimumNetboxVersion) {
+			return fmt.Errorf("vlan_translation_policy_id requires Netbox %s or later, but the Netbox version is %s", vlanTranslationMinimumNetboxVersion, api.netboxVersion)
+		}
+		policyID = id.(int)
+	}
+
+	_, err := genericAPIRequest(api, "PATCH", path, map[string]interface{}{"vlan_translation_policy": policyID})
+	return err
+}
+
+// validateInterfaceVlanTranslationPolicy rejects a VLAN translation policy of an interface during plan if the
+// Netbox version does not support it, before the interface itself is created.
+func validateInterfaceVlanTranslationPolicy(d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || config.GetAttr("vlan_translation_policy_id").IsNull() {
+		return nil
+	}
+	api := m.(*providerState)
+	if api.hasNetboxVersion(vlanTranslationMinimumNetboxVersion) {
+		return nil
+	}
+	return fmt.Errorf("vlan_translation_policy_id requires Netbox %s or later, but the Netbox version is %s", vlanTranslationMinimumNetboxVersion, api.netboxVersion)
+}
+
+// readInterfaceVlanTranslationPolicy sets the vlan_translation_policy_id attribute of an interface resource from
+// the interface at the given path. It is a no-op on Netbox versions without VLAN translation.
+func readInterfaceVlanTranslationPolicy(api *providerState, d *schema.ResourceData, path string) error {
+	if !api.hasNetboxVersion(vlanTranslationMinimumNetboxVersion) {
+		return nil
+	}
+	iface, err := genericAPIRequest(api, "GET", path, nil)
+	if err != nil {
+		return err
+	}
+	if policyID, ok := getGenericNestedObjectID(iface, "vlan_translation_policy"); ok {
+		d.Set("vlan_translation_policy_id", policyID)
+	} else {
+		d.Set("vlan_translation_policy_id", nil)
+	}
+	return nil
+}
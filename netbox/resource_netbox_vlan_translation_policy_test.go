@@ -0,0 +1,99 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netboxClient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxVlanTranslationPolicyRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.4.3"}
+	d := resourceNetboxVlanTranslationPolicy().TestResourceData()
+	d.Set("name", "test")
+
+	err := resourceNetboxVlanTranslationPolicyCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+}
+
+func TestSetInterfaceVlanTranslationPolicy(t *testing.T) {
+	var requestBody map[string]interface{}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/dcim/interfaces/1/", r.URL.Path)
+		requestBody = nil
+		json.NewDecoder(r.Body).Decode(&requestBody)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI), netboxVersion: "4.2.0"}
+	d := schema.TestResourceDataRaw(t, resourceNetboxDeviceInterface().Schema, map[string]interface{}{
+		"vlan_translation_policy_id": 3,
+	})
+
+	err = setInterfaceVlanTranslationPolicy(api, d, "/dcim/interfaces/1/")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"vlan_translation_policy": float64(3)}, requestBody)
+
+	// Older versions reject the attribute instead of sending it
+	api.netboxVersion = "3.4.3"
+	requestBody = nil
+	err = setInterfaceVlanTranslationPolicy(api, d, "/dcim/interfaces/1/")
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+	assert.Nil(t, requestBody)
+}
+
+func TestReadInterfaceVlanTranslationPolicy(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/virtualization/interfaces/1/":
+			w.Write([]byte(`{"id": 1, "vlan_translation_policy": {"id": 3, "name": "test"}}`))
+		default:
+			w.Write([]byte(`{"id": 2, "vlan_translation_policy": null}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	cases := []struct {
+		netboxVersion string
+		path          string
+		expected      interface{}
+	}{
+		{"4.2.0", "/virtualization/interfaces/1/", 3},
+		{"4.2.0", "/virtualization/interfaces/2/", 0},
+		// Older versions do not have VLAN translation, so the interface is not requested at all
+		{"3.4.3", "/virtualization/interfaces/1/", 0},
+	}
+	for _, c := range cases {
+		api := &providerState{NetBoxAPI: client.(*netboxClient.NetBoxAPI), netboxVersion: c.netboxVersion}
+		d := schema.TestResourceDataRaw(t, resourceNetboxInterface().Schema, map[string]interface{}{})
+
+		err = readInterfaceVlanTranslationPolicy(api, d, c.path)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, d.Get("vlan_translation_policy_id"))
+	}
+}
@@ -0,0 +1,139 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxVlanTranslationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVlanTranslationRuleCreate,
+		Read:   resourceNetboxVlanTranslationRuleRead,
+		Update: resourceNetboxVlanTranslationRuleUpdate,
+		Delete: resourceNetboxVlanTranslationRuleDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/vlantranslationrule/):
+
+> A VLAN translation rule represents a one-to-one mapping of a local VLAN ID (VID) to a remote VID. Many rules can belong to a single policy.
+
+This resource requires Netbox 4.2 or later. It is not covered by the generated API client and therefore uses the generic API of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"local_vid": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "The VLAN ID on the interface.",
+			},
+			"remote_vid": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "The VLAN ID the local VLAN ID is translated to.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVlanTranslationRuleCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !api.hasNetboxVersion(vlanTranslationMinimumNetboxVersion) {
+		return fmt.Errorf("netbox_vlan_translation_rule requires Netbox %s or later, but the Netbox version is %s", vlanTranslationMinimumNetboxVersion, api.netboxVersion)
+	}
+
+	res, err := genericAPIRequest(api, "POST", "/ipam/vlan-translation-rules/", getVlanTranslationRuleRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, err := getGenericObjectID(res)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceNetboxVlanTranslationRuleRead(d, m)
+}
+
+func resourceNetboxVlanTranslationRuleRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	rule, err := genericAPIRequest(api, "GET", fmt.Sprintf("/ipam/vlan-translation-rules/%d/", id), nil)
+	if err != nil {
+		if isGenericAPINotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if policyID, ok := getGenericNestedObjectID(rule, "policy"); ok {
+		d.Set("policy_id", policyID)
+	}
+	if localVid, ok := getGenericInt(rule, "local_vid"); ok {
+		d.Set("local_vid", localVid)
+	}
+	if remoteVid, ok := getGenericInt(rule, "remote_vid"); ok {
+		d.Set("remote_vid", remoteVid)
+	}
+	d.Set("description", rule["description"])
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(rule)))
+
+	return nil
+}
+
+func resourceNetboxVlanTranslationRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/ipam/vlan-translation-rules/%d/", id), getVlanTranslationRuleRequestData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVlanTranslationRuleRead(d, m)
+}
+
+func resourceNetboxVlanTranslationRuleDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	_, err := genericAPIRequest(api, "DELETE", fmt.Sprintf("/ipam/vlan-translation-rules/%d/", id), nil)
+	if err != nil && !isGenericAPINotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getVlanTranslationRuleRequestData returns the request body for creating or updating a VLAN translation rule.
+func getVlanTranslationRuleRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"policy":      d.Get("policy_id").(int),
+		"local_vid":   d.Get("local_vid").(int),
+		"remote_vid":  d.Get("remote_vid").(int),
+		"description": d.Get("description").(string),
+	}
+
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	return data
+}
@@ -0,0 +1,18 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxVlanTranslationRuleRequiresNetboxVersion(t *testing.T) {
+	api := &providerState{netboxVersion: "3.4.3"}
+	d := resourceNetboxVlanTranslationRule().TestResourceData()
+	d.Set("policy_id", 1)
+	d.Set("local_vid", 100)
+	d.Set("remote_vid", 200)
+
+	err := resourceNetboxVlanTranslationRuleCreate(d, api)
+	assert.ErrorContains(t, err, "requires Netbox 4.2.0 or later")
+}
@@ -0,0 +1,227 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var vmInterfacesEndpoint = bulkInterfacesEndpoint{
+	path:            "/virtualization/interfaces/",
+	parentField:     "virtual_machine",
+	parentAttribute: "virtual_machine_id",
+}
+
+func resourceNetboxVMInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxVMInterfacesCreate,
+		Read:          resourceNetboxVMInterfacesRead,
+		Update:        resourceNetboxVMInterfacesUpdate,
+		Delete:        resourceNetboxVMInterfacesDelete,
+		CustomizeDiff: customizeDiffBulkInterfaces,
+
+		Description: `:meta:subcategory:Virtualization:This resource manages a set of interfaces of a virtual machine that share the same attributes, e.g. when onboarding many virtual machines at once. The interfaces are created, updated and deleted with bulk API requests, which is considerably faster than managing each interface with a separate ` + "`netbox_interface`" + ` resource.
+
+The names of the interfaces are given as a pattern, just like with ` + "`netbox_device_interfaces`" + `. Ranges such as ` + "`{0..3}`" + ` (zero-padded if the start is, e.g. ` + "`{00..15}`" + `) and lists such as ` + "`{a,b}`" + ` are expanded, e.g. ` + "`eth{0..3}`" + ` yields the four interfaces ` + "`eth0`" + ` to ` + "`eth3`" + `. Existing interfaces of the virtual machine with matching names are adopted.
+
+This resource can be imported by ` + "`<virtual_machine_id>:<name_pattern>`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateInterfaceNamePattern,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65536),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+			"interface_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "A map of the interface names to their IDs.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetboxVMInterfacesImport,
+		},
+	}
+}
+
+func resourceNetboxVMInterfacesCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d:%s", d.Get("virtual_machine_id").(int), d.Get("name_pattern").(string)))
+
+	err = syncBulkInterfaces(api, d, vmInterfacesEndpoint, getVMInterfacesRequestData(api, d), names, true)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVMInterfacesRead(d, m)
+}
+
+func resourceNetboxVMInterfacesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	interfaces, err := lookupBulkInterfaces(api, vmInterfacesEndpoint, int64(d.Get("virtual_machine_id").(int)), names)
+	if err != nil {
+		return err
+	}
+	if len(interfaces) == 0 {
+		// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+		d.SetId("")
+		return nil
+	}
+
+	interfaceIDs := map[string]interface{}{}
+	for name, iface := range interfaces {
+		id, _ := getGenericObjectID(iface)
+		interfaceIDs[name] = id
+	}
+	d.Set("interface_ids", interfaceIDs)
+
+	// The attributes are shared by all interfaces. If any interface deviates from the configuration, its value is
+	// set instead, so that the deviation shows up as a diff and all interfaces are updated.
+	configured := map[string]interface{}{
+		"enabled":     d.Get("enabled").(bool),
+		"mtu":         d.Get("mtu").(int),
+		"description": d.Get("description").(string),
+	}
+	attributes := map[string]interface{}{}
+	for attribute, value := range configured {
+		attributes[attribute] = value
+	}
+	for _, name := range sortedInterfaceNames(interfaces) {
+		for attribute, value := range getVMInterfacesAttributes(interfaces[name]) {
+			if configured[attribute] != value {
+				attributes[attribute] = value
+			}
+		}
+	}
+	for attribute, value := range attributes {
+		d.Set(attribute, value)
+	}
+
+	first := interfaces[sortedInterfaceNames(interfaces)[0]]
+	d.Set(tagsKey, getManagedTagList(api, d, getNestedTagListFromGenericObject(first)))
+
+	return nil
+}
+
+func resourceNetboxVMInterfacesUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	err = syncBulkInterfaces(api, d, vmInterfacesEndpoint, getVMInterfacesRequestData(api, d), names, d.HasChanges("enabled", "mtu", "description", tagsKey))
+	if err != nil {
+		return err
+	}
+
+	// The ID contains the name pattern, which can be changed in place
+	d.SetId(fmt.Sprintf("%d:%s", d.Get("virtual_machine_id").(int), d.Get("name_pattern").(string)))
+
+	return resourceNetboxVMInterfacesRead(d, m)
+}
+
+func resourceNetboxVMInterfacesDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	names, err := expandInterfaceNamePattern(d.Get("name_pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	interfaces, err := lookupBulkInterfaces(api, vmInterfacesEndpoint, int64(d.Get("virtual_machine_id").(int)), names)
+	if err != nil {
+		return err
+	}
+
+	return deleteBulkInterfaces(api, vmInterfacesEndpoint, interfaces)
+}
+
+func resourceNetboxVMInterfacesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <virtual_machine_id>:<name_pattern>", d.Id())
+	}
+	virtualMachineID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%s), virtual_machine_id must be a number", d.Id())
+	}
+
+	d.Set("virtual_machine_id", virtualMachineID)
+	d.Set("name_pattern", parts[1])
+	d.Set("enabled", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getVMInterfacesRequestData returns the attributes that are shared by all interfaces of the resource.
+func getVMInterfacesRequestData(api *providerState, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"enabled":     d.Get("enabled").(bool),
+		"mtu":         nil,
+		"description": d.Get("description").(string),
+	}
+	if mtu, ok := d.GetOk("mtu"); ok {
+		data["mtu"] = mtu.(int)
+	}
+	data[tagsKey], _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	return data
+}
+
+// getVMInterfacesAttributes returns the shared attributes of a VM interface decoded by genericAPIRequest.
+func getVMInterfacesAttributes(iface map[string]interface{}) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"enabled":     iface["enabled"] == true,
+		"mtu":         0,
+		"description": "",
+	}
+	if mtu, ok := iface["mtu"].(json.Number); ok {
+		mtuValue, _ := mtu.Int64()
+		attributes["mtu"] = int(mtuValue)
+	}
+	if description, ok := iface["description"].(string); ok {
+		attributes["description"] = description
+	}
+	return attributes
+}
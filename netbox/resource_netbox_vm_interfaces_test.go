@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxVMInterfaces_basic(t *testing.T) {
+	testSlug := "vm_ifaces"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxInterfaceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_vm_interfaces" "test" {
+  virtual_machine_id = netbox_virtual_machine.test.id
+  name_pattern       = "%[1]s{0..7}"
+  mtu                = 9000
+  description        = "%[1]s"
+  tags               = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "interface_ids.%", "8"),
+					resource.TestCheckResourceAttrSet("netbox_vm_interfaces.test", fmt.Sprintf("interface_ids.%s7", testName)),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "mtu", "9000"),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccNetboxInterfaceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_vm_interfaces" "test" {
+  virtual_machine_id = netbox_virtual_machine.test.id
+  name_pattern       = "%[1]s{0..3}"
+  enabled            = false
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "interface_ids.%", "4"),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "mtu", "0"),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_vm_interfaces.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vm_interfaces.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGetVMInterfacesAttributes(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{
+		"enabled":     true,
+		"mtu":         1500,
+		"description": "uplink",
+	}, getVMInterfacesAttributes(map[string]interface{}{
+		"enabled":     true,
+		"mtu":         json.Number("1500"),
+		"description": "uplink",
+	}))
+
+	assert.Equal(t, map[string]interface{}{
+		"enabled":     false,
+		"mtu":         0,
+		"description": "",
+	}, getVMInterfacesAttributes(map[string]interface{}{
+		"enabled": false,
+		"mtu":     nil,
+	}))
+}
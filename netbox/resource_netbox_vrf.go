@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,7 +28,9 @@ func resourceNetboxVrf() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			tagsKey:                   tagsSchema,
+			customFieldsKey:           customFieldsSchema,
+			preventDeletionIfInUseKey: preventDeletionIfInUseSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -38,7 +39,7 @@ func resourceNetboxVrf() *schema.Resource {
 }
 
 func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableVRF{}
 
 	name := d.Get("name").(string)
@@ -51,6 +52,8 @@ func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	data.ExportTargets = []int64{}
 	data.ImportTargets = []int64{}
 
@@ -67,7 +70,7 @@ func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamVrfsReadParams().WithID(id)
 
@@ -88,11 +91,17 @@ func resourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set(tagsKey, getManagedTagList(api, d, res.GetPayload().Tags))
+
+	cf := getManagedCustomFields(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 	return nil
 }
 
 func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableVRF{}
@@ -103,6 +112,9 @@ func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Name = &name
 	data.Tags = tags
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
 	data.ExportTargets = []int64{}
 	data.ImportTargets = []int64{}
 
@@ -120,12 +132,18 @@ func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxVrfDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	err := checkObjectNotInUse(api, d, "VRF", id, vrfDependents)
+	if err != nil {
+		return err
+	}
+
 	params := ipam.NewIpamVrfsDeleteParams().WithID(id)
 
-	_, err := api.Ipam.IpamVrfsDelete(params, nil)
+	_, err = api.Ipam.IpamVrfsDelete(params, nil)
 	if err != nil {
 		return err
 	}
@@ -52,9 +52,10 @@ resource "netbox_vrf" "test" {
 				),
 			},
 			{
-				ResourceName:      "netbox_vrf.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "netbox_vrf.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_deletion_if_in_use"},
 			},
 		},
 	})
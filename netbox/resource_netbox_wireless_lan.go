@@ -0,0 +1,281 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// wirelessLANStatusMinimumNetboxVersion is the first Netbox version with a status on wireless LANs.
+const wirelessLANStatusMinimumNetboxVersion = "3.5.0"
+
+func resourceNetboxWirelessLAN() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxWirelessLANCreate,
+		Read:          resourceNetboxWirelessLANRead,
+		Update:        resourceNetboxWirelessLANUpdate,
+		Delete:        resourceNetboxWirelessLANDelete,
+		CustomizeDiff: resourceNetboxWirelessLANCustomizeDiff,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://docs.netbox.dev/en/stable/models/wireless/wirelesslan/):
+
+> A wireless LAN is a set of interfaces connected via a common wireless channel, identified by its SSID and authentication parameters. Wireless interfaces can be associated with wireless LANs to model multi-access wireless segments.`,
+
+		Schema: map[string]*schema.Schema{
+			"ssid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: statusDescription("active", "reserved", "disabled", "deprecated") + " Requires Netbox 3.5 or later.",
+			},
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"open", "wep", "wpa-personal", "wpa-enterprise"}, false),
+				Description:  "One of `open`, `wep`, `wpa-personal` or `wpa-enterprise`.",
+			},
+			"auth_cipher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "tkip", "aes"}, false),
+				Description:  "One of `auto`, `tkip` or `aes`.",
+			},
+			"auth_psk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The pre-shared key of the wireless LAN.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxWirelessLANCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWirelessLANData(api, d)
+
+	params := wireless.NewWirelessWirelessLansCreateParams().WithData(data)
+
+	res, err := api.Wireless.WirelessWirelessLansCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	err = setWirelessLANStatus(api, d)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxWirelessLANRead(d, m)
+}
+
+func resourceNetboxWirelessLANRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLansReadParams().WithID(id)
+
+	res, err := api.Wireless.WirelessWirelessLansRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLansReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	wlan := res.GetPayload()
+	d.Set("ssid", wlan.Ssid)
+	d.Set("description", wlan.Description)
+	d.Set("auth_psk", wlan.AuthPsk)
+
+	if wlan.Group != nil {
+		d.Set("group_id", wlan.Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+	if wlan.Vlan != nil {
+		d.Set("vlan_id", wlan.Vlan.ID)
+	} else {
+		d.Set("vlan_id", nil)
+	}
+	if wlan.Tenant != nil {
+		d.Set("tenant_id", wlan.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	if wlan.AuthType != nil {
+		d.Set("auth_type", wlan.AuthType.Value)
+	} else {
+		d.Set("auth_type", nil)
+	}
+	if wlan.AuthCipher != nil {
+		d.Set("auth_cipher", wlan.AuthCipher.Value)
+	} else {
+		d.Set("auth_cipher", nil)
+	}
+
+	d.Set(tagsKey, getManagedTagList(api, d, wlan.Tags))
+
+	cf := getManagedCustomFields(api, d, wlan.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	if api.hasNetboxVersion(wirelessLANStatusMinimumNetboxVersion) {
+		genericWLAN, err := genericAPIRequest(api, "GET", fmt.Sprintf("/wireless/wireless-lans/%d/", id), nil)
+		if err != nil {
+			return err
+		}
+		if status, ok := genericWLAN["status"].(map[string]interface{}); ok {
+			d.Set("status", status["value"])
+		}
+	}
+
+	return nil
+}
+
+func resourceNetboxWirelessLANUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWirelessLANData(api, d)
+
+	params := wireless.NewWirelessWirelessLansPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Wireless.WirelessWirelessLansPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/wireless/wireless-lans/%d/", id), map[string]string{
+		"description": "description",
+		"group_id":    "group",
+		"vlan_id":     "vlan",
+		"tenant_id":   "tenant",
+		"auth_type":   "auth_type",
+		"auth_cipher": "auth_cipher",
+		"auth_psk":    "auth_psk",
+	})
+	if err != nil {
+		return err
+	}
+
+	err = setWirelessLANStatus(api, d)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxWirelessLANRead(d, m)
+}
+
+func resourceNetboxWirelessLANDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLansDeleteParams().WithID(id)
+
+	_, err := api.Wireless.WirelessWirelessLansDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLansDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func resourceNetboxWirelessLANCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || config.GetAttr("status").IsNull() {
+		return nil
+	}
+	api := m.(*providerState)
+	if !api.hasNetboxVersion(wirelessLANStatusMinimumNetboxVersion) {
+		return fmt.Errorf("status requires Netbox %s or later, but the Netbox version is %s", wirelessLANStatusMinimumNetboxVersion, api.netboxVersion)
+	}
+	return validateChoiceAttribute(d, m, "/wireless/wireless-lans/", "status", "status")
+}
+
+// setWirelessLANStatus sets the status of the wireless LAN if it changed. The generated client does not know the
+// status, so it is patched separately.
+func setWirelessLANStatus(api *providerState, d *schema.ResourceData) error {
+	status, ok := d.GetOk("status")
+	if !ok || !d.HasChange("status") {
+		return nil
+	}
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	_, err := genericAPIRequest(api, "PATCH", fmt.Sprintf("/wireless/wireless-lans/%d/", id), map[string]interface{}{"status": status.(string)})
+	return err
+}
+
+func getWirelessLANData(api *providerState, d *schema.ResourceData) *models.WritableWirelessLAN {
+	ssid := d.Get("ssid").(string)
+
+	data := &models.WritableWirelessLAN{
+		Ssid:        &ssid,
+		Description: d.Get("description").(string),
+		AuthType:    d.Get("auth_type").(string),
+		AuthCipher:  d.Get("auth_cipher").(string),
+		AuthPsk:     d.Get("auth_psk").(string),
+	}
+
+	if groupID, ok := d.GetOk("group_id"); ok {
+		data.Group = int64ToPtr(int64(groupID.(int)))
+	}
+	if vlanID, ok := d.GetOk("vlan_id"); ok {
+		data.Vlan = int64ToPtr(int64(vlanID.(int)))
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data.Tenant = int64ToPtr(int64(tenantID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return data
+}
@@ -0,0 +1,180 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxWirelessLANGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxWirelessLANGroupCreate,
+		Read:   resourceNetboxWirelessLANGroupRead,
+		Update: resourceNetboxWirelessLANGroupUpdate,
+		Delete: resourceNetboxWirelessLANGroupDelete,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://docs.netbox.dev/en/stable/models/wireless/wirelesslangroup/):
+
+> Wireless LAN groups can be used to organize and classify wireless LANs. These groups are hierarchical: groups can be nested within parent groups. However, each wireless LAN may be assigned only to one group.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent wireless LAN group.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxWirelessLANGroupCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getWirelessLANGroupData(api, d)
+
+	params := wireless.NewWirelessWirelessLanGroupsCreateParams().WithData(data)
+
+	res, err := api.Wireless.WirelessWirelessLanGroupsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxWirelessLANGroupRead(d, m)
+}
+
+func resourceNetboxWirelessLANGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLanGroupsReadParams().WithID(id)
+
+	res, err := api.Wireless.WirelessWirelessLanGroupsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLanGroupsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	group := res.GetPayload()
+	d.Set("name", group.Name)
+	d.Set("slug", group.Slug)
+	d.Set("description", group.Description)
+	if group.Parent != nil {
+		d.Set("parent_id", group.Parent.ID)
+	} else {
+		d.Set("parent_id", nil)
+	}
+	d.Set(tagsKey, getManagedTagList(api, d, group.Tags))
+
+	cf := getManagedCustomFields(api, d, group.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxWirelessLANGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getWirelessLANGroupData(api, d)
+
+	params := wireless.NewWirelessWirelessLanGroupsPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Wireless.WirelessWirelessLanGroupsPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clearRemovedFields(api, d, fmt.Sprintf("/wireless/wireless-lan-groups/%d/", id), map[string]string{
+		"description": "description",
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxWirelessLANGroupRead(d, m)
+}
+
+func resourceNetboxWirelessLANGroupDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLanGroupsDeleteParams().WithID(id)
+
+	_, err := api.Wireless.WirelessWirelessLanGroupsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLanGroupsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func getWirelessLANGroupData(api *providerState, d *schema.ResourceData) *models.WritableWirelessLANGroup {
+	name := d.Get("name").(string)
+
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	data := &models.WritableWirelessLANGroup{
+		Name:        &name,
+		Slug:        &slug,
+		Description: d.Get("description").(string),
+	}
+
+	// The parent is not omitted when empty, so an unset parent_id clears it
+	if parentID, ok := d.GetOk("parent_id"); ok {
+		data.Parent = int64ToPtr(int64(parentID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	data.CustomFields = getCustomFieldsFromResourceData(api, d)
+
+	return data
+}
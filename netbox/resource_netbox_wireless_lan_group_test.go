@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLANGroup_basic(t *testing.T) {
+	testSlug := "wlan_grp_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_wireless_lan_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_wireless_lan_group" "test" {
+  name        = "%[1]s"
+  parent_id   = netbox_wireless_lan_group.parent.id
+  description = "Campus wireless"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "description", "Campus wireless"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan_group.test", "parent_id", "netbox_wireless_lan_group.parent", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_lan_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_wireless_lan_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_wireless_lan_group" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "parent_id", "0"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_wireless_lan_group", &resource.Sweeper{
+		Name:         "netbox_wireless_lan_group",
+		Dependencies: []string{"netbox_wireless_lan"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := wireless.NewWirelessWirelessLanGroupsListParams()
+			res, err := api.Wireless.WirelessWirelessLanGroupsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, group := range res.GetPayload().Results {
+				if strings.HasPrefix(*group.Name, testPrefix) {
+					deleteParams := wireless.NewWirelessWirelessLanGroupsDeleteParams().WithID(group.ID)
+					_, err := api.Wireless.WirelessWirelessLanGroupsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a wireless LAN group")
+				}
+			}
+			return nil
+		},
+	})
+}
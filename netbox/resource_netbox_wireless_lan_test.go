@@ -0,0 +1,117 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxWirelessLANDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_wireless_lan_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid  = 1742
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+`, testName)
+}
+
+func TestAccNetboxWirelessLAN_basic(t *testing.T) {
+	testSlug := "wlan_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxWirelessLANDependencies(testName) + fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid        = "%[1]s"
+  description = "Guest network"
+  group_id    = netbox_wireless_lan_group.test.id
+  status      = "reserved"
+  vlan_id     = netbox_vlan.test.id
+  tenant_id   = netbox_tenant.test.id
+  auth_type   = "wpa-personal"
+  auth_cipher = "aes"
+  auth_psk    = "correct-horse-battery-staple"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "ssid", testName),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "description", "Guest network"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "status", "reserved"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_type", "wpa-personal"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_cipher", "aes"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_psk", "correct-horse-battery-staple"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "group_id", "netbox_wireless_lan_group.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "vlan_id", "netbox_vlan.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "tenant_id", "netbox_tenant.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_lan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetboxWirelessLANDependencies(testName) + fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid   = "%[1]s"
+  status = "active"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "group_id", "0"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "vlan_id", "0"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "tenant_id", "0"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_type", ""),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_cipher", ""),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_psk", ""),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_wireless_lan", &resource.Sweeper{
+		Name:         "netbox_wireless_lan",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := wireless.NewWirelessWirelessLansListParams()
+			res, err := api.Wireless.WirelessWirelessLansList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, wlan := range res.GetPayload().Results {
+				if strings.HasPrefix(*wlan.Ssid, testPrefix) {
+					deleteParams := wireless.NewWirelessWirelessLansDeleteParams().WithID(wlan.ID)
+					_, err := api.Wireless.WirelessWirelessLansDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a wireless LAN")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -3,11 +3,11 @@ package netbox
 import (
 	"fmt"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
 )
 
 const tagsKey = "tags"
@@ -30,10 +30,22 @@ var tagsSchemaRead = &schema.Schema{
 	Set:      schema.HashString,
 }
 
-func getNestedTagListFromResourceDataSet(client *client.NetBoxAPI, d interface{}) ([]*models.NestedTag, diag.Diagnostics) {
+// getNestedTagListFromResourceDataSet maps the given set of tag names to nested tags. The default tags
+// configured on the provider are added to the result.
+func getNestedTagListFromResourceDataSet(client *providerState, d interface{}) ([]*models.NestedTag, diag.Diagnostics) {
+	tagList := d.(*schema.Set).List()
+	for _, tag := range client.defaultTags {
+		if !d.(*schema.Set).Contains(tag) {
+			tagList = append(tagList, tag)
+		}
+	}
+	return getNestedTagList(client, tagList)
+}
+
+// getNestedTagList maps the given tag names to nested tags by looking them up in netbox.
+func getNestedTagList(client *providerState, tagList []interface{}) ([]*models.NestedTag, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	tagList := d.(*schema.Set).List()
 	tags := []*models.NestedTag{}
 	for _, tag := range tagList {
 
@@ -75,3 +87,17 @@ func getTagListFromNestedTagList(nestedTags []*models.NestedTag) []string {
 	}
 	return tags
 }
+
+// getManagedTagList returns the names of the given nested tags without the default tags configured on the provider,
+// unless they are also explicitly given in the resource configuration. This prevents diffs caused by default tags.
+func getManagedTagList(client *providerState, d *schema.ResourceData, nestedTags []*models.NestedTag) []string {
+	configuredTags := d.Get(tagsKey).(*schema.Set)
+	tags := []string{}
+	for _, tag := range getTagListFromNestedTagList(nestedTags) {
+		if slices.Contains(client.defaultTags, tag) && !configuredTags.Contains(tag) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
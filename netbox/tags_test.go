@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,3 +28,36 @@ func TestGetTagListFromNestedTagList(t *testing.T) {
 	}
 	assert.Equal(t, flat, expected)
 }
+
+func TestGetManagedTagList(t *testing.T) {
+
+	state := &providerState{
+		defaultTags: []string{"managed-by-terraform", "Foo"},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{tagsKey: tagsSchema}, map[string]interface{}{
+		tagsKey: []interface{}{"Foo"},
+	})
+
+	tags := []*models.NestedTag{
+		{
+			Name: strToPtr("Foo"),
+			Slug: strToPtr("foo"),
+		},
+		{
+			Name: strToPtr("Bar"),
+			Slug: strToPtr("bar"),
+		},
+		{
+			Name: strToPtr("managed-by-terraform"),
+			Slug: strToPtr("managed-by-terraform"),
+		},
+	}
+
+	managed := getManagedTagList(state, d, tags)
+	expected := []string{
+		"Foo",
+		"Bar",
+	}
+	assert.Equal(t, expected, managed)
+}
@@ -1,10 +1,20 @@
 package netbox
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/pbkdf2"
 )
 
 func strToPtr(str string) *string {
@@ -31,6 +41,46 @@ func toInt64List(a interface{}) []int64 {
 	return intList
 }
 
+// getOptionalDate parses the date in the format YYYY-MM-DD of the given attribute. It returns nil if the attribute is
+// not set.
+func getOptionalDate(d *schema.ResourceData, attribute string) (*strfmt.Date, error) {
+	value, ok := d.GetOk(attribute)
+	if !ok {
+		return nil, nil
+	}
+	parsed, err := time.Parse(strfmt.RFC3339FullDate, value.(string))
+	if err != nil {
+		return nil, err
+	}
+	date := strfmt.Date(parsed)
+	return &date, nil
+}
+
+// getOptionalJSON decodes the JSON of the given attribute. It returns nil if the attribute is not set.
+func getOptionalJSON(d *schema.ResourceData, attribute string) (interface{}, error) {
+	value, ok := d.GetOk(attribute)
+	if !ok {
+		return nil, nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value.(string)), &decoded); err != nil {
+		return nil, fmt.Errorf("%s is not valid JSON: %w", attribute, err)
+	}
+	return decoded, nil
+}
+
+// formatOptionalJSON returns the given value read from Netbox as JSON string, or an empty string if it is null.
+func formatOptionalJSON(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 func joinStringWithFinalConjunction(elems []string, sep, con string) string {
 	switch len(elems) {
 	case 0:
@@ -44,3 +94,123 @@ func joinStringWithFinalConjunction(elems []string, sep, con string) string {
 	b.WriteString(fmt.Sprintf(" %s %s", con, elems[len(elems)-1]))
 	return b.String()
 }
+
+// roundFloat rounds the given value to the given number of decimal places.
+func roundFloat(value float64, decimalPlaces int) float64 {
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(value, 'f', decimalPlaces, 64), 64)
+	return rounded
+}
+
+// suppressFloatRoundingDiff returns a diff suppress function for float attributes that Netbox stores with the given
+// number of decimal places. Without it, configured values with more decimal places show a perpetual diff.
+func suppressFloatRoundingDiff(decimalPlaces int) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldValue, err := strconv.ParseFloat(old, 64)
+		if err != nil {
+			return false
+		}
+		newValue, err := strconv.ParseFloat(new, 64)
+		if err != nil {
+			return false
+		}
+		return roundFloat(oldValue, decimalPlaces) == roundFloat(newValue, decimalPlaces)
+	}
+}
+
+// suppressEquivalentTimeDiff is a diff suppress function for RFC 3339 time attributes. Netbox returns times in its own
+// time zone and format, so configured values like 2025-12-31T23:59:59Z show a perpetual diff without it.
+func suppressEquivalentTimeDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// normalizeMACAddress is a state function for MAC address attributes. Netbox returns MAC addresses in upper case
+// with colons, so configured values in other formats like aa-bb-cc-dd-ee-ff are stored in the same format to avoid a
+// perpetual diff. Values that are not a valid MAC address are returned unchanged.
+func normalizeMACAddress(value interface{}) string {
+	macAddress, _ := value.(string)
+	hardwareAddr, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return macAddress
+	}
+	return strings.ToUpper(hardwareAddr.String())
+}
+
+// hashSensitiveValue returns the hex encoded SHA-256 hash of a sensitive value, e.g. to compare credentials in memory.
+// The hash is unsalted, so sensitive values in the state use saltedHashSensitiveValue instead.
+func hashSensitiveValue(value interface{}) string {
+	plaintext, _ := value.(string)
+	hash := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(hash[:])
+}
+
+const (
+	// sensitiveValueHashScheme is the scheme prefix of salted hashes of sensitive values stored in the state
+	sensitiveValueHashScheme = "pbkdf2-sha256"
+	// sensitiveValueHashIterations is the number of PBKDF2 iterations of new salted hashes
+	sensitiveValueHashIterations = 100000
+	sensitiveValueSaltLength     = 16
+	sensitiveValueKeyLength      = 32
+)
+
+// saltedHashSensitiveValue returns a salted hash of a sensitive value that cannot be read from Netbox, like a
+// password, in the format `pbkdf2-sha256$<iterations>$<salt>$<hash>`. Every hash uses a new random salt and the
+// iterations of PBKDF2 slow down brute forcing the value from the state.
+func saltedHashSensitiveValue(plaintext string) (string, error) {
+	salt := make([]byte, sensitiveValueSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("could not generate salt: %w", err)
+	}
+	key := pbkdf2.Key([]byte(plaintext), salt, sensitiveValueHashIterations, sensitiveValueKeyLength, sha256.New)
+	return fmt.Sprintf("%s$%d$%s$%s", sensitiveValueHashScheme, sensitiveValueHashIterations, hex.EncodeToString(salt), hex.EncodeToString(key)), nil
+}
+
+// sensitiveValueMatchesHash returns whether the plaintext matches a hash returned by saltedHashSensitiveValue.
+func sensitiveValueMatchesHash(plaintext string, hash string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != sensitiveValueHashScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 {
+		return false
+	}
+	salt, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	expected, err := hex.DecodeString(parts[3])
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+	key := pbkdf2.Key([]byte(plaintext), salt, iterations, len(expected), sha256.New)
+	return subtle.ConstantTimeCompare(key, expected) == 1
+}
+
+// suppressSensitiveValueDiff is a diff suppress function for sensitive attributes whose state holds the salted hash
+// of the configured value.
+func suppressSensitiveValueDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return sensitiveValueMatchesHash(new, old)
+}
+
+// hashSensitiveValueInState replaces the value of a sensitive attribute in the raw state of an older schema version,
+// which stored the value in plaintext, with its salted hash.
+func hashSensitiveValueInState(rawState map[string]interface{}, key string) error {
+	value, ok := rawState[key].(string)
+	if !ok || value == "" || strings.HasPrefix(value, sensitiveValueHashScheme+"$") {
+		return nil
+	}
+	hash, err := saltedHashSensitiveValue(value)
+	if err != nil {
+		return err
+	}
+	rawState[key] = hash
+	return nil
+}
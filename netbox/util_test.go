@@ -1,6 +1,7 @@
 package netbox
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -35,3 +36,177 @@ func TestJoinStringWithFinalConjunction(t *testing.T) {
 		})
 	}
 }
+
+func TestSuppressFloatRoundingDiff(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "Rounded",
+			old:      "48.137154",
+			new:      "48.1371538",
+			expected: true,
+		},
+		{
+			name:     "Equal",
+			old:      "48.137154",
+			new:      "48.137154",
+			expected: true,
+		},
+		{
+			name:     "Different",
+			old:      "48.137154",
+			new:      "48.137155",
+			expected: false,
+		},
+		{
+			name:     "Removed",
+			old:      "48.137154",
+			new:      "",
+			expected: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := suppressFloatRoundingDiff(6)("latitude", tt.old, tt.new, nil)
+			if actual != tt.expected {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentTimeDiff(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "Equal",
+			old:      "2025-12-31T23:59:59Z",
+			new:      "2025-12-31T23:59:59Z",
+			expected: true,
+		},
+		{
+			name:     "OtherTimeZone",
+			old:      "2026-01-01T00:59:59+01:00",
+			new:      "2025-12-31T23:59:59Z",
+			expected: true,
+		},
+		{
+			name:     "FractionalSeconds",
+			old:      "2025-12-31T23:59:59.000000Z",
+			new:      "2025-12-31T23:59:59Z",
+			expected: true,
+		},
+		{
+			name:     "Different",
+			old:      "2025-12-31T23:59:59Z",
+			new:      "2025-12-31T23:59:58Z",
+			expected: false,
+		},
+		{
+			name:     "Removed",
+			old:      "2025-12-31T23:59:59Z",
+			new:      "",
+			expected: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := suppressEquivalentTimeDiff("expires", tt.old, tt.new, nil)
+			if actual != tt.expected {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNormalizeMACAddress(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "Normalized",
+			value:    "AA:BB:CC:DD:EE:FF",
+			expected: "AA:BB:CC:DD:EE:FF",
+		},
+		{
+			name:     "LowerCase",
+			value:    "aa:bb:cc:dd:ee:ff",
+			expected: "AA:BB:CC:DD:EE:FF",
+		},
+		{
+			name:     "Dashes",
+			value:    "aa-bb-cc-dd-ee-ff",
+			expected: "AA:BB:CC:DD:EE:FF",
+		},
+		{
+			name:     "Dots",
+			value:    "aabb.ccdd.eeff",
+			expected: "AA:BB:CC:DD:EE:FF",
+		},
+		{
+			name:     "Invalid",
+			value:    "not-a-mac",
+			expected: "not-a-mac",
+		},
+		{
+			name:     "Empty",
+			value:    "",
+			expected: "",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := normalizeMACAddress(tt.value)
+			if actual != tt.expected {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHashSensitiveValue(t *testing.T) {
+	expected := "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"
+	actual := hashSensitiveValue("secret")
+	if actual != expected {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
+
+func TestSaltedHashSensitiveValue(t *testing.T) {
+	hash, err := saltedHashSensitiveValue("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, "pbkdf2-sha256$100000$") || strings.Contains(hash, "secret") {
+		t.Fatalf("unexpected hash %s", hash)
+	}
+	if !sensitiveValueMatchesHash("secret", hash) {
+		t.Fatalf("expected %s to match its hash", "secret")
+	}
+	if sensitiveValueMatchesHash("secrets", hash) {
+		t.Fatalf("expected %s not to match the hash of %s", "secrets", "secret")
+	}
+
+	// Every hash uses a new salt
+	otherHash, err := saltedHashSensitiveValue("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if otherHash == hash {
+		t.Fatalf("expected different salts, got %s twice", hash)
+	}
+
+	// Unsalted hashes and plaintext values in the state never match
+	for _, value := range []string{"", "secret", hashSensitiveValue("secret"), "pbkdf2-sha256$x$00$00"} {
+		if sensitiveValueMatchesHash("secret", value) {
+			t.Fatalf("expected %s not to match", value)
+		}
+	}
+}